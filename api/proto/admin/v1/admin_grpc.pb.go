@@ -19,8 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_GetUsers_FullMethodName = "/admin.v1.AdminService/GetUsers"
-	AdminService_GetUser_FullMethodName  = "/admin.v1.AdminService/GetUser"
+	AdminService_GetUsers_FullMethodName             = "/admin.v1.AdminService/GetUsers"
+	AdminService_GetUser_FullMethodName              = "/admin.v1.AdminService/GetUser"
+	AdminService_SuspendUser_FullMethodName          = "/admin.v1.AdminService/SuspendUser"
+	AdminService_BanUser_FullMethodName              = "/admin.v1.AdminService/BanUser"
+	AdminService_ReinstateUser_FullMethodName        = "/admin.v1.AdminService/ReinstateUser"
+	AdminService_GrantPremium_FullMethodName         = "/admin.v1.AdminService/GrantPremium"
+	AdminService_GetUserAuthEvents_FullMethodName    = "/admin.v1.AdminService/GetUserAuthEvents"
+	AdminService_ListTaxonomyOptions_FullMethodName  = "/admin.v1.AdminService/ListTaxonomyOptions"
+	AdminService_CreateTaxonomyOption_FullMethodName = "/admin.v1.AdminService/CreateTaxonomyOption"
+	AdminService_UpdateTaxonomyOption_FullMethodName = "/admin.v1.AdminService/UpdateTaxonomyOption"
+	AdminService_DeleteTaxonomyOption_FullMethodName = "/admin.v1.AdminService/DeleteTaxonomyOption"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -31,6 +40,24 @@ type AdminServiceClient interface {
 	GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error)
 	// Get detailed information about a specific user by UUID, email, or phone
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// Suspend a user, optionally until a given time
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*ModerateUserResponse, error)
+	// Permanently ban a user
+	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*ModerateUserResponse, error)
+	// Lift a suspension or ban
+	ReinstateUser(ctx context.Context, in *ReinstateUserRequest, opts ...grpc.CallOption) (*ModerateUserResponse, error)
+	// Give a user premium membership or extend it
+	GrantPremium(ctx context.Context, in *GrantPremiumRequest, opts ...grpc.CallOption) (*ModerateUserResponse, error)
+	// Get a user's logins, logouts and other authentication events
+	GetUserAuthEvents(ctx context.Context, in *GetUserAuthEventsRequest, opts ...grpc.CallOption) (*GetUserAuthEventsResponse, error)
+	// List the community, profession, education level and location options, including deactivated ones
+	ListTaxonomyOptions(ctx context.Context, in *ListTaxonomyOptionsRequest, opts ...grpc.CallOption) (*ListTaxonomyOptionsResponse, error)
+	// Add an option to a taxonomy category
+	CreateTaxonomyOption(ctx context.Context, in *CreateTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error)
+	// Change an option's label, sort order or active flag
+	UpdateTaxonomyOption(ctx context.Context, in *UpdateTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error)
+	// Deactivate an option so it can no longer be selected
+	DeleteTaxonomyOption(ctx context.Context, in *DeleteTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error)
 }
 
 type adminServiceClient struct {
@@ -61,6 +88,96 @@ func (c *adminServiceClient) GetUser(ctx context.Context, in *GetUserRequest, op
 	return out, nil
 }
 
+func (c *adminServiceClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*ModerateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*ModerateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_BanUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReinstateUser(ctx context.Context, in *ReinstateUserRequest, opts ...grpc.CallOption) (*ModerateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReinstateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GrantPremium(ctx context.Context, in *GrantPremiumRequest, opts ...grpc.CallOption) (*ModerateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateUserResponse)
+	err := c.cc.Invoke(ctx, AdminService_GrantPremium_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetUserAuthEvents(ctx context.Context, in *GetUserAuthEventsRequest, opts ...grpc.CallOption) (*GetUserAuthEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserAuthEventsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetUserAuthEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListTaxonomyOptions(ctx context.Context, in *ListTaxonomyOptionsRequest, opts ...grpc.CallOption) (*ListTaxonomyOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaxonomyOptionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListTaxonomyOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateTaxonomyOption(ctx context.Context, in *CreateTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxonomyOptionResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateTaxonomyOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateTaxonomyOption(ctx context.Context, in *UpdateTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxonomyOptionResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateTaxonomyOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteTaxonomyOption(ctx context.Context, in *DeleteTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxonomyOptionResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteTaxonomyOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -69,6 +186,24 @@ type AdminServiceServer interface {
 	GetUsers(context.Context, *GetUsersRequest) (*GetUsersResponse, error)
 	// Get detailed information about a specific user by UUID, email, or phone
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// Suspend a user, optionally until a given time
+	SuspendUser(context.Context, *SuspendUserRequest) (*ModerateUserResponse, error)
+	// Permanently ban a user
+	BanUser(context.Context, *BanUserRequest) (*ModerateUserResponse, error)
+	// Lift a suspension or ban
+	ReinstateUser(context.Context, *ReinstateUserRequest) (*ModerateUserResponse, error)
+	// Give a user premium membership or extend it
+	GrantPremium(context.Context, *GrantPremiumRequest) (*ModerateUserResponse, error)
+	// Get a user's logins, logouts and other authentication events
+	GetUserAuthEvents(context.Context, *GetUserAuthEventsRequest) (*GetUserAuthEventsResponse, error)
+	// List the community, profession, education level and location options, including deactivated ones
+	ListTaxonomyOptions(context.Context, *ListTaxonomyOptionsRequest) (*ListTaxonomyOptionsResponse, error)
+	// Add an option to a taxonomy category
+	CreateTaxonomyOption(context.Context, *CreateTaxonomyOptionRequest) (*TaxonomyOptionResponse, error)
+	// Change an option's label, sort order or active flag
+	UpdateTaxonomyOption(context.Context, *UpdateTaxonomyOptionRequest) (*TaxonomyOptionResponse, error)
+	// Deactivate an option so it can no longer be selected
+	DeleteTaxonomyOption(context.Context, *DeleteTaxonomyOptionRequest) (*TaxonomyOptionResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -85,6 +220,33 @@ func (UnimplementedAdminServiceServer) GetUsers(context.Context, *GetUsersReques
 func (UnimplementedAdminServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
+func (UnimplementedAdminServiceServer) SuspendUser(context.Context, *SuspendUserRequest) (*ModerateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedAdminServiceServer) BanUser(context.Context, *BanUserRequest) (*ModerateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (UnimplementedAdminServiceServer) ReinstateUser(context.Context, *ReinstateUserRequest) (*ModerateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReinstateUser not implemented")
+}
+func (UnimplementedAdminServiceServer) GrantPremium(context.Context, *GrantPremiumRequest) (*ModerateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPremium not implemented")
+}
+func (UnimplementedAdminServiceServer) GetUserAuthEvents(context.Context, *GetUserAuthEventsRequest) (*GetUserAuthEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAuthEvents not implemented")
+}
+func (UnimplementedAdminServiceServer) ListTaxonomyOptions(context.Context, *ListTaxonomyOptionsRequest) (*ListTaxonomyOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaxonomyOptions not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateTaxonomyOption(context.Context, *CreateTaxonomyOptionRequest) (*TaxonomyOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTaxonomyOption not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateTaxonomyOption(context.Context, *UpdateTaxonomyOptionRequest) (*TaxonomyOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaxonomyOption not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteTaxonomyOption(context.Context, *DeleteTaxonomyOptionRequest) (*TaxonomyOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaxonomyOption not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -142,6 +304,168 @@ func _AdminService_GetUser_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SuspendUser(ctx, req.(*SuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_BanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReinstateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinstateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReinstateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReinstateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReinstateUser(ctx, req.(*ReinstateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GrantPremium_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantPremiumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GrantPremium(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GrantPremium_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GrantPremium(ctx, req.(*GrantPremiumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetUserAuthEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserAuthEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetUserAuthEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetUserAuthEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetUserAuthEvents(ctx, req.(*GetUserAuthEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListTaxonomyOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaxonomyOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTaxonomyOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListTaxonomyOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTaxonomyOptions(ctx, req.(*ListTaxonomyOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateTaxonomyOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaxonomyOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateTaxonomyOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateTaxonomyOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateTaxonomyOption(ctx, req.(*CreateTaxonomyOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateTaxonomyOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaxonomyOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateTaxonomyOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateTaxonomyOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateTaxonomyOption(ctx, req.(*UpdateTaxonomyOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteTaxonomyOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaxonomyOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteTaxonomyOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteTaxonomyOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteTaxonomyOption(ctx, req.(*DeleteTaxonomyOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +481,42 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUser",
 			Handler:    _AdminService_GetUser_Handler,
 		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _AdminService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _AdminService_BanUser_Handler,
+		},
+		{
+			MethodName: "ReinstateUser",
+			Handler:    _AdminService_ReinstateUser_Handler,
+		},
+		{
+			MethodName: "GrantPremium",
+			Handler:    _AdminService_GrantPremium_Handler,
+		},
+		{
+			MethodName: "GetUserAuthEvents",
+			Handler:    _AdminService_GetUserAuthEvents_Handler,
+		},
+		{
+			MethodName: "ListTaxonomyOptions",
+			Handler:    _AdminService_ListTaxonomyOptions_Handler,
+		},
+		{
+			MethodName: "CreateTaxonomyOption",
+			Handler:    _AdminService_CreateTaxonomyOption_Handler,
+		},
+		{
+			MethodName: "UpdateTaxonomyOption",
+			Handler:    _AdminService_UpdateTaxonomyOption_Handler,
+		},
+		{
+			MethodName: "DeleteTaxonomyOption",
+			Handler:    _AdminService_DeleteTaxonomyOption_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/admin.proto",
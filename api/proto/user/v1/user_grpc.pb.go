@@ -42,6 +42,10 @@ const (
 	UserService_DeleteUserVideo_FullMethodName          = "/user.v1.UserService/DeleteUserVideo"
 	UserService_GetDetailedProfile_FullMethodName       = "/user.v1.UserService/GetDetailedProfile"
 	UserService_GetProfileForAdmin_FullMethodName       = "/user.v1.UserService/GetProfileForAdmin"
+	UserService_ListTaxonomyOptions_FullMethodName      = "/user.v1.UserService/ListTaxonomyOptions"
+	UserService_CreateTaxonomyOption_FullMethodName     = "/user.v1.UserService/CreateTaxonomyOption"
+	UserService_UpdateTaxonomyOption_FullMethodName     = "/user.v1.UserService/UpdateTaxonomyOption"
+	UserService_DeleteTaxonomyOption_FullMethodName     = "/user.v1.UserService/DeleteTaxonomyOption"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -71,6 +75,11 @@ type UserServiceClient interface {
 	DeleteUserVideo(ctx context.Context, in *DeleteUserVideoRequest, opts ...grpc.CallOption) (*DeleteUserVideoResponse, error)
 	GetDetailedProfile(ctx context.Context, in *GetDetailedProfileRequest, opts ...grpc.CallOption) (*GetDetailedProfileResponse, error)
 	GetProfileForAdmin(ctx context.Context, in *GetProfileForAdminRequest, opts ...grpc.CallOption) (*GetDetailedProfileResponse, error)
+	// Option lists for community, profession, education level and location
+	ListTaxonomyOptions(ctx context.Context, in *ListTaxonomyOptionsRequest, opts ...grpc.CallOption) (*ListTaxonomyOptionsResponse, error)
+	CreateTaxonomyOption(ctx context.Context, in *CreateTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error)
+	UpdateTaxonomyOption(ctx context.Context, in *UpdateTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error)
+	DeleteTaxonomyOption(ctx context.Context, in *DeleteTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error)
 }
 
 type userServiceClient struct {
@@ -311,6 +320,46 @@ func (c *userServiceClient) GetProfileForAdmin(ctx context.Context, in *GetProfi
 	return out, nil
 }
 
+func (c *userServiceClient) ListTaxonomyOptions(ctx context.Context, in *ListTaxonomyOptionsRequest, opts ...grpc.CallOption) (*ListTaxonomyOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaxonomyOptionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListTaxonomyOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateTaxonomyOption(ctx context.Context, in *CreateTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxonomyOptionResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateTaxonomyOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateTaxonomyOption(ctx context.Context, in *UpdateTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxonomyOptionResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateTaxonomyOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteTaxonomyOption(ctx context.Context, in *DeleteTaxonomyOptionRequest, opts ...grpc.CallOption) (*TaxonomyOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxonomyOptionResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteTaxonomyOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -338,6 +387,11 @@ type UserServiceServer interface {
 	DeleteUserVideo(context.Context, *DeleteUserVideoRequest) (*DeleteUserVideoResponse, error)
 	GetDetailedProfile(context.Context, *GetDetailedProfileRequest) (*GetDetailedProfileResponse, error)
 	GetProfileForAdmin(context.Context, *GetProfileForAdminRequest) (*GetDetailedProfileResponse, error)
+	// Option lists for community, profession, education level and location
+	ListTaxonomyOptions(context.Context, *ListTaxonomyOptionsRequest) (*ListTaxonomyOptionsResponse, error)
+	CreateTaxonomyOption(context.Context, *CreateTaxonomyOptionRequest) (*TaxonomyOptionResponse, error)
+	UpdateTaxonomyOption(context.Context, *UpdateTaxonomyOptionRequest) (*TaxonomyOptionResponse, error)
+	DeleteTaxonomyOption(context.Context, *DeleteTaxonomyOptionRequest) (*TaxonomyOptionResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -417,6 +471,18 @@ func (UnimplementedUserServiceServer) GetDetailedProfile(context.Context, *GetDe
 func (UnimplementedUserServiceServer) GetProfileForAdmin(context.Context, *GetProfileForAdminRequest) (*GetDetailedProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfileForAdmin not implemented")
 }
+func (UnimplementedUserServiceServer) ListTaxonomyOptions(context.Context, *ListTaxonomyOptionsRequest) (*ListTaxonomyOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaxonomyOptions not implemented")
+}
+func (UnimplementedUserServiceServer) CreateTaxonomyOption(context.Context, *CreateTaxonomyOptionRequest) (*TaxonomyOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTaxonomyOption not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateTaxonomyOption(context.Context, *UpdateTaxonomyOptionRequest) (*TaxonomyOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaxonomyOption not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteTaxonomyOption(context.Context, *DeleteTaxonomyOptionRequest) (*TaxonomyOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaxonomyOption not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -852,6 +918,78 @@ func _UserService_GetProfileForAdmin_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListTaxonomyOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaxonomyOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListTaxonomyOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListTaxonomyOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListTaxonomyOptions(ctx, req.(*ListTaxonomyOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateTaxonomyOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaxonomyOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateTaxonomyOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateTaxonomyOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateTaxonomyOption(ctx, req.(*CreateTaxonomyOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateTaxonomyOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaxonomyOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateTaxonomyOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateTaxonomyOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateTaxonomyOption(ctx, req.(*UpdateTaxonomyOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteTaxonomyOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaxonomyOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteTaxonomyOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteTaxonomyOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteTaxonomyOption(ctx, req.(*DeleteTaxonomyOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -951,6 +1089,22 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProfileForAdmin",
 			Handler:    _UserService_GetProfileForAdmin_Handler,
 		},
+		{
+			MethodName: "ListTaxonomyOptions",
+			Handler:    _UserService_ListTaxonomyOptions_Handler,
+		},
+		{
+			MethodName: "CreateTaxonomyOption",
+			Handler:    _UserService_CreateTaxonomyOption_Handler,
+		},
+		{
+			MethodName: "UpdateTaxonomyOption",
+			Handler:    _UserService_UpdateTaxonomyOption_Handler,
+		},
+		{
+			MethodName: "DeleteTaxonomyOption",
+			Handler:    _UserService_DeleteTaxonomyOption_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/v1/user.proto",
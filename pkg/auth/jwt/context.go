@@ -0,0 +1,17 @@
+package jwt
+
+import "context"
+
+// claimsContextKey is the context key of the claims of the validated token of a request
+type claimsContextKey struct{}
+
+// NewContext returns a copy of ctx carrying the claims of the request's validated token
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims stored by NewContext, if any
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*Claims)
+	return claims, ok && claims != nil
+}
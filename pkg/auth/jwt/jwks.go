@@ -0,0 +1,116 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+)
+
+// JSONWebKey is the public part of a verification key as described in RFC 7517
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`           // "RSA" or "OKP"
+	KeyID     string `json:"kid"`           // Matches the "kid" header of tokens signed with the key
+	Use       string `json:"use"`           // Always "sig"
+	Algorithm string `json:"alg"`           // RS256 or EdDSA
+	N         string `json:"n,omitempty"`   // RSA modulus
+	E         string `json:"e,omitempty"`   // RSA public exponent
+	Curve     string `json:"crv,omitempty"` // "Ed25519" for OKP keys
+	X         string `json:"x,omitempty"`   // Ed25519 public key
+}
+
+// JWKS is a JSON Web Key Set, the document verifiers fetch to learn the active public keys
+type JWKS struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS returns the public keys the manager accepts. HMAC secrets are never included.
+func (m *Manager) JWKS() JWKS {
+	jwks := JWKS{Keys: make([]JSONWebKey, 0, len(m.keyOrder))}
+	for _, id := range m.keyOrder {
+		jwk, err := newJSONWebKey(m.verificationKeys[id])
+		if err != nil {
+			continue
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	return jwks
+}
+
+// VerificationKeys converts the key set back into verification keys.
+// Example: a service can fetch the auth service's JWKS and pass the result
+// as Config.VerificationKeys instead of loading public key files.
+func (s JWKS) VerificationKeys() ([]*Key, error) {
+	keys := make([]*Key, 0, len(s.Keys))
+	for _, jwk := range s.Keys {
+		key, err := jwk.VerificationKey()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func newJSONWebKey(key *Key) (JSONWebKey, error) {
+	jwk := JSONWebKey{
+		KeyID:     key.ID,
+		Use:       "sig",
+		Algorithm: key.Algorithm,
+	}
+
+	switch publicKey := key.PublicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+	default:
+		return JSONWebKey{}, fmt.Errorf("unsupported public key type %T", key.PublicKey)
+	}
+	return jwk, nil
+}
+
+// VerificationKey converts a single key of the set into a verification key
+func (jwk JSONWebKey) VerificationKey() (*Key, error) {
+	key := &Key{
+		ID:        jwk.KeyID,
+		Algorithm: jwk.Algorithm,
+	}
+
+	switch jwk.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: invalid modulus: %w", jwk.KeyID, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: invalid exponent: %w", jwk.KeyID, err)
+		}
+		key.PublicKey = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	case "OKP":
+		if jwk.Curve != "Ed25519" {
+			return nil, fmt.Errorf("key %q: unsupported curve %q", jwk.KeyID, jwk.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("key %q: invalid Ed25519 public key", jwk.KeyID)
+		}
+		key.PublicKey = ed25519.PublicKey(x)
+	default:
+		return nil, fmt.Errorf("key %q: unsupported key type %q", jwk.KeyID, jwk.KeyType)
+	}
+
+	if err := key.validate(); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
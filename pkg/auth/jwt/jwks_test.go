@@ -0,0 +1,56 @@
+package jwt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestJWKSRoundTrip(t *testing.T) {
+	rsaKey := newTestRSAKey(t, "rsa-1")
+	edKey := newTestEd25519Key(t, "ed-1")
+	m := NewManager(Config{SigningKey: rsaKey, VerificationKeys: []*Key{edKey}, SecretKey: "never-published"})
+
+	data, err := json.Marshal(m.JWKS())
+	if err != nil {
+		t.Fatalf("failed to marshal JWKS: %v", err)
+	}
+
+	var jwks JWKS
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		t.Fatalf("failed to unmarshal JWKS: %v", err)
+	}
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("JWKS has %d keys, want 2", len(jwks.Keys))
+	}
+
+	keys, err := jwks.VerificationKeys()
+	if err != nil {
+		t.Fatalf("VerificationKeys: %v", err)
+	}
+	for i, want := range []*Key{rsaKey, edKey} {
+		got := keys[i]
+		if got.ID != want.ID || got.Algorithm != want.Algorithm {
+			t.Errorf("key %d = %s/%s, want %s/%s", i, got.ID, got.Algorithm, want.ID, want.Algorithm)
+		}
+		if got.PrivateKey != nil {
+			t.Errorf("key %s carries a private key", got.ID)
+		}
+	}
+
+	// A verifier built from the published set accepts tokens of both keys
+	verifier := NewManager(Config{VerificationKeys: keys})
+	for _, key := range []*Key{rsaKey, edKey} {
+		token := signWith(t, key.signingMethod(), key.ID, key.PrivateKey)
+		if _, err := verifier.ValidateToken(token); err != nil {
+			t.Errorf("token of key %s rejected after the round trip: %v", key.ID, err)
+		}
+	}
+
+	// The HMAC secret never appears in the set
+	token := signWith(t, jwt.SigningMethodHS256, "", []byte("never-published"))
+	if _, err := verifier.ValidateToken(token); err == nil {
+		t.Error("verifier built from the JWKS accepted an HS256 token")
+	}
+}
@@ -62,32 +62,97 @@ func GetServiceAccessByRole(role Role) ServiceAccess {
 }
 
 // Config contains configuration used to create and validate tokens.
+// Tokens are signed with SigningKey when it is set; otherwise they fall back to
+// HS256 with SecretKey. Verifiers only need the public VerificationKeys, and may
+// keep SecretKey while HS256 tokens issued before the switch are still valid.
 type Config struct {
-	SecretKey       string        // Secret used to sign the token (keep this safe!)
-	AccessTokenTTL  time.Duration // How long the access token is valid (e.g. 15 minutes)
-	RefreshTokenTTL time.Duration // How long the refresh token is valid (e.g. 7 days)
-	Issuer          string        // The name or source of the token (usually your app name)
+	SecretKey        string        // Secret used to sign and verify HS256 tokens (keep this safe!)
+	SigningKey       *Key          // Private key used to sign new tokens, only set in the auth service
+	VerificationKeys []*Key        // Public keys accepted when validating tokens, looked up by "kid"
+	AccessTokenTTL   time.Duration // How long the access token is valid (e.g. 15 minutes)
+	RefreshTokenTTL  time.Duration // How long the refresh token is valid (e.g. 7 days)
+	Issuer           string        // The name or source of the token (usually your app name)
 }
 
 // Claims represents the data stored inside a JWT.
 // This includes user ID, role, services allowed, etc.
 type Claims struct {
-	UserID               string        `json:"user_id"`  // UUID string identifier , Example: "123e4567-e89b-12d3-a456-426614174000"
-	Role                 Role          `json:"role"`     // USER, PREMIUM_USER, or ADMIN
-	Services             ServiceAccess `json:"services"` // Services user is allowed to access
+	UserID               string        `json:"user_id"`               // UUID string identifier , Example: "123e4567-e89b-12d3-a456-426614174000"
+	Role                 Role          `json:"role"`                  // USER, PREMIUM_USER, or ADMIN
+	Services             ServiceAccess `json:"services"`              // Services user is allowed to access
+	SessionID            string        `json:"sid,omitempty"`         // Login session (device) the token belongs to
+	AdminRole            AdminRole     `json:"admin_role,omitempty"`  // Staff role, only set for ADMIN tokens
+	Permissions          []Permission  `json:"permissions,omitempty"` // Admin permissions granted by the admin role
 	jwt.RegisteredClaims               // Includes standard JWT fields like expiration, issued at, etc.
 }
 
 // Manager is the main struct that handles all JWT-related operations.
 type Manager struct {
-	config Config
+	config           Config
+	verificationKeys map[string]*Key // Keyed by key ID
+	keyOrder         []string        // Key IDs in configuration order, signing key first
 }
 
-// NewManager creates a new JWT manager
+// NewManager creates a new JWT manager.
+// The signing key's public half is always accepted for verification, so a
+// service that issues tokens does not have to list its own key twice.
 func NewManager(config Config) *Manager {
-	return &Manager{
-		config: config,
+	m := &Manager{
+		config:           config,
+		verificationKeys: make(map[string]*Key),
 	}
+
+	if config.SigningKey != nil {
+		m.addVerificationKey(config.SigningKey)
+	}
+	for _, key := range config.VerificationKeys {
+		m.addVerificationKey(key)
+	}
+
+	return m
+}
+
+func (m *Manager) addVerificationKey(key *Key) {
+	if _, exists := m.verificationKeys[key.ID]; !exists {
+		m.keyOrder = append(m.keyOrder, key.ID)
+	}
+	m.verificationKeys[key.ID] = key
+}
+
+// signToken signs the claims with the configured signing key, or with the
+// HMAC secret when no signing key is configured.
+func (m *Manager) signToken(claims *Claims) (string, error) {
+	if key := m.config.SigningKey; key != nil {
+		token := jwt.NewWithClaims(key.signingMethod(), claims)
+		token.Header["kid"] = key.ID
+		return token.SignedString(key.PrivateKey)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(m.config.SecretKey))
+}
+
+// verificationKey returns the key to verify the token with, based on its "kid" header.
+// Tokens without a kid are HS256 tokens and are verified with the shared secret.
+func (m *Manager) verificationKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || m.config.SecretKey == "" {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(m.config.SecretKey), nil
+	}
+
+	key, ok := m.verificationKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key: %s", kid)
+	}
+
+	// The algorithm is pinned to the key so a token cannot pick a weaker one
+	if token.Method.Alg() != key.Algorithm {
+		return nil, fmt.Errorf("unexpected signing method %v for key %s", token.Header["alg"], kid)
+	}
+	return key.PublicKey, nil
 }
 
 // Add this helper function
@@ -101,16 +166,18 @@ func generateTokenID() string {
 func (m *Manager) GenerateAccessToken(userID string,
 	role Role,
 	verified bool,
-	premiumUntil *int64) (string, error) {
+	premiumUntil *int64,
+	sessionID string) (string, error) {
 
 	now := indianstandardtime.Now()
 	services := GetServiceAccessByRole(role)
 
 	// Create the claims
 	claims := &Claims{
-		UserID:   userID,
-		Role:     role,
-		Services: services,
+		UserID:    userID,
+		Role:      role,
+		Services:  services,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        generateTokenID(), // ADD THIS LINE
 			ExpiresAt: jwt.NewNumericDate(now.Add(m.config.AccessTokenTTL)),
@@ -120,20 +187,46 @@ func (m *Manager) GenerateAccessToken(userID string,
 		},
 	}
 
-	// Create a new token using HMAC SHA256 signing method
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	// Sign the token with the active signing key and return it
+	return m.signToken(claims)
+}
 
-	// Sign the token using the secret key and return it
-	return token.SignedString([]byte(m.config.SecretKey))
+// GenerateAdminAccessToken creates an ADMIN access token carrying the admin's
+// staff role and the permissions that role grants.
+func (m *Manager) GenerateAdminAccessToken(adminID string,
+	adminRole AdminRole,
+	permissions []Permission,
+	sessionID string) (string, error) {
+
+	now := indianstandardtime.Now()
+
+	claims := &Claims{
+		UserID:      adminID,
+		Role:        RoleAdmin,
+		Services:    GetServiceAccessByRole(RoleAdmin),
+		SessionID:   sessionID,
+		AdminRole:   adminRole,
+		Permissions: permissions,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        generateTokenID(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.config.AccessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    m.config.Issuer,
+		},
+	}
+
+	return m.signToken(claims)
 }
 
 // Update your GenerateRefreshToken method
-func (m *Manager) GenerateRefreshToken(userID string) (string, error) {
+func (m *Manager) GenerateRefreshToken(userID string, sessionID string) (string, error) {
 	now := indianstandardtime.Now()
 
-	// Refresh token only stores minimal info: just the user ID
+	// Refresh token only stores minimal info: the user ID and the session it belongs to
 	claims := &Claims{
-		UserID: userID,
+		UserID:    userID,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        generateTokenID(), // ADD THIS LINE
 			ExpiresAt: jwt.NewNumericDate(now.Add(m.config.RefreshTokenTTL)),
@@ -143,8 +236,7 @@ func (m *Manager) GenerateRefreshToken(userID string) (string, error) {
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.config.SecretKey))
+	return m.signToken(claims)
 }
 
 // ValidateToken takes a token string and verifies its validity.
@@ -153,13 +245,7 @@ func (m *Manager) ValidateToken(tokenString string) (*Claims, error) {
 	claims := &Claims{}
 
 	// Parse and validate the token with claims
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		// Ensure the token was signed with HMAC
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(m.config.SecretKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, claims, m.verificationKey)
 
 	// If the token failed to parse or verify (e.g., invalid signature, expired, tampered), return an error.
 	if err != nil {
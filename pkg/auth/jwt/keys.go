@@ -0,0 +1,180 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Signing algorithms supported by the Manager
+const (
+	AlgorithmHS256 = "HS256" // Shared secret, kept for tokens issued before key rotation was introduced
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// Key is an asymmetric key used to sign or verify tokens.
+// The ID is written to the "kid" header of every token it signs, so verifiers
+// can pick the right public key while several keys are active during a rotation.
+type Key struct {
+	ID         string
+	Algorithm  string            // RS256 or EdDSA
+	PrivateKey crypto.PrivateKey // Only needed by the issuer; nil for verification-only keys
+	PublicKey  crypto.PublicKey
+}
+
+// LoadSigningKey reads a PEM encoded private key (PKCS#1 or PKCS#8) from disk.
+// The public key is derived from it, so the key can also verify its own tokens.
+func LoadSigningKey(id, algorithm, privateKeyFile string) (*Key, error) {
+	data, err := os.ReadFile(privateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key %q: %w", id, err)
+	}
+
+	privateKey, err := ParsePrivateKeyPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key %q: %w", id, err)
+	}
+
+	key := &Key{
+		ID:         id,
+		Algorithm:  algorithm,
+		PrivateKey: privateKey,
+		PublicKey:  privateKey.Public(),
+	}
+	if err := key.validate(); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// LoadVerificationKey reads a PEM encoded public key (PKIX) from disk
+func LoadVerificationKey(id, algorithm, publicKeyFile string) (*Key, error) {
+	data, err := os.ReadFile(publicKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key %q: %w", id, err)
+	}
+
+	publicKey, err := ParsePublicKeyPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key %q: %w", id, err)
+	}
+
+	key := &Key{
+		ID:        id,
+		Algorithm: algorithm,
+		PublicKey: publicKey,
+	}
+	if err := key.validate(); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// VerificationKeyConfig points to a public key published by the auth service.
+// Services that only verify tokens list one entry per key that is still active.
+type VerificationKeyConfig struct {
+	ID            string `mapstructure:"id"`
+	Algorithm     string `mapstructure:"algorithm"` // RS256 or EdDSA
+	PublicKeyFile string `mapstructure:"public_key_file"`
+}
+
+// LoadVerificationKeys loads every configured public key
+func LoadVerificationKeys(configs []VerificationKeyConfig) ([]*Key, error) {
+	keys := make([]*Key, 0, len(configs))
+	for _, cfg := range configs {
+		key, err := LoadVerificationKey(cfg.ID, cfg.Algorithm, cfg.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// ParsePrivateKeyPEM parses an RSA (PKCS#1 or PKCS#8) or Ed25519 (PKCS#8) private key
+func ParsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case ed25519.PrivateKey:
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+// ParsePublicKeyPEM parses an RSA or Ed25519 public key in PKIX form
+func ParsePublicKeyPEM(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return k, nil
+	case ed25519.PublicKey:
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// validate checks that the key has an ID and that its type matches the algorithm
+func (k *Key) validate() error {
+	if k.ID == "" {
+		return fmt.Errorf("key ID is required")
+	}
+
+	switch k.Algorithm {
+	case AlgorithmRS256:
+		if _, ok := k.PublicKey.(*rsa.PublicKey); !ok {
+			return fmt.Errorf("key %q: %s requires an RSA key", k.ID, k.Algorithm)
+		}
+	case AlgorithmEdDSA:
+		if _, ok := k.PublicKey.(ed25519.PublicKey); !ok {
+			return fmt.Errorf("key %q: %s requires an Ed25519 key", k.ID, k.Algorithm)
+		}
+	default:
+		return fmt.Errorf("key %q: unsupported algorithm %q", k.ID, k.Algorithm)
+	}
+	return nil
+}
+
+// signingMethod returns the jwt signing method for the key's algorithm
+func (k *Key) signingMethod() jwt.SigningMethod {
+	switch k.Algorithm {
+	case AlgorithmRS256:
+		return jwt.SigningMethodRS256
+	case AlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA
+	default:
+		return nil
+	}
+}
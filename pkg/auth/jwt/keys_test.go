@@ -0,0 +1,131 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newTestRSAKey(t *testing.T, id string) *Key {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+	return &Key{ID: id, Algorithm: AlgorithmRS256, PrivateKey: privateKey, PublicKey: &privateKey.PublicKey}
+}
+
+func newTestEd25519Key(t *testing.T, id string) *Key {
+	t.Helper()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate Ed25519 key: %v", err)
+	}
+	return &Key{ID: id, Algorithm: AlgorithmEdDSA, PrivateKey: privateKey, PublicKey: publicKey}
+}
+
+func testClaims() *Claims {
+	now := time.Now()
+	return &Claims{
+		UserID: "123e4567-e89b-12d3-a456-426614174000",
+		Role:   RoleUser,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    "test",
+		},
+	}
+}
+
+// signWith signs the test claims with the given method and key, setting kid when it is not empty
+func signWith(t *testing.T, method jwt.SigningMethod, kid string, key interface{}) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, testClaims())
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func TestValidateTokenAcceptsSigningKey(t *testing.T) {
+	for _, key := range []*Key{newTestRSAKey(t, "rsa-1"), newTestEd25519Key(t, "ed-1")} {
+		m := NewManager(Config{SigningKey: key, AccessTokenTTL: time.Minute, Issuer: "test"})
+
+		token, err := m.GenerateAccessToken("user-1", RoleUser, true, nil, "session-1")
+		if err != nil {
+			t.Fatalf("%s: GenerateAccessToken: %v", key.Algorithm, err)
+		}
+		claims, err := m.ValidateToken(token)
+		if err != nil {
+			t.Fatalf("%s: ValidateToken: %v", key.Algorithm, err)
+		}
+		if claims.UserID != "user-1" || claims.SessionID != "session-1" {
+			t.Errorf("%s: claims = %+v, want user-1 and session-1", key.Algorithm, claims)
+		}
+	}
+}
+
+func TestValidateTokenRejectsUnknownKeyID(t *testing.T) {
+	trusted := newTestEd25519Key(t, "trusted")
+	other := newTestEd25519Key(t, "other")
+	m := NewManager(Config{SigningKey: trusted})
+
+	token := signWith(t, jwt.SigningMethodEdDSA, other.ID, other.PrivateKey)
+	if _, err := m.ValidateToken(token); err == nil {
+		t.Fatal("token signed with an unknown kid was accepted")
+	}
+}
+
+func TestValidateTokenRejectsAlgorithmOtherThanKeys(t *testing.T) {
+	rsaKey := newTestRSAKey(t, "rsa-1")
+	edKey := newTestEd25519Key(t, "ed-1")
+	m := NewManager(Config{VerificationKeys: []*Key{rsaKey, edKey}})
+
+	// A valid EdDSA signature presented under the RSA key's kid
+	token := signWith(t, jwt.SigningMethodEdDSA, rsaKey.ID, edKey.PrivateKey)
+	if _, err := m.ValidateToken(token); err == nil {
+		t.Fatal("EdDSA token with the kid of an RS256 key was accepted")
+	}
+
+	// RS512 with the right RSA key is still not the algorithm pinned to the kid
+	token = signWith(t, jwt.SigningMethodRS512, rsaKey.ID, rsaKey.PrivateKey)
+	if _, err := m.ValidateToken(token); err == nil {
+		t.Fatal("RS512 token with the kid of an RS256 key was accepted")
+	}
+}
+
+func TestValidateTokenRejectsHS256WithAsymmetricKey(t *testing.T) {
+	rsaKey := newTestRSAKey(t, "rsa-1")
+	m := NewManager(Config{SigningKey: rsaKey})
+
+	// HMAC signed with the published public key, the classic algorithm confusion attack
+	der, err := x509.MarshalPKIXPublicKey(rsaKey.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	token := signWith(t, jwt.SigningMethodHS256, rsaKey.ID, publicPEM)
+	if _, err := m.ValidateToken(token); err == nil {
+		t.Fatal("HS256 token with the kid of an RS256 key was accepted")
+	}
+
+	// Without a kid, HS256 is only accepted while a shared secret is configured
+	token = signWith(t, jwt.SigningMethodHS256, "", publicPEM)
+	if _, err := m.ValidateToken(token); err == nil {
+		t.Fatal("HS256 token without a kid was accepted with no shared secret configured")
+	}
+}
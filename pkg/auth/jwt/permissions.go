@@ -0,0 +1,40 @@
+package jwt
+
+// AdminRole is the staff role of an ADMIN account.
+// It decides which permissions the admin's tokens carry.
+type AdminRole string
+
+const (
+	AdminRoleSuperAdmin AdminRole = "super_admin"
+	AdminRoleModerator  AdminRole = "moderator"
+	AdminRoleSupport    AdminRole = "support"
+	AdminRoleFinance    AdminRole = "finance"
+)
+
+// Permission is a single action an admin is allowed to perform.
+// The permissions of each admin role are stored in the auth database.
+type Permission string
+
+const (
+	PermissionUsersRead        Permission = "users:read"        // View user accounts and profiles
+	PermissionUsersManage      Permission = "users:manage"      // Suspend, ban or otherwise change user accounts
+	PermissionPremiumGrant     Permission = "premium:grant"     // Grant or extend premium membership
+	PermissionPaymentsRead     Permission = "payments:read"     // View payments and subscriptions
+	PermissionAdminsManage     Permission = "admins:manage"     // Create and manage other admin accounts
+	PermissionTaxonomiesManage Permission = "taxonomies:manage" // Edit the profession, education, community and location options
+)
+
+// HasPermission reports whether the token grants the permission
+func (c *Claims) HasPermission(permission Permission) bool {
+	return HasPermission(c.Permissions, permission)
+}
+
+// HasPermission reports whether permission is one of the granted permissions
+func HasPermission(granted []Permission, permission Permission) bool {
+	for _, p := range granted {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
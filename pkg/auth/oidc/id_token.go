@@ -0,0 +1,153 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	authjwt "github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
+)
+
+// Clock skew tolerated between the provider and this service
+const idTokenLeeway = time.Minute
+
+// Signing keys are refetched at most this often when a token names an unknown key
+const keyRefreshInterval = time.Minute
+
+// IDTokenClaims are the claims of a validated ID token the caller needs to sign the user in
+type IDTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce           string       `json:"nonce"`
+	AuthorizedParty string       `json:"azp,omitempty"`
+	Email           string       `json:"email,omitempty"`
+	EmailVerified   flexibleBool `json:"email_verified,omitempty"`
+	Name            string       `json:"name,omitempty"`
+}
+
+// VerifyIDToken checks the signature of the ID token against the provider's
+// JWKS and validates the issuer, audience, expiry and nonce.
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*IDTokenClaims, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &IDTokenClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims,
+		func(token *jwt.Token) (interface{}, error) {
+			kid, _ := token.Header["kid"].(string)
+			key, err := p.signingKey(ctx, doc, kid)
+			if err != nil {
+				return nil, err
+			}
+			if key.Algorithm != token.Method.Alg() {
+				return nil, fmt.Errorf("token algorithm %s does not match key %q", token.Method.Alg(), key.ID)
+			}
+			return key.PublicKey, nil
+		},
+		jwt.WithValidMethods([]string{authjwt.AlgorithmRS256, authjwt.AlgorithmEdDSA}),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(idTokenLeeway),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+	// OpenID Connect Core section 3.1.3.7: with several audiences the token must be issued to this client
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.config.ClientID {
+		return nil, fmt.Errorf("%w: token was issued to %q", ErrInvalidIDToken, claims.AuthorizedParty)
+	}
+
+	return claims, nil
+}
+
+// signingKey returns the provider key with the given ID, refetching the JWKS
+// when the key is unknown so provider key rotations are picked up
+func (p *Provider) signingKey(ctx context.Context, doc *discoveryDocument, kid string) (*authjwt.Key, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key := p.lookupKey(kid); key != nil {
+		return key, nil
+	}
+	if time.Since(p.keysFetchedAt) < keyRefreshInterval {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	var jwks authjwt.JWKS
+	if err := p.getJSON(ctx, doc.JWKSURI, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch signing keys: %w", err)
+	}
+
+	keys := make(map[string]*authjwt.Key, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if jwk.Algorithm == "" {
+			// "alg" is optional in a JWKS; assume the algorithm the key type is used with
+			jwk.Algorithm = defaultAlgorithms[jwk.KeyType]
+		}
+		// Keys of unsupported types, such as EC keys, are skipped instead of failing the whole set
+		key, err := jwk.VerificationKey()
+		if err != nil {
+			continue
+		}
+		keys[key.ID] = key
+	}
+
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+
+	if key := p.lookupKey(kid); key != nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookupKey finds a cached key. Tokens without a "kid" header are accepted
+// when the provider publishes a single key.
+func (p *Provider) lookupKey(kid string) *authjwt.Key {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key
+		}
+	}
+	return p.keys[kid]
+}
+
+var defaultAlgorithms = map[string]string{
+	"RSA": authjwt.AlgorithmRS256,
+	"OKP": authjwt.AlgorithmEdDSA,
+}
+
+// flexibleBool accepts booleans sent as JSON strings, which some providers
+// use for email_verified
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case bool:
+		*b = flexibleBool(v)
+	case string:
+		*b = flexibleBool(v == "true")
+	default:
+		*b = false
+	}
+	return nil
+}
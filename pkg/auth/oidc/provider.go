@@ -0,0 +1,245 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	authjwt "github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
+)
+
+// DefaultScopes are requested when a provider does not configure its own.
+// "openid" is always requested, even when it is missing from Config.Scopes.
+var DefaultScopes = []string{"openid", "email", "profile"}
+
+// Errors returned while talking to a provider
+var (
+	ErrDiscoveryFailed = errors.New("oidc: failed to load provider configuration")
+	ErrExchangeFailed  = errors.New("oidc: authorization code exchange failed")
+	ErrInvalidIDToken  = errors.New("oidc: invalid ID token")
+)
+
+// Config describes a single OpenID Connect provider
+type Config struct {
+	Name         string   // Name used in routes, e.g. "google"
+	Issuer       string   // Issuer URL; the discovery document is read from <issuer>/.well-known/openid-configuration
+	ClientID     string   // Client registered with the provider
+	ClientSecret string   // Empty for public clients that rely on PKCE only
+	RedirectURL  string   // Callback URL registered with the provider
+	Scopes       []string // Scopes to request; DefaultScopes when empty
+}
+
+// discoveryDocument holds the parts of the provider metadata the client uses
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is a client for one OpenID Connect provider. It only relies on
+// the standard discovery document, so any compliant issuer can be used,
+// including a local mock issuer during development.
+//
+// The discovery document is loaded on first use and the signing keys are
+// cached until a token signed with an unknown key forces a refresh.
+type Provider struct {
+	config     Config
+	httpClient *http.Client
+
+	mu            sync.Mutex
+	discovery     *discoveryDocument
+	keys          map[string]*authjwt.Key
+	keysFetchedAt time.Time
+}
+
+// NewProvider validates the provider configuration. No request is made until
+// the provider is first used. A nil httpClient uses a client with a 10 second timeout.
+func NewProvider(config Config, httpClient *http.Client) (*Provider, error) {
+	if config.Name == "" {
+		return nil, fmt.Errorf("oidc: provider name is required")
+	}
+	if config.Issuer == "" || config.ClientID == "" || config.RedirectURL == "" {
+		return nil, fmt.Errorf("oidc: provider %q requires an issuer, client ID and redirect URL", config.Name)
+	}
+	if _, err := url.ParseRequestURI(config.Issuer); err != nil {
+		return nil, fmt.Errorf("oidc: provider %q has an invalid issuer: %w", config.Name, err)
+	}
+
+	config.Issuer = strings.TrimSuffix(config.Issuer, "/")
+	config.Scopes = withOpenIDScope(config.Scopes)
+
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &Provider{
+		config:     config,
+		httpClient: httpClient,
+	}, nil
+}
+
+// Name returns the name the provider was configured with
+func (p *Provider) Name() string {
+	return p.config.Name
+}
+
+// AuthCodeURL returns the provider URL the user is sent to for signing in.
+// state and nonce are echoed back in the callback and the ID token, and
+// codeChallenge is the S256 challenge of the verifier passed to Exchange.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.config.ClientID},
+		"redirect_uri":          {p.config.RedirectURL},
+		"scope":                 {strings.Join(p.config.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {CodeChallengeMethod},
+	}
+
+	separator := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return doc.AuthorizationEndpoint + separator + params.Encode(), nil
+}
+
+// TokenResponse is the provider's answer to an authorization code exchange
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// Exchange trades the authorization code from the callback for the user's tokens
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier string) (*TokenResponse, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"client_id":     {p.config.ClientID},
+		"code_verifier": {codeVerifier},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExchangeFailed, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		// RFC 6749 section 2.3.1: credentials are form encoded before being used for basic auth
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExchangeFailed, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExchangeFailed, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var tokenErr struct {
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+		}
+		if json.Unmarshal(body, &tokenErr) == nil && tokenErr.Error != "" {
+			return nil, fmt.Errorf("%w: %s: %s", ErrExchangeFailed, tokenErr.Error, tokenErr.Description)
+		}
+		return nil, fmt.Errorf("%w: unexpected status %d", ErrExchangeFailed, resp.StatusCode)
+	}
+
+	var tokens TokenResponse
+	if err := json.Unmarshal(body, &tokens); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExchangeFailed, err)
+	}
+	if tokens.IDToken == "" {
+		return nil, fmt.Errorf("%w: response has no ID token", ErrExchangeFailed)
+	}
+	return &tokens, nil
+}
+
+// maxResponseSize limits how much of a provider response is read
+const maxResponseSize = 1 << 20
+
+// discover loads the provider metadata once. Failed attempts are retried on the next call.
+func (p *Provider) discover(ctx context.Context) (*discoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var doc discoveryDocument
+	if err := p.getJSON(ctx, p.config.Issuer+"/.well-known/openid-configuration", &doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDiscoveryFailed, err)
+	}
+
+	// OpenID Connect Discovery section 4.3: the issuer must match the one the document was read from
+	if strings.TrimSuffix(doc.Issuer, "/") != p.config.Issuer {
+		return nil, fmt.Errorf("%w: issuer %q does not match %q", ErrDiscoveryFailed, doc.Issuer, p.config.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: document is missing required endpoints", ErrDiscoveryFailed)
+	}
+
+	p.discovery = &doc
+	return p.discovery, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: unexpected status %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v)
+}
+
+func withOpenIDScope(scopes []string) []string {
+	if len(scopes) == 0 {
+		return DefaultScopes
+	}
+	for _, scope := range scopes {
+		if scope == "openid" {
+			return scopes
+		}
+	}
+	return append([]string{"openid"}, scopes...)
+}
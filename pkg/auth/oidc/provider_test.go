@@ -0,0 +1,322 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	authjwt "github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
+)
+
+const (
+	testClientID    = "qubool-test"
+	testRedirectURL = "https://example.com/api/v1/auth/oidc/mock/callback"
+	testCode        = "auth-code"
+	testKeyID       = "mock-key"
+)
+
+// mockIssuer is a minimal OpenID Connect provider serving the discovery
+// document, its JWKS and a token endpoint that checks the PKCE verifier
+type mockIssuer struct {
+	server *httptest.Server
+	key    ed25519.PrivateKey
+
+	mu            sync.Mutex
+	codeChallenge string // Challenge of the last authorization request
+	nonce         string // Nonce of the last authorization request
+
+	// Overrides for the discovery document and the claims of issued ID tokens
+	discoveryIssuer string
+	tokenIssuer     string
+	tokenAudience   string
+	tokenNonce      string
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	m := &mockIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", m.handleDiscovery)
+	mux.HandleFunc("/jwks", m.handleJWKS)
+	mux.HandleFunc("/token", m.handleToken)
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+func (m *mockIssuer) issuer() string {
+	return m.server.URL
+}
+
+// authorize plays the provider's sign-in page: it remembers the PKCE challenge
+// and nonce of the authorization URL the user was sent to
+func (m *mockIssuer) authorize(t *testing.T, authURL string) url.Values {
+	t.Helper()
+
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("invalid authorization URL: %v", err)
+	}
+	params := parsed.Query()
+
+	m.mu.Lock()
+	m.codeChallenge = params.Get("code_challenge")
+	m.nonce = params.Get("nonce")
+	m.mu.Unlock()
+	return params
+}
+
+func (m *mockIssuer) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	issuer := m.issuer()
+	if m.discoveryIssuer != "" {
+		issuer = m.discoveryIssuer
+	}
+	writeJSON(w, http.StatusOK, map[string]string{
+		"issuer":                 issuer,
+		"authorization_endpoint": m.issuer() + "/authorize",
+		"token_endpoint":         m.issuer() + "/token",
+		"jwks_uri":               m.issuer() + "/jwks",
+	})
+}
+
+func (m *mockIssuer) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, authjwt.JWKS{Keys: []authjwt.JSONWebKey{{
+		KeyType:   "OKP",
+		KeyID:     testKeyID,
+		Use:       "sig",
+		Algorithm: authjwt.AlgorithmEdDSA,
+		Curve:     "Ed25519",
+		X:         base64.RawURLEncoding.EncodeToString(m.key.Public().(ed25519.PublicKey)),
+	}}})
+}
+
+func (m *mockIssuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	m.mu.Lock()
+	challenge, nonce := m.codeChallenge, m.nonce
+	m.mu.Unlock()
+
+	if r.PostForm.Get("grant_type") != "authorization_code" || r.PostForm.Get("code") != testCode ||
+		r.PostForm.Get("redirect_uri") != testRedirectURL {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	if CodeChallenge(r.PostForm.Get("code_verifier")) != challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{
+			"error":             "invalid_grant",
+			"error_description": "PKCE verification failed",
+		})
+		return
+	}
+
+	idToken, err := m.signIDToken(nonce)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": "provider-access-token",
+		"token_type":   "Bearer",
+		"id_token":     idToken,
+		"expires_in":   3600,
+	})
+}
+
+func (m *mockIssuer) signIDToken(nonce string) (string, error) {
+	issuer, audience := m.issuer(), testClientID
+	if m.tokenIssuer != "" {
+		issuer = m.tokenIssuer
+	}
+	if m.tokenAudience != "" {
+		audience = m.tokenAudience
+	}
+	if m.tokenNonce != "" {
+		nonce = m.tokenNonce
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+		"iss":            issuer,
+		"aud":            audience,
+		"sub":            "provider-user-1",
+		"iat":            now.Unix(),
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"nonce":          nonce,
+		"email":          "user@example.com",
+		"email_verified": "true",
+	})
+	token.Header["kid"] = testKeyID
+	return token.SignedString(m.key)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newTestProvider(t *testing.T, issuer string) *Provider {
+	t.Helper()
+
+	provider, err := NewProvider(Config{
+		Name:        "mock",
+		Issuer:      issuer,
+		ClientID:    testClientID,
+		RedirectURL: testRedirectURL,
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewProvider: %v", err)
+	}
+	return provider
+}
+
+// signIn runs the authorization request and callback against the mock issuer
+// and returns the validated claims of the ID token
+func signIn(t *testing.T, m *mockIssuer, provider *Provider, exchangeVerifier func(verifier string) string) (*IDTokenClaims, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	verifier, err := NewCodeVerifier()
+	if err != nil {
+		t.Fatalf("NewCodeVerifier: %v", err)
+	}
+	authURL, err := provider.AuthCodeURL(ctx, "state-1", "nonce-1", CodeChallenge(verifier))
+	if err != nil {
+		return nil, err
+	}
+	m.authorize(t, authURL)
+
+	if exchangeVerifier != nil {
+		verifier = exchangeVerifier(verifier)
+	}
+	tokens, err := provider.Exchange(ctx, testCode, verifier)
+	if err != nil {
+		return nil, err
+	}
+	return provider.VerifyIDToken(ctx, tokens.IDToken, "nonce-1")
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	m := newMockIssuer(t)
+	provider := newTestProvider(t, m.issuer())
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", CodeChallenge("verifier"))
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	params := m.authorize(t, authURL)
+
+	want := map[string]string{
+		"response_type":         "code",
+		"client_id":             testClientID,
+		"redirect_uri":          testRedirectURL,
+		"scope":                 "openid email profile",
+		"state":                 "state-1",
+		"nonce":                 "nonce-1",
+		"code_challenge":        CodeChallenge("verifier"),
+		"code_challenge_method": CodeChallengeMethod,
+	}
+	for name, value := range want {
+		if got := params.Get(name); got != value {
+			t.Errorf("%s = %q, want %q", name, got, value)
+		}
+	}
+}
+
+func TestSignInCallback(t *testing.T) {
+	m := newMockIssuer(t)
+	provider := newTestProvider(t, m.issuer())
+
+	claims, err := signIn(t, m, provider, nil)
+	if err != nil {
+		t.Fatalf("sign-in failed: %v", err)
+	}
+
+	if claims.Subject != "provider-user-1" {
+		t.Errorf("Subject = %q, want %q", claims.Subject, "provider-user-1")
+	}
+	if claims.Email != "user@example.com" || !claims.EmailVerified {
+		t.Errorf("Email = %q verified %v, want a verified user@example.com", claims.Email, claims.EmailVerified)
+	}
+}
+
+func TestExchangeRejectsWrongCodeVerifier(t *testing.T) {
+	m := newMockIssuer(t)
+	provider := newTestProvider(t, m.issuer())
+
+	_, err := signIn(t, m, provider, func(string) string {
+		other, err := NewCodeVerifier()
+		if err != nil {
+			t.Fatalf("NewCodeVerifier: %v", err)
+		}
+		return other
+	})
+	if !errors.Is(err, ErrExchangeFailed) {
+		t.Fatalf("err = %v, want %v", err, ErrExchangeFailed)
+	}
+}
+
+func TestVerifyIDTokenRejectsMismatchedClaims(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(m *mockIssuer)
+	}{
+		{
+			name:  "nonce",
+			setup: func(m *mockIssuer) { m.tokenNonce = "other-nonce" },
+		},
+		{
+			name:  "audience",
+			setup: func(m *mockIssuer) { m.tokenAudience = "other-client" },
+		},
+		{
+			name:  "issuer",
+			setup: func(m *mockIssuer) { m.tokenIssuer = "https://other-issuer.example.com" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMockIssuer(t)
+			tt.setup(m)
+			provider := newTestProvider(t, m.issuer())
+
+			_, err := signIn(t, m, provider, nil)
+			if !errors.Is(err, ErrInvalidIDToken) {
+				t.Fatalf("err = %v, want %v", err, ErrInvalidIDToken)
+			}
+		})
+	}
+}
+
+func TestDiscoveryRejectsIssuerMismatch(t *testing.T) {
+	m := newMockIssuer(t)
+	m.discoveryIssuer = "https://other-issuer.example.com"
+	provider := newTestProvider(t, m.issuer())
+
+	_, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", CodeChallenge("verifier"))
+	if !errors.Is(err, ErrDiscoveryFailed) {
+		t.Fatalf("err = %v, want %v", err, ErrDiscoveryFailed)
+	}
+}
@@ -0,0 +1,71 @@
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	redisdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/redis"
+)
+
+// Redis key prefixes of the revocation markers written by the auth service
+const (
+	BlacklistPrefix          = "blacklist:"            // blacklist:<jti>, set on logout
+	TokensRevokedAfterPrefix = "tokens_revoked_after:" // tokens_revoked_after:<userID>, set on password change, ban, etc.
+)
+
+// Checker reads the revocation markers so that services sharing the auth
+// service's Redis can reject access tokens that are still within their TTL.
+type Checker struct {
+	client *redisdb.Client
+}
+
+// NewChecker creates a revocation checker on top of the shared Redis client
+func NewChecker(client *redisdb.Client) *Checker {
+	return &Checker{client: client}
+}
+
+// IsTokenBlacklisted checks if the token with the given ID was blacklisted
+func (c *Checker) IsTokenBlacklisted(ctx context.Context, tokenID string) (bool, error) {
+	return c.client.Exists(ctx, BlacklistPrefix+tokenID)
+}
+
+// IsUserTokenRevoked checks if a token of the user issued at issuedAt was
+// revoked by a "revoke all tokens issued before" marker.
+func (c *Checker) IsUserTokenRevoked(ctx context.Context, userID string, issuedAt time.Time) (bool, error) {
+	value, err := c.client.Get(ctx, TokensRevokedAfterPrefix+userID)
+	if err == redis.Nil {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	revokedAt, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid revocation marker for user %s: %w", userID, err)
+	}
+
+	return issuedAt.Unix() < revokedAt, nil
+}
+
+// IsRevoked checks both markers for a token
+func (c *Checker) IsRevoked(ctx context.Context, tokenID, userID string, issuedAt time.Time) (bool, error) {
+	if tokenID != "" {
+		blacklisted, err := c.IsTokenBlacklisted(ctx, tokenID)
+		if err != nil {
+			return false, fmt.Errorf("failed to check token blacklist: %w", err)
+		}
+		if blacklisted {
+			return true, nil
+		}
+	}
+
+	revoked, err := c.IsUserTokenRevoked(ctx, userID, issuedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to check token revocation: %w", err)
+	}
+	return revoked, nil
+}
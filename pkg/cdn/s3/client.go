@@ -23,6 +23,7 @@ var (
 	ErrInvalidFile     = errors.New("invalid file")
 	ErrFileTooLarge    = errors.New("file size exceeds the maximum allowed size")
 	ErrInvalidFileType = errors.New("file type not supported")
+	ErrObjectNotFound  = errors.New("object not found")
 )
 
 // MaxFileSize is the maximum allowed file size (5MB)
@@ -236,8 +237,54 @@ func (s *Service) GetPresignedURL(ctx context.Context, key string) (string, erro
 	return presignedReq.URL, nil
 }
 
+// UploadObject stores raw data under the given key.
+// Unlike photo uploads there is no size or type validation, so callers must only pass data they produced.
+func (s *Service) UploadObject(ctx context.Context, key string, data []byte, contentType string) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return fmt.Errorf("uploading to S3/MinIO: %w", err)
+	}
+
+	return nil
+}
+
+// DownloadObject reads an object from the bucket by key.
+// Returns ErrObjectNotFound if the key does not exist.
+func (s *Service) DownloadObject(ctx context.Context, key string) ([]byte, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("downloading from S3/MinIO: %w", err)
+	}
+	defer output.Body.Close()
+
+	data, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading object data: %w", err)
+	}
+
+	return data, nil
+}
+
 // DeletePhoto removes an object from the bucket by key.
 func (s *Service) DeletePhoto(ctx context.Context, key string) error {
+	return s.DeleteObject(ctx, key)
+}
+
+// DeleteObject removes an object from the bucket by key.
+// Deleting a key that does not exist is not an error.
+func (s *Service) DeleteObject(ctx context.Context, key string) error {
 	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
 		Bucket: aws.String(s.bucketName),
 		Key:    aws.String(key),
@@ -249,6 +296,52 @@ func (s *Service) DeletePhoto(ctx context.Context, key string) error {
 	return nil
 }
 
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Key          string
+	LastModified time.Time
+}
+
+// ListObjects returns every object whose key starts with the prefix
+func (s *Service) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucketName),
+		Prefix: aws.String(prefix),
+	})
+
+	var objects []ObjectInfo
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing S3/MinIO objects: %w", err)
+		}
+		for _, object := range page.Contents {
+			objects = append(objects, ObjectInfo{
+				Key:          aws.ToString(object.Key),
+				LastModified: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+// DeletePrefix removes every object whose key starts with the prefix and
+// returns how many were removed
+func (s *Service) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	objects, err := s.ListObjects(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, object := range objects {
+		if err := s.DeleteObject(ctx, object.Key); err != nil {
+			return i, err
+		}
+	}
+	return len(objects), nil
+}
+
 // CheckIfBucketExists returns true if the configured bucket already exists.
 func (s *Service) CheckIfBucketExists(ctx context.Context) (bool, error) {
 	_, err := s.client.HeadBucket(ctx, &s3.HeadBucketInput{
@@ -265,6 +358,27 @@ func (s *Service) CheckIfBucketExists(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// CreatePrivateBucket creates the bucket if it does not exist, without any public access.
+// Objects in it can only be shared through presigned URLs.
+func (s *Service) CreatePrivateBucket(ctx context.Context) error {
+	exists, err := s.CheckIfBucketExists(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	_, err = s.client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(s.bucketName),
+	})
+	if err != nil {
+		return fmt.Errorf("creating bucket: %w", err)
+	}
+
+	return nil
+}
+
 // CreateBucket creates the bucket if it does not exist, and makes it public.
 func (s *Service) CreateBucket(ctx context.Context) error {
 	exists, err := s.CheckIfBucketExists(ctx)
@@ -76,6 +76,54 @@ func (c *Client) TTL(ctx context.Context, key string) (time.Duration, error) {
 	return c.client.TTL(ctx, key).Result()
 }
 
+// SetNX stores a key-value pair only if the key does not exist yet.
+// It returns true if the value was stored.
+func (c *Client) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	return c.client.SetNX(ctx, key, value, expiration).Result()
+}
+
+// Incr increments the integer value stored at key by one and returns the new value.
+// A missing key is treated as 0, so the first call returns 1.
+func (c *Client) Incr(ctx context.Context, key string) (int64, error) {
+	return c.client.Incr(ctx, key).Result()
+}
+
+// incrWithExpiryScript increments a counter and starts its timeout when the
+// counter is created, in one step so a counter can never be left without one
+var incrWithExpiryScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// IncrWithExpiry increments the counter stored at key and returns the new value.
+// A new counter expires after the expiration; incrementing does not extend it.
+func (c *Client) IncrWithExpiry(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	return incrWithExpiryScript.Run(ctx, c.client, []string{key}, expiration.Milliseconds()).Int64()
+}
+
+// Expire sets a timeout on an existing key
+func (c *Client) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return c.client.Expire(ctx, key, expiration).Err()
+}
+
+// SAdd adds members to the set stored at key
+func (c *Client) SAdd(ctx context.Context, key string, members ...interface{}) error {
+	return c.client.SAdd(ctx, key, members...).Err()
+}
+
+// SRem removes members from the set stored at key
+func (c *Client) SRem(ctx context.Context, key string, members ...interface{}) error {
+	return c.client.SRem(ctx, key, members...).Err()
+}
+
+// SMembers returns all members of the set stored at key
+func (c *Client) SMembers(ctx context.Context, key string) ([]string, error) {
+	return c.client.SMembers(ctx, key).Result()
+}
+
 // GetClient returns the underlying redis client for use with health checkers
 func (c *Client) GetClient() *redis.Client {
 	return c.client
@@ -18,6 +18,7 @@ const (
 	Unauthorized        ErrorType = "UNAUTHORIZED"          // For 401 errors - User is not authenticated
 	Forbidden           ErrorType = "FORBIDDEN"             // For 403 errors - Authenticated but access denied
 	NotFound            ErrorType = "NOT_FOUND"             // For 404 errors - Resource not found
+	TooManyRequests     ErrorType = "TOO_MANY_REQUESTS"     // For 429 errors - Client is being rate limited
 	InternalServerError ErrorType = "INTERNAL_SERVER_ERROR" // For 500 errors - Unexpected server error
 
 	// Reusing HTTP constants for convenience
@@ -50,6 +51,8 @@ func (e *AppError) StatusCode() int {
 		return http.StatusForbidden
 	case NotFound:
 		return http.StatusNotFound
+	case TooManyRequests:
+		return http.StatusTooManyRequests
 	default:
 		return http.StatusInternalServerError
 	}
@@ -102,6 +105,11 @@ func NewNotFound(message string, err error) *AppError {
 	return NewError(NotFound, message, err)
 }
 
+// NewTooManyRequests creates a TooManyRequests error
+func NewTooManyRequests(message string, err error) *AppError {
+	return NewError(TooManyRequests, message, err)
+}
+
 // NewInternalServerError creates an InternalServerError
 func NewInternalServerError(message string, err error) *AppError {
 	return NewError(InternalServerError, message, err)
@@ -174,6 +182,8 @@ func FromGRPCError(err error) *AppError {
 		return NewUnauthorized(st.Message(), err)
 	case codes.PermissionDenied:
 		return NewForbidden(st.Message(), err)
+	case codes.ResourceExhausted:
+		return NewTooManyRequests(st.Message(), err)
 	default:
 		return NewInternalServerError("Internal server error", err)
 	}
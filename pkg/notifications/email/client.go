@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/smtp"
 	"strings"
+	"time"
 )
 
 var (
@@ -146,3 +147,58 @@ func (c *Client) SendOTPEmail(to, otp string) error {
 	body := fmt.Sprintf(`<h1>Verification Code</h1><p>Your code: <strong>%s</strong></p>`, otp)
 	return c.SendEmail(EmailData{To: to, Subject: "Your Verification Code", Body: body, IsHTML: true})
 }
+
+// SendAdminInviteEmail sends the link an invited admin uses to set up their account
+func (c *Client) SendAdminInviteEmail(to, role, inviteLink string, expiresIn time.Duration) error {
+	body := fmt.Sprintf(`<h1>You're Invited</h1>
+<p>You have been invited to join the admin team as <strong>%s</strong>.</p>
+<p><a href="%s">Set up your admin account</a></p>
+<p>This link expires in %d hours.</p>`, role, inviteLink, int(expiresIn.Hours()))
+	return c.SendEmail(EmailData{To: to, Subject: "Your Admin Account Invitation", Body: body, IsHTML: true})
+}
+
+// SendAccountLockedEmail notifies the account owner that logins were locked
+// after repeated failed attempts
+func (c *Client) SendAccountLockedEmail(to string, lockedFor time.Duration) error {
+	body := fmt.Sprintf(`<h1>Account Temporarily Locked</h1>
+<p>We detected several failed login attempts on your account, so logins have been locked for <strong>%d minutes</strong>.</p>
+<p>If this was not you, we recommend resetting your password once the lock expires.</p>`, int(lockedFor.Minutes()))
+	return c.SendEmail(EmailData{To: to, Subject: "Your Account Has Been Temporarily Locked", Body: body, IsHTML: true})
+}
+
+// SendNewDeviceLoginEmail warns the account owner about a login from a device or
+// network the account has not been used from before
+func (c *Client) SendNewDeviceLoginEmail(to string, loginAt time.Time, device, ipAddress, reportLink string) error {
+	body := fmt.Sprintf(`<h1>New Login to Your Account</h1>
+<p>Your account was just accessed from a device we have not seen before.</p>
+<p><strong>Time:</strong> %s<br>
+<strong>Device:</strong> %s<br>
+<strong>IP address:</strong> %s</p>
+<p>If this was you, no action is needed.</p>
+<p>If this wasn't you, <a href="%s">secure your account</a>. All devices will be signed out and you will need to reset your password.</p>`,
+		loginAt.Format("2 January 2006, 3:04 PM MST"), device, ipAddress, reportLink)
+	return c.SendEmail(EmailData{To: to, Subject: "New Login to Your Account", Body: body, IsHTML: true})
+}
+
+// SendContactChangedEmail tells the account owner, at their previous address,
+// that the email address or phone number of the account was changed
+func (c *Client) SendContactChangedEmail(to, contact string, changedAt time.Time) error {
+	body := fmt.Sprintf(`<h1>Your Contact Details Were Changed</h1>
+<p>The %s of your account was changed on %s.</p>
+<p>If you made this change, no action is needed.</p>
+<p>If you did not, reset your password right away and contact our support team.</p>`,
+		contact, changedAt.Format("2 January 2006, 3:04 PM MST"))
+	return c.SendEmail(EmailData{To: to, Subject: "Your Contact Details Were Changed", Body: body, IsHTML: true})
+}
+
+// SendPremiumExpiryReminderEmail reminds a premium member to renew before their membership ends
+func (c *Client) SendPremiumExpiryReminderEmail(to string, expiresAt time.Time, daysLeft int) error {
+	days := "days"
+	if daysLeft == 1 {
+		days = "day"
+	}
+	body := fmt.Sprintf(`<h1>Your Premium Membership Is Ending</h1>
+<p>Your premium membership expires in <strong>%d %s</strong>, on %s.</p>
+<p>Renew now to keep unlimited access to all premium features.</p>`, daysLeft, days, expiresAt.Format("2 January 2006"))
+	return c.SendEmail(EmailData{To: to, Subject: "Your Premium Membership Expires Soon", Body: body, IsHTML: true})
+}
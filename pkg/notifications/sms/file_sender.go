@@ -0,0 +1,58 @@
+package sms
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+)
+
+// FileSender is a development SMSSender that never contacts an SMS gateway.
+// Every message is logged and, when a path is configured, appended to that
+// file so OTPs can be read back while testing locally.
+type FileSender struct {
+	path   string
+	logger logging.Logger
+	mu     sync.Mutex
+}
+
+// NewFileSender creates a FileSender. An empty path only logs the messages.
+func NewFileSender(path string, logger logging.Logger) (*FileSender, error) {
+	if path != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create SMS output directory: %w", err)
+		}
+	}
+
+	return &FileSender{
+		path:   path,
+		logger: logger,
+	}, nil
+}
+
+// SendSMS logs the message and appends it to the output file
+func (s *FileSender) SendSMS(to, message string) error {
+	s.logger.Info("SMS sent", "to", to, "message", message)
+
+	if s.path == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open SMS output file: %w", err)
+	}
+	defer f.Close()
+
+	line := fmt.Sprintf("%s\t%s\t%s\n", indianstandardtime.Now().Format("2006-01-02 15:04:05"), to, message)
+	if _, err := f.WriteString(line); err != nil {
+		return fmt.Errorf("failed to write SMS: %w", err)
+	}
+	return nil
+}
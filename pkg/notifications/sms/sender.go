@@ -0,0 +1,21 @@
+package sms
+
+import "fmt"
+
+// SMSSender delivers text messages to phone numbers in E.164 format (e.g. "+919876543210").
+// Implementations wrap an SMS gateway; FileSender is used for local development.
+type SMSSender interface {
+	SendSMS(to, message string) error
+}
+
+// SendOTP sends a verification code to the given phone number
+func SendOTP(sender SMSSender, to, otp string) error {
+	message := fmt.Sprintf("%s is your Qubool Kallyanam verification code. Do not share it with anyone.", otp)
+	return sender.SendSMS(to, message)
+}
+
+// SendPhoneChanged tells the previous phone number of an account that it was replaced
+func SendPhoneChanged(sender SMSSender, to string) error {
+	message := "The phone number of your Qubool Kallyanam account was changed. If you did not do this, reset your password right away."
+	return sender.SendSMS(to, message)
+}
@@ -0,0 +1,133 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Config holds the parameters of the time-based codes (RFC 6238).
+// The defaults match what common authenticator apps expect.
+type Config struct {
+	Digits int           // Number of digits in a code
+	Period time.Duration // How long a single code is valid for
+	Skew   int           // Number of periods before and after the current one that are also accepted
+}
+
+// DefaultConfig returns the standard 6 digit, 30 second configuration
+func DefaultConfig() Config {
+	return Config{
+		Digits: 6,
+		Period: 30 * time.Second,
+		Skew:   1,
+	}
+}
+
+// secretSize is the length of generated secrets in bytes (160 bits, as recommended for HMAC-SHA1)
+const secretSize = 20
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret creates a new random base32 encoded secret
+func GenerateSecret() (string, error) {
+	bytes := make([]byte, secretSize)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	return encoding.EncodeToString(bytes), nil
+}
+
+// ProvisioningURI builds the otpauth:// URI that authenticator apps read from a QR code.
+// Example: ProvisioningURI(cfg, "Qubool Kallyanam", "admin@example.com", secret)
+func ProvisioningURI(config Config, issuer, account, secret string) string {
+	label := url.PathEscape(issuer + ":" + account)
+
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprintf("%d", config.Digits))
+	params.Set("period", fmt.Sprintf("%d", int(config.Period.Seconds())))
+
+	// Authenticator apps expect spaces as %20 rather than the query style "+"
+	return "otpauth://totp/" + label + "?" + strings.ReplaceAll(params.Encode(), "+", "%20")
+}
+
+// GenerateCode returns the code for the given secret at time t
+func GenerateCode(config Config, secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, counterAt(config, t), config.Digits), nil
+}
+
+// Validate checks the code against the secret at time t, allowing for clock skew.
+// It returns the counter of the matching period so callers can reject a code
+// that has already been used.
+func Validate(config Config, secret, code string, t time.Time) (bool, int64, error) {
+	code = strings.TrimSpace(code)
+	if len(code) != config.Digits {
+		return false, 0, nil
+	}
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return false, 0, err
+	}
+
+	current := counterAt(config, t)
+	for offset := -config.Skew; offset <= config.Skew; offset++ {
+		counter := current + int64(offset)
+		if counter < 0 {
+			continue
+		}
+		expected := hotp(key, counter, config.Digits)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return true, counter, nil
+		}
+	}
+
+	return false, 0, nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := encoding.DecodeString(strings.TrimRight(normalized, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+	return key, nil
+}
+
+func counterAt(config Config, t time.Time) int64 {
+	return t.Unix() / int64(config.Period.Seconds())
+}
+
+// hotp computes the HMAC-based one-time password (RFC 4226) for the counter
+func hotp(key []byte, counter int64, digits int) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < digits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, value%modulo)
+}
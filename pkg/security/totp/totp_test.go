@@ -0,0 +1,111 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 seed of the RFC 6238 test vectors, "12345678901234567890" in base32
+var rfcSecret = encoding.EncodeToString([]byte("12345678901234567890"))
+
+func rfcConfig() Config {
+	return Config{Digits: 8, Period: 30 * time.Second, Skew: 1}
+}
+
+func TestGenerateCodeRFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "94287082"},
+		{unix: 1111111109, want: "07081804"},
+		{unix: 1111111111, want: "14050471"},
+		{unix: 1234567890, want: "89005924"},
+		{unix: 2000000000, want: "69279037"},
+		{unix: 20000000000, want: "65353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := GenerateCode(rfcConfig(), rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("GenerateCode(%d): %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("GenerateCode(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateCodeSixDigits(t *testing.T) {
+	// RFC 4226 HOTP values for counters 1 and 2, truncated to 6 digits
+	for counter, want := range map[int64]string{1: "287082", 2: "359152"} {
+		got, err := GenerateCode(DefaultConfig(), rfcSecret, time.Unix(counter*30, 0))
+		if err != nil {
+			t.Fatalf("GenerateCode: %v", err)
+		}
+		if got != want {
+			t.Errorf("code for counter %d = %s, want %s", counter, got, want)
+		}
+	}
+}
+
+func TestValidateSkewWindow(t *testing.T) {
+	config := rfcConfig()
+	now := time.Unix(1111111111, 0)
+	current := counterAt(config, now)
+
+	tests := []struct {
+		name   string
+		offset time.Duration
+		valid  bool
+	}{
+		{name: "two steps behind", offset: -60 * time.Second, valid: false},
+		{name: "one step behind", offset: -30 * time.Second, valid: true},
+		{name: "current step", offset: 0, valid: true},
+		{name: "one step ahead", offset: 30 * time.Second, valid: true},
+		{name: "two steps ahead", offset: 60 * time.Second, valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codeTime := now.Add(tt.offset)
+			code, err := GenerateCode(config, rfcSecret, codeTime)
+			if err != nil {
+				t.Fatalf("GenerateCode: %v", err)
+			}
+
+			valid, counter, err := Validate(config, rfcSecret, code, now)
+			if err != nil {
+				t.Fatalf("Validate: %v", err)
+			}
+			if valid != tt.valid {
+				t.Fatalf("Validate = %v, want %v", valid, tt.valid)
+			}
+			if valid && counter != counterAt(config, codeTime) {
+				t.Errorf("counter = %d, want %d (current %d)", counter, counterAt(config, codeTime), current)
+			}
+		})
+	}
+}
+
+func TestValidateRejectsMalformedCodes(t *testing.T) {
+	config := rfcConfig()
+	now := time.Unix(59, 0)
+
+	for _, code := range []string{"", "9428708", "942870820", "00000000"} {
+		valid, _, err := Validate(config, rfcSecret, code, now)
+		if err != nil {
+			t.Fatalf("Validate(%q): %v", code, err)
+		}
+		if valid {
+			t.Errorf("Validate(%q) accepted a wrong code", code)
+		}
+	}
+
+	// Spaces around the code and a lowercase, spaced secret are tolerated
+	secret := strings.ToLower(rfcSecret[:4] + " " + rfcSecret[4:])
+	if valid, _, err := Validate(config, secret, " 94287082 ", now); err != nil || !valid {
+		t.Errorf("Validate with a formatted secret and code = %v, %v, want true", valid, err)
+	}
+}
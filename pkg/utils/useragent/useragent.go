@@ -0,0 +1,72 @@
+package useragent
+
+import (
+	"strings"
+)
+
+// Unknown is reported for the parts of a user agent that could not be recognised
+const Unknown = "Unknown"
+
+// Order matters: Edge and Opera also claim to be Chrome, and Chrome claims to be Safari
+var browsers = []struct {
+	token string
+	name  string
+}{
+	{"edg/", "Edge"},
+	{"opr/", "Opera"},
+	{"samsungbrowser/", "Samsung Internet"},
+	{"firefox/", "Firefox"},
+	{"fxios/", "Firefox"},
+	{"crios/", "Chrome"},
+	{"chrome/", "Chrome"},
+	{"safari/", "Safari"},
+	{"okhttp/", "Android app"},
+	{"cfnetwork/", "iOS app"},
+}
+
+// Order matters: Android user agents also mention Linux
+var operatingSystems = []struct {
+	token string
+	name  string
+}{
+	{"android", "Android"},
+	{"iphone", "iOS"},
+	{"ipad", "iPadOS"},
+	{"windows", "Windows"},
+	{"mac os x", "macOS"},
+	{"cros", "ChromeOS"},
+	{"linux", "Linux"},
+}
+
+// Describe returns an approximate, human readable description of the device
+// a user agent belongs to, such as "Chrome on Windows"
+func Describe(userAgent string) string {
+	browser, os := Parse(userAgent)
+	if browser == Unknown && os == Unknown {
+		return "Unknown device"
+	}
+	return browser + " on " + os
+}
+
+// Parse returns the browser and operating system named in a user agent
+func Parse(userAgent string) (browser, os string) {
+	ua := strings.ToLower(userAgent)
+
+	browser = Unknown
+	for _, b := range browsers {
+		if strings.Contains(ua, b.token) {
+			browser = b.name
+			break
+		}
+	}
+
+	os = Unknown
+	for _, o := range operatingSystems {
+		if strings.Contains(ua, o.token) {
+			os = o.name
+			break
+		}
+	}
+
+	return browser, os
+}
@@ -1,33 +1,136 @@
 package validation
 
 import (
+	"errors"
 	"regexp"
 	"strings"
 )
 
-// Regular expression for validating international phone number format
-//
-// Regex Explanation:
-// ^              : Start of the string
-// \+             : Matches the literal '+' sign (required for international format)
-// [1-9]{1}       : The first digit after '+' must be 1-9 (avoids leading 0)
-// [0-9]{3,14}    : Matches the remaining digits (minimum 3, maximum 14) to allow country codes and phone numbers
-// $              : End of the string
+// DefaultPhoneRegion is assumed for numbers entered without a country code
+const DefaultPhoneRegion = "IN"
+
+// ErrInvalidPhone is returned for phone numbers that cannot be parsed or do not
+// match the length used by their country
+var ErrInvalidPhone = errors.New("invalid phone number")
+
+// PhoneNumber is a parsed phone number
+type PhoneNumber struct {
+	E164        string // Normalized form, e.g. "+919876543210"
+	CountryCode string // Country calling code without the '+', e.g. "91"
+	Region      string // ISO 3166-1 alpha-2 country, e.g. "IN"
+	National    string // Significant national number, e.g. "9876543210"
+}
+
+// e164Regex matches any number in E.164 form: a '+', a calling code that does
+// not start with 0 and at most 15 digits in total. Numbers of countries missing
+// from phoneRegions are only checked against it.
+var e164Regex = regexp.MustCompile(`^\+[1-9][0-9]{3,14}$`)
+
+// phoneFormatting holds the characters people use to group digits; they are removed before parsing
+var phoneFormatting = strings.NewReplacer(" ", "", "\u00a0", "", "-", "", ".", "", "(", "", ")", "")
+
+// ParsePhone parses a phone number written in international form ("+44 7911 123456",
+// "0044 7911 123456") or, when defaultRegion is given, in the national form of that
+// region ("07911 123456"). The number is checked against the length used by its country.
+// Numbers of countries not listed in phoneRegions only have to be valid E.164 numbers;
+// their CountryCode and Region are left empty and National holds every digit.
 //
-// ✅ Valid examples:
-// - +14155552671     (US number)
-// - +919876543210    (Indian number)
-// - +447911123456    (UK number)
+// ✅ Valid examples (default region "IN"):
+// - +91 98765 43210   → +919876543210
+// - 098765 43210      → +919876543210
+// - +971 50 123 4567  → +971501234567
+// - 00447911123456    → +447911123456
+// - +353 85 123 4567  → +353851234567
+// - +255 712 345 678  → +255712345678 (country not listed, checked as E.164 only)
 //
 // ❌ Invalid examples:
-// - 14155552671      (missing '+')
-// - +0123456789      (starts with 0 after '+')
-// - +123             (too short to be a valid number)
-// - +1234567890123456 (too long, exceeds 15 digits)
-var phoneRegex = regexp.MustCompile(`^\+[1-9]{1}[0-9]{3,14}$`)
+// - +91 98765        (too short for India)
+// - +0 123456789     (calling codes never start with 0)
+// - +1234567890123456 (longer than 15 digits)
+func ParsePhone(phone, defaultRegion string) (*PhoneNumber, error) {
+	// "+44 (0) 7911 123456" is a common way of showing the trunk prefix that is dropped internationally
+	phone = strings.Replace(strings.TrimSpace(phone), "(0)", "", 1)
+	phone = phoneFormatting.Replace(phone)
+
+	switch {
+	case strings.HasPrefix(phone, "+"):
+		phone = phone[1:]
+	case strings.HasPrefix(phone, "00"):
+		phone = phone[2:]
+	default:
+		region, ok := phoneRegions[defaultRegion]
+		if !ok {
+			return nil, ErrInvalidPhone
+		}
+		phone = region.callingCode + strings.TrimPrefix(phone, region.trunkPrefix)
+	}
+
+	if phone == "" || !isDigits(phone) {
+		return nil, ErrInvalidPhone
+	}
+
+	// Calling codes are prefix free, so at most one of the 1 to 3 digit prefixes matches
+	for length := 1; length <= 3 && length < len(phone); length++ {
+		code := phone[:length]
+		regionCode, ok := callingCodeRegions[code]
+		if !ok {
+			continue
+		}
 
-// ValidatePhone validates if the provided phone has a valid international format
+		region := phoneRegions[regionCode]
+		national := strings.TrimPrefix(phone[length:], region.trunkPrefix)
+		if len(national) < region.minLength || len(national) > region.maxLength {
+			return nil, ErrInvalidPhone
+		}
+
+		return &PhoneNumber{
+			E164:        "+" + code + national,
+			CountryCode: code,
+			Region:      regionCode,
+			National:    national,
+		}, nil
+	}
+
+	if !e164Regex.MatchString("+" + phone) {
+		return nil, ErrInvalidPhone
+	}
+	return &PhoneNumber{
+		E164:     "+" + phone,
+		National: phone,
+	}, nil
+}
+
+// NormalizePhone returns the E.164 form of the phone number, reading numbers
+// without a country code as numbers of DefaultPhoneRegion
+func NormalizePhone(phone string) (string, error) {
+	parsed, err := ParsePhone(phone, DefaultPhoneRegion)
+	if err != nil {
+		return "", err
+	}
+	return parsed.E164, nil
+}
+
+// ValidatePhone validates if the provided phone is a valid international or Indian phone number
 func ValidatePhone(phone string) bool {
-	phone = strings.TrimSpace(phone)
-	return phoneRegex.MatchString(phone)
+	_, err := ParsePhone(phone, DefaultPhoneRegion)
+	return err == nil
+}
+
+// PhoneRegion returns the ISO country of a phone number in E.164 format, or an
+// empty string if it cannot be parsed
+func PhoneRegion(phone string) string {
+	parsed, err := ParsePhone(phone, "")
+	if err != nil {
+		return ""
+	}
+	return parsed.Region
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,79 @@
+package validation
+
+// phoneRegion describes how the phone numbers of a country are written
+type phoneRegion struct {
+	callingCode string
+	trunkPrefix string // Dialled before national numbers inside the country and dropped internationally
+	minLength   int    // Length of the significant national number
+	maxLength   int
+}
+
+// phoneRegions covers India and the countries most of our members living abroad are in.
+// Countries sharing a calling code are listed under the first one, e.g. Canada under "US".
+var phoneRegions = map[string]phoneRegion{
+	// South Asia
+	"IN": {"91", "0", 10, 10},
+	"PK": {"92", "0", 9, 10},
+	"BD": {"880", "0", 8, 10},
+	"LK": {"94", "0", 9, 9},
+	"NP": {"977", "0", 8, 10},
+	"MV": {"960", "", 7, 7},
+
+	// Gulf and Middle East
+	"AE": {"971", "0", 8, 9},
+	"SA": {"966", "0", 8, 9},
+	"QA": {"974", "", 8, 8},
+	"KW": {"965", "", 8, 8},
+	"OM": {"968", "", 8, 8},
+	"BH": {"973", "", 8, 8},
+	"JO": {"962", "0", 8, 9},
+	"LB": {"961", "0", 7, 8},
+	"IQ": {"964", "0", 10, 10},
+	"YE": {"967", "0", 9, 9},
+	"IL": {"972", "0", 8, 9},
+	"TR": {"90", "0", 10, 10},
+	"EG": {"20", "0", 9, 10},
+
+	// Europe
+	"GB": {"44", "0", 9, 10},
+	"IE": {"353", "0", 7, 9},
+	"DE": {"49", "0", 7, 11},
+	"FR": {"33", "0", 9, 9},
+	"NL": {"31", "0", 9, 9},
+	"IT": {"39", "", 6, 11},
+	"ES": {"34", "", 9, 9},
+	"CH": {"41", "0", 9, 9},
+	"SE": {"46", "0", 7, 10},
+	"NO": {"47", "", 8, 8},
+	"DK": {"45", "", 8, 8},
+	"RU": {"7", "8", 10, 10},
+
+	// Americas
+	"US": {"1", "1", 10, 10},
+
+	// Asia Pacific
+	"SG": {"65", "", 8, 8},
+	"MY": {"60", "0", 9, 10},
+	"ID": {"62", "0", 9, 12},
+	"TH": {"66", "0", 8, 9},
+	"PH": {"63", "0", 10, 10},
+	"HK": {"852", "", 8, 8},
+	"CN": {"86", "0", 10, 11},
+	"JP": {"81", "0", 9, 10},
+	"AU": {"61", "0", 9, 9},
+	"NZ": {"64", "0", 8, 10},
+
+	// Africa
+	"ZA": {"27", "0", 9, 9},
+	"KE": {"254", "0", 9, 9},
+	"NG": {"234", "0", 8, 10},
+}
+
+// callingCodeRegions maps each calling code back to its region
+var callingCodeRegions = func() map[string]string {
+	regions := make(map[string]string, len(phoneRegions))
+	for region, info := range phoneRegions {
+		regions[info.callingCode] = region
+	}
+	return regions
+}()
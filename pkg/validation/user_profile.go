@@ -4,17 +4,28 @@ package validation
 import (
 	"errors"
 	"time"
+	"unicode/utf8"
 )
 
 var (
 	ErrInvalidHeight         = errors.New("height must be between 130 and 220 cm")
-	ErrInvalidCommunity      = errors.New("invalid community value")
 	ErrInvalidMaritalStatus  = errors.New("invalid marital status value")
-	ErrInvalidProfession     = errors.New("invalid profession value")
 	ErrInvalidProfessionType = errors.New("invalid profession type value")
-	ErrInvalidEducationLevel = errors.New("invalid education level value")
-	ErrInvalidHomeDistrict   = errors.New("invalid home district value")
 	ErrInvalidDateOfBirth    = errors.New("invalid date of birth, user must be at least 18 years old")
+	ErrInvalidIncomeBand     = errors.New("invalid annual income value")
+	ErrInvalidFamilyType     = errors.New("invalid family type value")
+	ErrInvalidResidency      = errors.New("invalid residency status value")
+	ErrInvalidLanguage       = errors.New("invalid language value")
+	ErrAboutMeTooLong        = errors.New("about me must be at most 2000 characters")
+	ErrTextTooLong           = errors.New("value must be at most 100 characters")
+	ErrInvalidSiblingCount   = errors.New("number of siblings must be between 0 and 20")
+	ErrInvalidPrayer         = errors.New("invalid prayer frequency value")
+	ErrInvalidHijab          = errors.New("invalid hijab value")
+	ErrInvalidBeard          = errors.New("invalid beard value")
+	ErrInvalidMadrasa        = errors.New("invalid madrasa education value")
+	ErrInvalidDiet           = errors.New("invalid diet value")
+	ErrInvalidSmoking        = errors.New("invalid smoking value")
+	ErrInvalidRelocation     = errors.New("invalid relocation value")
 )
 
 // ValidateHeight checks if height is within acceptable range
@@ -30,88 +41,214 @@ func ValidateHeight(height *int) error {
 	return nil
 }
 
-// ValidateCommunity checks if community value is valid
-func ValidateCommunity(community string) error {
-	validCommunities := map[string]bool{
-		"sunni": true, "mujahid": true, "tabligh": true,
-		"jamate_islami": true, "shia": true, "muslim": true,
+// ValidateMaritalStatus checks if marital status value is valid
+func ValidateMaritalStatus(status string) error {
+	validStatuses := map[string]bool{
+		"never_married": true, "divorced": true, "nikkah_divorce": true,
+		"widowed": true, "not_mentioned": true,
+	}
+
+	if _, ok := validStatuses[status]; !ok {
+		return ErrInvalidMaritalStatus
+	}
+
+	return nil
+}
+
+// ValidateProfessionType checks if profession type value is valid
+func ValidateProfessionType(professionType string) error {
+	validTypes := map[string]bool{
+		"full_time": true, "part_time": true, "freelance": true,
+		"self_employed": true, "not_working": true, "not_mentioned": true,
+	}
+
+	if _, ok := validTypes[professionType]; !ok {
+		return ErrInvalidProfessionType
+	}
+
+	return nil
+}
+
+// ValidateIncomeBand checks if annual income value is valid
+func ValidateIncomeBand(income string) error {
+	validBands := map[string]bool{
+		"upto_3_lakh": true, "3_to_5_lakh": true, "5_to_10_lakh": true,
+		"10_to_20_lakh": true, "20_to_50_lakh": true, "above_50_lakh": true,
 		"not_mentioned": true,
 	}
 
-	if _, ok := validCommunities[community]; !ok {
-		return ErrInvalidCommunity
+	if _, ok := validBands[income]; !ok {
+		return ErrInvalidIncomeBand
 	}
 
 	return nil
 }
 
-// ValidateMaritalStatus checks if marital status value is valid
-func ValidateMaritalStatus(status string) error {
+// ValidateFamilyType checks if family type value is valid
+func ValidateFamilyType(familyType string) error {
+	validTypes := map[string]bool{
+		"nuclear": true, "joint": true, "extended": true, "not_mentioned": true,
+	}
+
+	if _, ok := validTypes[familyType]; !ok {
+		return ErrInvalidFamilyType
+	}
+
+	return nil
+}
+
+// ValidateResidencyStatus checks if residency status value is valid
+func ValidateResidencyStatus(status string) error {
 	validStatuses := map[string]bool{
-		"never_married": true, "divorced": true, "nikkah_divorce": true,
-		"widowed": true, "not_mentioned": true,
+		"citizen": true, "permanent_resident": true, "work_visa": true,
+		"student_visa": true, "dependent_visa": true, "not_mentioned": true,
 	}
 
 	if _, ok := validStatuses[status]; !ok {
-		return ErrInvalidMaritalStatus
+		return ErrInvalidResidency
 	}
 
 	return nil
 }
 
-// ValidateProfession checks if profession value is valid
-func ValidateProfession(profession string) error {
-	validProfessions := map[string]bool{
-		"student": true, "doctor": true, "engineer": true,
-		"farmer": true, "teacher": true, "not_mentioned": true,
+// ValidateLanguage checks if a spoken language value is valid
+func ValidateLanguage(language string) error {
+	validLanguages := map[string]bool{
+		"malayalam": true, "english": true, "hindi": true, "tamil": true,
+		"kannada": true, "telugu": true, "urdu": true, "arabic": true,
+		"beary": true, "konkani": true, "marathi": true, "gujarati": true,
+		"bengali": true, "french": true, "german": true,
 	}
 
-	if _, ok := validProfessions[profession]; !ok {
-		return ErrInvalidProfession
+	if _, ok := validLanguages[language]; !ok {
+		return ErrInvalidLanguage
 	}
 
 	return nil
 }
 
-// ValidateProfessionType checks if profession type value is valid
-func ValidateProfessionType(professionType string) error {
-	validTypes := map[string]bool{
-		"full_time": true, "part_time": true, "freelance": true,
-		"self_employed": true, "not_working": true, "not_mentioned": true,
+// ValidateAboutMe checks the length of the free text about me section
+func ValidateAboutMe(aboutMe string) error {
+	if utf8.RuneCountInString(aboutMe) > 2000 {
+		return ErrAboutMeTooLong
 	}
 
-	if _, ok := validTypes[professionType]; !ok {
-		return ErrInvalidProfessionType
+	return nil
+}
+
+// ValidateShortText checks the length of short free text fields such as
+// occupations and place names
+func ValidateShortText(value string) error {
+	if utf8.RuneCountInString(value) > 100 {
+		return ErrTextTooLong
+	}
+
+	return nil
+}
+
+// ValidateSiblingCount checks if the number of brothers or sisters is within range
+func ValidateSiblingCount(count *int) error {
+	if count == nil {
+		return nil
+	}
+
+	if *count < 0 || *count > 20 {
+		return ErrInvalidSiblingCount
+	}
+
+	return nil
+}
+
+// ValidatePrayerFrequency checks if prayer frequency value is valid
+func ValidatePrayerFrequency(frequency string) error {
+	validFrequencies := map[string]bool{
+		"five_times": true, "mostly": true, "sometimes": true,
+		"rarely": true, "not_mentioned": true,
+	}
+
+	if _, ok := validFrequencies[frequency]; !ok {
+		return ErrInvalidPrayer
+	}
+
+	return nil
+}
+
+// ValidateHijab checks if hijab value is valid
+func ValidateHijab(hijab string) error {
+	validValues := map[string]bool{
+		"niqab": true, "hijab": true, "none": true, "not_mentioned": true,
+	}
+
+	if _, ok := validValues[hijab]; !ok {
+		return ErrInvalidHijab
+	}
+
+	return nil
+}
+
+// ValidateBeard checks if beard value is valid
+func ValidateBeard(beard string) error {
+	validValues := map[string]bool{
+		"full": true, "trimmed": true, "none": true, "not_mentioned": true,
+	}
+
+	if _, ok := validValues[beard]; !ok {
+		return ErrInvalidBeard
+	}
+
+	return nil
+}
+
+// ValidateMadrasaEducation checks if madrasa education value is valid
+func ValidateMadrasaEducation(education string) error {
+	validValues := map[string]bool{
+		"hafiz": true, "completed": true, "basic": true,
+		"none": true, "not_mentioned": true,
+	}
+
+	if _, ok := validValues[education]; !ok {
+		return ErrInvalidMadrasa
+	}
+
+	return nil
+}
+
+// ValidateDiet checks if diet value is valid
+func ValidateDiet(diet string) error {
+	validValues := map[string]bool{
+		"non_vegetarian": true, "vegetarian": true, "eggetarian": true,
+		"not_mentioned": true,
+	}
+
+	if _, ok := validValues[diet]; !ok {
+		return ErrInvalidDiet
 	}
 
 	return nil
 }
 
-// ValidateEducationLevel checks if education level value is valid
-func ValidateEducationLevel(level string) error {
-	validLevels := map[string]bool{
-		"less_than_high_school": true, "high_school": true, "higher_secondary": true,
-		"under_graduation": true, "post_graduation": true, "not_mentioned": true,
+// ValidateSmoking checks if smoking value is valid
+func ValidateSmoking(smoking string) error {
+	validValues := map[string]bool{
+		"never": true, "occasionally": true, "regularly": true,
+		"not_mentioned": true,
 	}
 
-	if _, ok := validLevels[level]; !ok {
-		return ErrInvalidEducationLevel
+	if _, ok := validValues[smoking]; !ok {
+		return ErrInvalidSmoking
 	}
 
 	return nil
 }
 
-// ValidateHomeDistrict checks if home district value is valid
-func ValidateHomeDistrict(district string) error {
-	validDistricts := map[string]bool{
-		"thiruvananthapuram": true, "kollam": true, "pathanamthitta": true,
-		"alappuzha": true, "kottayam": true, "ernakulam": true, "thrissur": true,
-		"palakkad": true, "malappuram": true, "kozhikode": true, "wayanad": true,
-		"kannur": true, "kasaragod": true, "idukki": true, "not_mentioned": true,
+// ValidateRelocation checks if willingness to relocate value is valid
+func ValidateRelocation(relocation string) error {
+	validValues := map[string]bool{
+		"yes": true, "no": true, "open": true, "not_mentioned": true,
 	}
 
-	if _, ok := validDistricts[district]; !ok {
-		return ErrInvalidHomeDistrict
+	if _, ok := validValues[relocation]; !ok {
+		return ErrInvalidRelocation
 	}
 
 	return nil
@@ -8,8 +8,13 @@ import (
 	authpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/auth/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
+// actorIDHeader carries the ID of the admin making the request. The auth
+// service checks the admin's permissions itself, so it is passed through.
+const actorIDHeader = "user-id"
+
 type Client struct {
 	conn   *grpc.ClientConn
 	client authpb.AuthServiceClient
@@ -38,6 +43,38 @@ func (c *Client) GetUser(ctx context.Context, req *authpb.GetUserRequest) (*auth
 	return c.client.GetUser(ctx, req)
 }
 
+func (c *Client) SuspendUser(ctx context.Context, req *authpb.SuspendUserRequest) (*authpb.ModerateUserResponse, error) {
+	return c.client.SuspendUser(withActor(ctx), req)
+}
+
+func (c *Client) BanUser(ctx context.Context, req *authpb.BanUserRequest) (*authpb.ModerateUserResponse, error) {
+	return c.client.BanUser(withActor(ctx), req)
+}
+
+func (c *Client) ReinstateUser(ctx context.Context, req *authpb.ReinstateUserRequest) (*authpb.ModerateUserResponse, error) {
+	return c.client.ReinstateUser(withActor(ctx), req)
+}
+
+func (c *Client) GrantPremium(ctx context.Context, req *authpb.GrantPremiumRequest) (*authpb.ModerateUserResponse, error) {
+	return c.client.GrantPremium(withActor(ctx), req)
+}
+
+func (c *Client) GetUserAuthEvents(ctx context.Context, req *authpb.GetUserAuthEventsRequest) (*authpb.ListAuthEventsResponse, error) {
+	return c.client.GetUserAuthEvents(withActor(ctx), req)
+}
+
 func (c *Client) Close() error {
 	return c.conn.Close()
 }
+
+// withActor forwards the acting admin's ID from the incoming request
+func withActor(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if values := md.Get(actorIDHeader); len(values) > 0 {
+		return metadata.AppendToOutgoingContext(ctx, actorIDHeader, values[0])
+	}
+	return ctx
+}
@@ -8,8 +8,13 @@ import (
 	userpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/user/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
+// authorizationHeader carries the acting admin's access token. The user service
+// checks the admin's permissions from it, so it is passed through.
+const authorizationHeader = "authorization"
+
 type Client struct {
 	conn   *grpc.ClientConn
 	client userpb.UserServiceClient
@@ -35,6 +40,38 @@ func (c *Client) GetProfileForAdmin(ctx context.Context, req *userpb.GetProfileF
 	return c.client.GetProfileForAdmin(ctx, req)
 }
 
+// ListTaxonomyOptions lists the options of the admin-managed taxonomies
+func (c *Client) ListTaxonomyOptions(ctx context.Context, req *userpb.ListTaxonomyOptionsRequest) (*userpb.ListTaxonomyOptionsResponse, error) {
+	return c.client.ListTaxonomyOptions(ctx, req)
+}
+
+// CreateTaxonomyOption adds a taxonomy option
+func (c *Client) CreateTaxonomyOption(ctx context.Context, req *userpb.CreateTaxonomyOptionRequest) (*userpb.TaxonomyOptionResponse, error) {
+	return c.client.CreateTaxonomyOption(withAuthorization(ctx), req)
+}
+
+// UpdateTaxonomyOption changes a taxonomy option
+func (c *Client) UpdateTaxonomyOption(ctx context.Context, req *userpb.UpdateTaxonomyOptionRequest) (*userpb.TaxonomyOptionResponse, error) {
+	return c.client.UpdateTaxonomyOption(withAuthorization(ctx), req)
+}
+
+// DeleteTaxonomyOption deactivates a taxonomy option
+func (c *Client) DeleteTaxonomyOption(ctx context.Context, req *userpb.DeleteTaxonomyOptionRequest) (*userpb.TaxonomyOptionResponse, error) {
+	return c.client.DeleteTaxonomyOption(withAuthorization(ctx), req)
+}
+
+// withAuthorization forwards the acting admin's access token from the incoming request
+func withAuthorization(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if values := md.Get(authorizationHeader); len(values) > 0 {
+		return metadata.AppendToOutgoingContext(ctx, authorizationHeader, values[0])
+	}
+	return ctx
+}
+
 func (c *Client) Close() error {
 	return c.conn.Close()
 }
@@ -113,18 +113,7 @@ func (s *AdminService) GetUser(ctx context.Context, req *adminpb.GetUserRequest)
 
 	// 3. Build detailed response
 	userDetails := &adminpb.UserDetails{
-		Auth: &adminpb.AuthData{
-			Id:           authResp.User.Id,
-			Email:        authResp.User.Email,
-			Phone:        authResp.User.Phone,
-			Verified:     authResp.User.Verified,
-			IsActive:     authResp.User.IsActive,
-			IsPremium:    authResp.User.IsPremium,
-			PremiumUntil: authResp.User.PremiumUntil,
-			LastLoginAt:  authResp.User.LastLoginAt,
-			CreatedAt:    authResp.User.CreatedAt,
-			UpdatedAt:    authResp.User.UpdatedAt,
-		},
+		Auth: convertAuthData(authResp.User),
 	}
 
 	// 4. Convert user service profile data to admin proto format if available
@@ -197,3 +186,139 @@ func (s *AdminService) GetUser(ctx context.Context, req *adminpb.GetUserRequest)
 		User:    userDetails,
 	}, nil
 }
+
+// SuspendUser suspends a user through the auth service, which checks the acting admin's permissions
+func (s *AdminService) SuspendUser(ctx context.Context, req *adminpb.SuspendUserRequest) (*adminpb.ModerateUserResponse, error) {
+	s.logger.Info("Admin service suspending user", "userID", req.UserId)
+
+	authResp, err := s.authClient.SuspendUser(ctx, &authpb.SuspendUserRequest{
+		UserId:         req.UserId,
+		Reason:         req.Reason,
+		SuspendedUntil: req.SuspendedUntil,
+	})
+	if err != nil {
+		s.logger.Error("Failed to suspend user", "error", err, "userID", req.UserId)
+		return nil, err
+	}
+
+	return convertModerateUserResponse(authResp), nil
+}
+
+// BanUser permanently bans a user through the auth service
+func (s *AdminService) BanUser(ctx context.Context, req *adminpb.BanUserRequest) (*adminpb.ModerateUserResponse, error) {
+	s.logger.Info("Admin service banning user", "userID", req.UserId)
+
+	authResp, err := s.authClient.BanUser(ctx, &authpb.BanUserRequest{
+		UserId: req.UserId,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		s.logger.Error("Failed to ban user", "error", err, "userID", req.UserId)
+		return nil, err
+	}
+
+	return convertModerateUserResponse(authResp), nil
+}
+
+// ReinstateUser lifts a user's suspension or ban through the auth service
+func (s *AdminService) ReinstateUser(ctx context.Context, req *adminpb.ReinstateUserRequest) (*adminpb.ModerateUserResponse, error) {
+	s.logger.Info("Admin service reinstating user", "userID", req.UserId)
+
+	authResp, err := s.authClient.ReinstateUser(ctx, &authpb.ReinstateUserRequest{
+		UserId: req.UserId,
+	})
+	if err != nil {
+		s.logger.Error("Failed to reinstate user", "error", err, "userID", req.UserId)
+		return nil, err
+	}
+
+	return convertModerateUserResponse(authResp), nil
+}
+
+// GrantPremium gives a user premium membership through the auth service
+func (s *AdminService) GrantPremium(ctx context.Context, req *adminpb.GrantPremiumRequest) (*adminpb.ModerateUserResponse, error) {
+	s.logger.Info("Admin service granting premium", "userID", req.UserId)
+
+	authResp, err := s.authClient.GrantPremium(ctx, &authpb.GrantPremiumRequest{
+		UserId:       req.UserId,
+		PremiumUntil: req.PremiumUntil,
+	})
+	if err != nil {
+		s.logger.Error("Failed to grant premium", "error", err, "userID", req.UserId)
+		return nil, err
+	}
+
+	return convertModerateUserResponse(authResp), nil
+}
+
+// GetUserAuthEvents returns a user's authentication events from the auth service
+func (s *AdminService) GetUserAuthEvents(ctx context.Context, req *adminpb.GetUserAuthEventsRequest) (*adminpb.GetUserAuthEventsResponse, error) {
+	s.logger.Info("Admin service getting user auth events", "userID", req.UserId)
+
+	authResp, err := s.authClient.GetUserAuthEvents(ctx, &authpb.GetUserAuthEventsRequest{
+		UserId:    req.UserId,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+		EventType: req.EventType,
+		Outcome:   req.Outcome,
+	})
+	if err != nil {
+		s.logger.Error("Failed to get user auth events", "error", err, "userID", req.UserId)
+		return nil, err
+	}
+
+	events := make([]*adminpb.AuthEvent, len(authResp.Events))
+	for i, event := range authResp.Events {
+		events[i] = &adminpb.AuthEvent{
+			Id:         event.Id,
+			EventType:  event.EventType,
+			Identifier: event.Identifier,
+			IpAddress:  event.IpAddress,
+			UserAgent:  event.UserAgent,
+			Outcome:    event.Outcome,
+			Reason:     event.Reason,
+			CreatedAt:  event.CreatedAt,
+		}
+	}
+
+	return &adminpb.GetUserAuthEventsResponse{
+		Success: authResp.Success,
+		Message: authResp.Message,
+		Events:  events,
+		Pagination: &adminpb.Pagination{
+			Total:       authResp.Pagination.Total,
+			Limit:       authResp.Pagination.Limit,
+			Offset:      authResp.Pagination.Offset,
+			HasMore:     authResp.Pagination.HasMore,
+			TotalPages:  authResp.Pagination.TotalPages,
+			CurrentPage: authResp.Pagination.CurrentPage,
+		},
+	}, nil
+}
+
+func convertModerateUserResponse(authResp *authpb.ModerateUserResponse) *adminpb.ModerateUserResponse {
+	return &adminpb.ModerateUserResponse{
+		Success: authResp.Success,
+		Message: authResp.Message,
+		User:    convertAuthData(authResp.User),
+	}
+}
+
+func convertAuthData(authUser *authpb.UserData) *adminpb.AuthData {
+	return &adminpb.AuthData{
+		Id:               authUser.Id,
+		Email:            authUser.Email,
+		Phone:            authUser.Phone,
+		Verified:         authUser.Verified,
+		IsActive:         authUser.IsActive,
+		IsPremium:        authUser.IsPremium,
+		PremiumUntil:     authUser.PremiumUntil,
+		LastLoginAt:      authUser.LastLoginAt,
+		CreatedAt:        authUser.CreatedAt,
+		UpdatedAt:        authUser.UpdatedAt,
+		IsSuspended:      authUser.IsSuspended,
+		IsBanned:         authUser.IsBanned,
+		SuspensionReason: authUser.SuspensionReason,
+		SuspendedUntil:   authUser.SuspendedUntil,
+	}
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	adminpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/admin/v1"
+	userpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/user/v1"
+)
+
+// ListTaxonomyOptions lists the taxonomy options from the user service, deactivated ones included
+func (s *AdminService) ListTaxonomyOptions(ctx context.Context, req *adminpb.ListTaxonomyOptionsRequest) (*adminpb.ListTaxonomyOptionsResponse, error) {
+	s.logger.Info("Admin service listing taxonomy options", "category", req.Category)
+
+	userResp, err := s.userClient.ListTaxonomyOptions(ctx, &userpb.ListTaxonomyOptionsRequest{
+		Category:        req.Category,
+		IncludeInactive: true,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list taxonomy options", "error", err, "category", req.Category)
+		return nil, err
+	}
+
+	options := make([]*adminpb.TaxonomyOption, len(userResp.Options))
+	for i, option := range userResp.Options {
+		options[i] = convertTaxonomyOption(option)
+	}
+
+	return &adminpb.ListTaxonomyOptionsResponse{
+		Success: userResp.Success,
+		Message: userResp.Message,
+		Options: options,
+	}, nil
+}
+
+// CreateTaxonomyOption adds a taxonomy option through the user service
+func (s *AdminService) CreateTaxonomyOption(ctx context.Context, req *adminpb.CreateTaxonomyOptionRequest) (*adminpb.TaxonomyOptionResponse, error) {
+	s.logger.Info("Admin service creating taxonomy option", "category", req.Category, "value", req.Value)
+
+	userResp, err := s.userClient.CreateTaxonomyOption(ctx, &userpb.CreateTaxonomyOptionRequest{
+		Category:    req.Category,
+		Value:       req.Value,
+		Label:       req.Label,
+		SortOrder:   req.SortOrder,
+		Parent:      req.Parent,
+		Level:       req.Level,
+		Coordinates: toUserLocationCoordinates(req.Coordinates),
+		Adjacent:    req.Adjacent,
+	})
+	if err != nil {
+		s.logger.Error("Failed to create taxonomy option", "error", err, "category", req.Category, "value", req.Value)
+		return nil, err
+	}
+
+	return convertTaxonomyOptionResponse(userResp), nil
+}
+
+// UpdateTaxonomyOption changes a taxonomy option through the user service
+func (s *AdminService) UpdateTaxonomyOption(ctx context.Context, req *adminpb.UpdateTaxonomyOptionRequest) (*adminpb.TaxonomyOptionResponse, error) {
+	s.logger.Info("Admin service updating taxonomy option", "id", req.Id)
+
+	userReq := &userpb.UpdateTaxonomyOptionRequest{Id: req.Id}
+	if req.Label != nil {
+		userReq.Label = wrapperspb.String(req.GetLabel())
+	}
+	if req.SortOrder != nil {
+		userReq.SortOrder = wrapperspb.Int32(req.GetSortOrder())
+	}
+	if req.IsActive != nil {
+		userReq.IsActive = wrapperspb.Bool(req.GetIsActive())
+	}
+	if req.Parent != nil {
+		userReq.Parent = wrapperspb.String(req.GetParent())
+	}
+	if req.Level != nil {
+		userReq.Level = wrapperspb.String(req.GetLevel())
+	}
+	userReq.Coordinates = toUserLocationCoordinates(req.Coordinates)
+	userReq.AddAdjacent = req.AddAdjacent
+	userReq.RemoveAdjacent = req.RemoveAdjacent
+
+	userResp, err := s.userClient.UpdateTaxonomyOption(ctx, userReq)
+	if err != nil {
+		s.logger.Error("Failed to update taxonomy option", "error", err, "id", req.Id)
+		return nil, err
+	}
+
+	return convertTaxonomyOptionResponse(userResp), nil
+}
+
+// DeleteTaxonomyOption deactivates a taxonomy option through the user service
+func (s *AdminService) DeleteTaxonomyOption(ctx context.Context, req *adminpb.DeleteTaxonomyOptionRequest) (*adminpb.TaxonomyOptionResponse, error) {
+	s.logger.Info("Admin service deleting taxonomy option", "id", req.Id)
+
+	userResp, err := s.userClient.DeleteTaxonomyOption(ctx, &userpb.DeleteTaxonomyOptionRequest{
+		Id: req.Id,
+	})
+	if err != nil {
+		s.logger.Error("Failed to delete taxonomy option", "error", err, "id", req.Id)
+		return nil, err
+	}
+
+	return convertTaxonomyOptionResponse(userResp), nil
+}
+
+func convertTaxonomyOptionResponse(userResp *userpb.TaxonomyOptionResponse) *adminpb.TaxonomyOptionResponse {
+	return &adminpb.TaxonomyOptionResponse{
+		Success: userResp.Success,
+		Message: userResp.Message,
+		Option:  convertTaxonomyOption(userResp.Option),
+	}
+}
+
+func convertTaxonomyOption(option *userpb.TaxonomyOption) *adminpb.TaxonomyOption {
+	if option == nil {
+		return nil
+	}
+	return &adminpb.TaxonomyOption{
+		Id:          option.Id,
+		Category:    option.Category,
+		Value:       option.Value,
+		Label:       option.Label,
+		SortOrder:   option.SortOrder,
+		IsActive:    option.IsActive,
+		Parent:      option.Parent,
+		Level:       option.Level,
+		Coordinates: convertLocationCoordinates(option.Coordinates),
+	}
+}
+
+func convertLocationCoordinates(coordinates *userpb.LocationCoordinates) *adminpb.LocationCoordinates {
+	if coordinates == nil {
+		return nil
+	}
+	return &adminpb.LocationCoordinates{
+		Latitude:  coordinates.Latitude,
+		Longitude: coordinates.Longitude,
+	}
+}
+
+func toUserLocationCoordinates(coordinates *adminpb.LocationCoordinates) *userpb.LocationCoordinates {
+	if coordinates == nil {
+		return nil
+	}
+	return &userpb.LocationCoordinates{
+		Latitude:  coordinates.Latitude,
+		Longitude: coordinates.Longitude,
+	}
+}
@@ -31,3 +31,39 @@ func (h *AdminHandler) GetUsers(ctx context.Context, req *adminpb.GetUsersReques
 func (h *AdminHandler) GetUser(ctx context.Context, req *adminpb.GetUserRequest) (*adminpb.GetUserResponse, error) {
 	return h.adminService.GetUser(ctx, req)
 }
+
+func (h *AdminHandler) SuspendUser(ctx context.Context, req *adminpb.SuspendUserRequest) (*adminpb.ModerateUserResponse, error) {
+	return h.adminService.SuspendUser(ctx, req)
+}
+
+func (h *AdminHandler) BanUser(ctx context.Context, req *adminpb.BanUserRequest) (*adminpb.ModerateUserResponse, error) {
+	return h.adminService.BanUser(ctx, req)
+}
+
+func (h *AdminHandler) ReinstateUser(ctx context.Context, req *adminpb.ReinstateUserRequest) (*adminpb.ModerateUserResponse, error) {
+	return h.adminService.ReinstateUser(ctx, req)
+}
+
+func (h *AdminHandler) GrantPremium(ctx context.Context, req *adminpb.GrantPremiumRequest) (*adminpb.ModerateUserResponse, error) {
+	return h.adminService.GrantPremium(ctx, req)
+}
+
+func (h *AdminHandler) GetUserAuthEvents(ctx context.Context, req *adminpb.GetUserAuthEventsRequest) (*adminpb.GetUserAuthEventsResponse, error) {
+	return h.adminService.GetUserAuthEvents(ctx, req)
+}
+
+func (h *AdminHandler) ListTaxonomyOptions(ctx context.Context, req *adminpb.ListTaxonomyOptionsRequest) (*adminpb.ListTaxonomyOptionsResponse, error) {
+	return h.adminService.ListTaxonomyOptions(ctx, req)
+}
+
+func (h *AdminHandler) CreateTaxonomyOption(ctx context.Context, req *adminpb.CreateTaxonomyOptionRequest) (*adminpb.TaxonomyOptionResponse, error) {
+	return h.adminService.CreateTaxonomyOption(ctx, req)
+}
+
+func (h *AdminHandler) UpdateTaxonomyOption(ctx context.Context, req *adminpb.UpdateTaxonomyOptionRequest) (*adminpb.TaxonomyOptionResponse, error) {
+	return h.adminService.UpdateTaxonomyOption(ctx, req)
+}
+
+func (h *AdminHandler) DeleteTaxonomyOption(ctx context.Context, req *adminpb.DeleteTaxonomyOptionRequest) (*adminpb.TaxonomyOptionResponse, error) {
+	return h.adminService.DeleteTaxonomyOption(ctx, req)
+}
@@ -4,6 +4,7 @@ go 1.23.4
 
 require (
 	github.com/google/uuid v1.6.0
+	github.com/jackc/pgx/v5 v5.5.5
 	github.com/mohamedfawas/qubool-kallyanam/api v0.0.0-20250504110234-801e4ceebd5e
 	github.com/mohamedfawas/qubool-kallyanam/pkg v0.0.0-00010101000000-000000000000
 	github.com/redis/go-redis/v9 v9.8.0
@@ -22,7 +23,6 @@ require (
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
-	github.com/jackc/pgx/v5 v5.5.5 // indirect
 	github.com/jackc/puddle/v2 v2.2.1 // indirect
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type AccountDeletionRepo struct {
+	db *gorm.DB
+}
+
+func NewAccountDeletionRepository(db *gorm.DB) repositories.AccountDeletionRepository {
+	return &AccountDeletionRepo{
+		db: db,
+	}
+}
+
+func (r *AccountDeletionRepo) CreateDeletion(ctx context.Context, deletion *models.AccountDeletion) error {
+	return r.db.WithContext(ctx).Create(deletion).Error
+}
+
+func (r *AccountDeletionRepo) UpdateDeletion(ctx context.Context, deletion *models.AccountDeletion) error {
+	return r.db.WithContext(ctx).Save(deletion).Error
+}
+
+func (r *AccountDeletionRepo) SetPurgedAt(ctx context.Context, deletionID uuid.UUID, column string, at time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.AccountDeletion{}).
+		Where("id = ?", deletionID).
+		Updates(map[string]interface{}{
+			column:       at,
+			"updated_at": at,
+		}).Error
+}
+
+func (r *AccountDeletionRepo) GetOpenDeletion(ctx context.Context, userID string) (*models.AccountDeletion, error) {
+	var deletion models.AccountDeletion
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND status IN ?", userID, []string{models.AccountDeletionPending, models.AccountDeletionPurging}).
+		Order("requested_at DESC").
+		First(&deletion)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &deletion, nil
+}
+
+func (r *AccountDeletionRepo) GetDueDeletions(ctx context.Context, before time.Time, limit int) ([]*models.AccountDeletion, error) {
+	var deletions []*models.AccountDeletion
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND purge_after <= ?", models.AccountDeletionPending, before).
+		Order("purge_after ASC").
+		Limit(limit).
+		Find(&deletions).Error
+	return deletions, err
+}
+
+func (r *AccountDeletionRepo) GetStalledPurges(ctx context.Context, before time.Time, limit int) ([]*models.AccountDeletion, error) {
+	var deletions []*models.AccountDeletion
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND updated_at <= ?", models.AccountDeletionPurging, before).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&deletions).Error
+	return deletions, err
+}
@@ -0,0 +1,24 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type AdminAuditRepo struct {
+	db *gorm.DB
+}
+
+func NewAdminAuditRepository(db *gorm.DB) repositories.AdminAuditRepository {
+	return &AdminAuditRepo{
+		db: db,
+	}
+}
+
+func (r *AdminAuditRepo) RecordAdminAudit(ctx context.Context, entry *models.AdminAuditLog) error {
+	return r.db.WithContext(ctx).Create(entry).Error
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type AdminInviteRepo struct {
+	db *gorm.DB
+}
+
+func NewAdminInviteRepository(db *gorm.DB) repositories.AdminInviteRepository {
+	return &AdminInviteRepo{
+		db: db,
+	}
+}
+
+func (r *AdminInviteRepo) CreateInvite(ctx context.Context, invite *models.AdminInvite) error {
+	return r.db.WithContext(ctx).Create(invite).Error
+}
+
+func (r *AdminInviteRepo) GetInviteByTokenHash(ctx context.Context, tokenHash string) (*models.AdminInvite, error) {
+	var invite models.AdminInvite
+	result := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&invite)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &invite, nil
+}
+
+func (r *AdminInviteRepo) MarkInviteAccepted(ctx context.Context, inviteID string, acceptedAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&models.AdminInvite{}).
+		Where("id = ?", inviteID).
+		Update("accepted_at", acceptedAt).Error
+}
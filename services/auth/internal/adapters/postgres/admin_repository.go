@@ -32,6 +32,18 @@ func (r *AdminRepo) GetAdminByEmail(ctx context.Context, email string) (*models.
 	return &admin, nil
 }
 
+func (r *AdminRepo) GetAdminByID(ctx context.Context, id string) (*models.Admin, error) {
+	var admin models.Admin
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&admin)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &admin, nil
+}
+
 func (r *AdminRepo) CreateAdmin(ctx context.Context, admin *models.Admin) error {
 	return r.db.WithContext(ctx).Create(admin).Error
 }
@@ -40,6 +52,30 @@ func (r *AdminRepo) UpdateAdmin(ctx context.Context, admin *models.Admin) error
 	return r.db.WithContext(ctx).Save(admin).Error
 }
 
+// ListAdmins returns all admin accounts, oldest first
+func (r *AdminRepo) ListAdmins(ctx context.Context) ([]*models.Admin, error) {
+	var admins []*models.Admin
+	err := r.db.WithContext(ctx).Order("created_at ASC").Find(&admins).Error
+	return admins, err
+}
+
+func (r *AdminRepo) AdminRoleExists(ctx context.Context, role string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.AdminRole{}).Where("name = ?", role).Count(&count).Error
+	return count > 0, err
+}
+
+// GetRolePermissions returns the permissions granted to the admin role
+func (r *AdminRepo) GetRolePermissions(ctx context.Context, role string) ([]string, error) {
+	var permissions []string
+	err := r.db.WithContext(ctx).
+		Model(&models.AdminRolePermission{}).
+		Where("role = ?", role).
+		Order("permission").
+		Pluck("permission", &permissions).Error
+	return permissions, err
+}
+
 func (r *AdminRepo) CheckAdminExists(ctx context.Context) (bool, error) {
 	var count int64
 	err := r.db.WithContext(ctx).Model(&models.Admin{}).Count(&count).Error
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type AuthEventRepo struct {
+	db *gorm.DB
+}
+
+func NewAuthEventRepository(db *gorm.DB) repositories.AuthEventRepository {
+	return &AuthEventRepo{
+		db: db,
+	}
+}
+
+func (r *AuthEventRepo) RecordAuthEvent(ctx context.Context, event *models.AuthEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+// ListAuthEvents returns an account's events, newest first, with the total number of matching events
+func (r *AuthEventRepo) ListAuthEvents(ctx context.Context, params repositories.ListAuthEventsParams) ([]*models.AuthEvent, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.AuthEvent{}).
+		Where("account_type = ? AND account_id = ?", params.AccountType, params.AccountID)
+	if params.EventType != "" {
+		query = query.Where("event_type = ?", params.EventType)
+	}
+	if params.Outcome != "" {
+		query = query.Where("outcome = ?", params.Outcome)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var events []*models.AuthEvent
+	err := query.
+		Order("created_at DESC").
+		Limit(params.Limit).
+		Offset(params.Offset).
+		Find(&events).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
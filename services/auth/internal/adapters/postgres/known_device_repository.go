@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type KnownDeviceRepo struct {
+	db *gorm.DB
+}
+
+func NewKnownDeviceRepository(db *gorm.DB) repositories.KnownDeviceRepository {
+	return &KnownDeviceRepo{
+		db: db,
+	}
+}
+
+func (r *KnownDeviceRepo) GetKnownDevices(ctx context.Context, userID string) ([]*models.KnownDevice, error) {
+	var devices []*models.KnownDevice
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Find(&devices).Error
+	if err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+func (r *KnownDeviceRepo) SaveKnownDevice(ctx context.Context, device *models.KnownDevice) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "device"}, {Name: "ip_range"}},
+			DoUpdates: clause.AssignmentColumns([]string{"last_seen_at"}),
+		}).
+		Create(device).Error
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -41,3 +42,10 @@ func (r *RegistrationRepo) GetPendingRegistration(ctx context.Context, field str
 func (r *RegistrationRepo) DeletePendingRegistration(ctx context.Context, id int) error {
 	return r.db.WithContext(ctx).Delete(&models.PendingRegistration{}, id).Error
 }
+
+// DeleteExpiredPendingRegistrations removes the registrations that expired before now
+// and returns how many were removed
+func (r *RegistrationRepo) DeleteExpiredPendingRegistrations(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("expires_at < ?", now).Delete(&models.PendingRegistration{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type UserIdentityRepo struct {
+	db *gorm.DB
+}
+
+func NewUserIdentityRepository(db *gorm.DB) repositories.UserIdentityRepository {
+	return &UserIdentityRepo{
+		db: db,
+	}
+}
+
+func (r *UserIdentityRepo) GetIdentity(ctx context.Context, provider, subject string) (*models.UserIdentity, error) {
+	var identity models.UserIdentity
+	err := r.db.WithContext(ctx).
+		Where("provider = ? AND subject = ?", provider, subject).
+		First(&identity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *UserIdentityRepo) CreateIdentity(ctx context.Context, identity *models.UserIdentity) error {
+	return r.db.WithContext(ctx).Create(identity).Error
+}
+
+func (r *UserIdentityRepo) UpdateIdentityLogin(ctx context.Context, identity *models.UserIdentity) error {
+	return r.db.WithContext(ctx).
+		Model(&models.UserIdentity{}).
+		Where("id = ?", identity.ID).
+		Updates(map[string]interface{}{
+			"email":         identity.Email,
+			"last_login_at": identity.LastLoginAt,
+		}).Error
+}
@@ -6,12 +6,16 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
 	"gorm.io/gorm"
 )
 
+// uniqueViolationCode is the PostgreSQL error code of a unique constraint violation
+const uniqueViolationCode = "23505"
+
 type UserRepo struct {
 	db *gorm.DB
 }
@@ -58,6 +62,18 @@ func (r *UserRepo) SoftDeleteUser(ctx context.Context, userID string) error {
 		}).Error
 }
 
+// PurgeUser permanently removes a user that was soft deleted
+func (r *UserRepo) PurgeUser(ctx context.Context, userID string) error {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Unscoped().
+		Where("id = ? AND is_active = ?", id, false).
+		Delete(&models.User{}).Error
+}
+
 func (r *UserRepo) UpdateLastLogin(ctx context.Context, userID string) error {
 	id, err := uuid.Parse(userID)
 	if err != nil {
@@ -88,6 +104,27 @@ func (r *UserRepo) UpdatePremiumUntil(ctx context.Context, userID string, premiu
 		}).Error
 }
 
+// ClearPremiumUntil removes the user's premium status unless it was extended
+// past expiredAt, and reports whether it was removed
+func (r *UserRepo) ClearPremiumUntil(ctx context.Context, userID string, expiredAt time.Time) (bool, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return false, err
+	}
+
+	now := indianstandardtime.Now()
+	result := r.db.WithContext(ctx).Model(&models.User{}).
+		Where("id = ? AND premium_until IS NOT NULL AND premium_until <= ?", id, expiredAt).
+		Updates(map[string]interface{}{
+			"premium_until": nil,
+			"updated_at":    now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
 func (r *UserRepo) IsRegistered(ctx context.Context, field, value string) (bool, error) {
 	user, err := r.GetUser(ctx, field, value)
 	if err != nil {
@@ -96,6 +133,57 @@ func (r *UserRepo) IsRegistered(ctx context.Context, field, value string) (bool,
 	return user != nil, nil
 }
 
+// ChangeEmail replaces the user's email address. It returns false without
+// changing anything when another account already uses the address.
+func (r *UserRepo) ChangeEmail(ctx context.Context, userID, email string) (bool, error) {
+	return r.changeContact(ctx, userID, "email", email, map[string]interface{}{
+		"email": email,
+	})
+}
+
+// ChangePhone replaces the user's phone number and marks it verified, since the
+// change is only applied after an OTP sent to the new number was entered.
+// It returns false without changing anything when another account already uses the number.
+func (r *UserRepo) ChangePhone(ctx context.Context, userID, phone string) (bool, error) {
+	return r.changeContact(ctx, userID, "phone", phone, map[string]interface{}{
+		"phone":          phone,
+		"phone_verified": true,
+	})
+}
+
+// changeContact applies the updates in a single statement that only matches
+// while no other account holds the value. Two concurrent changes can both pass
+// that check, so the unique index violation of the loser is reported the same way.
+func (r *UserRepo) changeContact(ctx context.Context, userID, field, value string, updates map[string]interface{}) (bool, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return false, err
+	}
+
+	updates["updated_at"] = indianstandardtime.Now()
+	taken := r.db.Model(&models.User{}).
+		Select("1").
+		Where(field+" = ? AND id <> ?", value, id)
+
+	result := r.db.WithContext(ctx).Model(&models.User{}).
+		Where("id = ? AND is_active = ?", id, true).
+		Where("NOT EXISTS (?)", taken).
+		Updates(updates)
+	if result.Error != nil {
+		if isUniqueViolation(result.Error) {
+			return false, nil
+		}
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// isUniqueViolation reports whether the error was raised by a unique constraint
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
 // GetUsers implements admin user listing with filtering (reuses existing patterns)
 func (r *UserRepo) GetUsers(ctx context.Context, params repositories.GetUsersParams) ([]*models.User, int64, error) {
 	query := r.db.WithContext(ctx).Model(&models.User{})
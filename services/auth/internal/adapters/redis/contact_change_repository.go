@@ -0,0 +1,58 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	redisdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/redis"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type ContactChangeRepo struct {
+	client *redisdb.Client
+}
+
+func NewContactChangeRepository(client *redisdb.Client) repositories.ContactChangeRepository {
+	return &ContactChangeRepo{
+		client: client,
+	}
+}
+
+// StoreContactChange stores the change, replacing an earlier request for the same field
+func (r *ContactChangeRepo) StoreContactChange(ctx context.Context, change *models.ContactChange, expiry time.Duration) error {
+	data, err := json.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("failed to marshal contact change: %w", err)
+	}
+	return r.client.Set(ctx, contactChangeKey(change.UserID, change.Field), data, expiry)
+}
+
+// GetContactChange returns the pending change of the field, or nil if there is none or it has expired
+func (r *ContactChangeRepo) GetContactChange(ctx context.Context, userID, field string) (*models.ContactChange, error) {
+	data, err := r.client.Get(ctx, contactChangeKey(userID, field))
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var change models.ContactChange
+	if err := json.Unmarshal([]byte(data), &change); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal contact change: %w", err)
+	}
+	return &change, nil
+}
+
+func (r *ContactChangeRepo) DeleteContactChange(ctx context.Context, userID, field string) error {
+	return r.client.Del(ctx, contactChangeKey(userID, field))
+}
+
+func contactChangeKey(userID, field string) string {
+	return fmt.Sprintf("%s%s:%s", constants.ContactChangePrefix, field, userID)
+}
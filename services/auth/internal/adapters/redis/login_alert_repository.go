@@ -0,0 +1,39 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	redisdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/redis"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type LoginAlertRepo struct {
+	client *redisdb.Client
+}
+
+func NewLoginAlertRepository(client *redisdb.Client) repositories.LoginAlertRepository {
+	return &LoginAlertRepo{
+		client: client,
+	}
+}
+
+func (r *LoginAlertRepo) StoreReportToken(ctx context.Context, token, userID string, expiry time.Duration) error {
+	return r.client.Set(ctx, constants.LoginAlertReportPrefix+token, userID, expiry)
+}
+
+// GetReportToken returns the user ID of the report link, or an empty string if it does not exist
+func (r *LoginAlertRepo) GetReportToken(ctx context.Context, token string) (string, error) {
+	userID, err := r.client.Get(ctx, constants.LoginAlertReportPrefix+token)
+	if err == redis.Nil {
+		return "", nil
+	}
+	return userID, err
+}
+
+func (r *LoginAlertRepo) DeleteReportToken(ctx context.Context, token string) error {
+	return r.client.Del(ctx, constants.LoginAlertReportPrefix+token)
+}
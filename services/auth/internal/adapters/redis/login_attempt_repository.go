@@ -0,0 +1,63 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	redisdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/redis"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type LoginAttemptRepo struct {
+	client *redisdb.Client
+}
+
+func NewLoginAttemptRepository(client *redisdb.Client) repositories.LoginAttemptRepository {
+	return &LoginAttemptRepo{
+		client: client,
+	}
+}
+
+// IncrementFailedAttempts increases the failed login counter for an identifier (email or IP).
+// The counter starts a new window when it is first created.
+func (r *LoginAttemptRepo) IncrementFailedAttempts(ctx context.Context, identifier string, window time.Duration) (int64, error) {
+	key := fmt.Sprintf("%s%s", constants.LoginAttemptsPrefix, identifier)
+	return r.client.IncrWithExpiry(ctx, key, window)
+}
+
+// ResetFailedAttempts clears the failed login counter for an identifier.
+func (r *LoginAttemptRepo) ResetFailedAttempts(ctx context.Context, identifier string) error {
+	key := fmt.Sprintf("%s%s", constants.LoginAttemptsPrefix, identifier)
+	return r.client.Del(ctx, key)
+}
+
+// IncrementLockoutCount increases the number of lockouts an identifier has had within the window.
+// It is used to grow the lockout duration on repeated lockouts.
+func (r *LoginAttemptRepo) IncrementLockoutCount(ctx context.Context, identifier string, window time.Duration) (int64, error) {
+	key := fmt.Sprintf("%s%s", constants.LoginLockoutCountPrefix, identifier)
+	return r.client.IncrWithExpiry(ctx, key, window)
+}
+
+// Lock blocks logins for an identifier for the given duration.
+func (r *LoginAttemptRepo) Lock(ctx context.Context, identifier string, duration time.Duration) error {
+	key := fmt.Sprintf("%s%s", constants.LoginLockoutPrefix, identifier)
+	return r.client.Set(ctx, key, "1", duration)
+}
+
+// GetLockoutRemaining returns how long an identifier stays locked, or 0 if it is not locked.
+func (r *LoginAttemptRepo) GetLockoutRemaining(ctx context.Context, identifier string) (time.Duration, error) {
+	key := fmt.Sprintf("%s%s", constants.LoginLockoutPrefix, identifier)
+
+	ttl, err := r.client.TTL(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+
+	// Redis returns negative values when the key is missing or has no expiry
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
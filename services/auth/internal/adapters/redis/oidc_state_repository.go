@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	redisdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/redis"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type OIDCStateRepo struct {
+	client *redisdb.Client
+}
+
+func NewOIDCStateRepository(client *redisdb.Client) repositories.OIDCStateRepository {
+	return &OIDCStateRepo{
+		client: client,
+	}
+}
+
+func (r *OIDCStateRepo) StoreOIDCState(ctx context.Context, state string, oidcState *models.OIDCState, expiry time.Duration) error {
+	data, err := json.Marshal(oidcState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal OIDC state: %w", err)
+	}
+	return r.client.Set(ctx, constants.OIDCStatePrefix+state, data, expiry)
+}
+
+// GetOIDCState returns the sign-in started with the state, or nil if there is none or it has expired
+func (r *OIDCStateRepo) GetOIDCState(ctx context.Context, state string) (*models.OIDCState, error) {
+	data, err := r.client.Get(ctx, constants.OIDCStatePrefix+state)
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var oidcState models.OIDCState
+	if err := json.Unmarshal([]byte(data), &oidcState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal OIDC state: %w", err)
+	}
+	return &oidcState, nil
+}
+
+func (r *OIDCStateRepo) DeleteOIDCState(ctx context.Context, state string) error {
+	return r.client.Del(ctx, constants.OIDCStatePrefix+state)
+}
@@ -0,0 +1,41 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	redisdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/redis"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type OTPLimitRepo struct {
+	client *redisdb.Client
+}
+
+func NewOTPLimitRepository(client *redisdb.Client) repositories.OTPLimitRepository {
+	return &OTPLimitRepo{
+		client: client,
+	}
+}
+
+// StartResendCooldown blocks new OTPs for the identifier for the cooldown.
+// It returns false if a cooldown is already running.
+func (r *OTPLimitRepo) StartResendCooldown(ctx context.Context, identifier string, cooldown time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, constants.OTPResendCooldownPrefix+identifier, "1", cooldown)
+}
+
+// IncrementSendCount increases the number of OTPs sent to the identifier within the window
+func (r *OTPLimitRepo) IncrementSendCount(ctx context.Context, identifier string, window time.Duration) (int64, error) {
+	return r.client.IncrWithExpiry(ctx, constants.OTPSendCountPrefix+identifier, window)
+}
+
+// IncrementVerifyAttempts increases the number of times an OTP of the identifier was checked
+func (r *OTPLimitRepo) IncrementVerifyAttempts(ctx context.Context, identifier string, window time.Duration) (int64, error) {
+	return r.client.IncrWithExpiry(ctx, constants.OTPVerifyAttemptsPrefix+identifier, window)
+}
+
+// ResetVerifyAttempts clears the attempt counter, such as when a new OTP is sent
+func (r *OTPLimitRepo) ResetVerifyAttempts(ctx context.Context, identifier string) error {
+	return r.client.Del(ctx, constants.OTPVerifyAttemptsPrefix+identifier)
+}
@@ -0,0 +1,105 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	redisdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/redis"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	"github.com/redis/go-redis/v9"
+)
+
+type SessionRepo struct {
+	client *redisdb.Client
+}
+
+func NewSessionRepository(client *redisdb.Client) repositories.SessionRepository {
+	return &SessionRepo{
+		client: client,
+	}
+}
+
+// SaveSession stores the session and adds it to the user's session index.
+// The index expiry is extended so it lives at least as long as its newest session.
+func (r *SessionRepo) SaveSession(ctx context.Context, session *models.Session, expiry time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session: %w", err)
+	}
+
+	key := fmt.Sprintf("%s%s", constants.SessionPrefix, session.ID)
+	if err := r.client.Set(ctx, key, data, expiry); err != nil {
+		return err
+	}
+
+	indexKey := fmt.Sprintf("%s%s", constants.UserSessionsPrefix, session.UserID)
+	if err := r.client.SAdd(ctx, indexKey, session.ID); err != nil {
+		return err
+	}
+	return r.client.Expire(ctx, indexKey, expiry)
+}
+
+// GetSession returns the session with the given ID, or nil if it does not exist or has expired.
+func (r *SessionRepo) GetSession(ctx context.Context, sessionID string) (*models.Session, error) {
+	key := fmt.Sprintf("%s%s", constants.SessionPrefix, sessionID)
+
+	data, err := r.client.Get(ctx, key)
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var session models.Session
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal session: %w", err)
+	}
+	return &session, nil
+}
+
+// ListSessions returns the active sessions of a user, most recently used first.
+// Expired sessions still referenced by the index are removed from it.
+func (r *SessionRepo) ListSessions(ctx context.Context, userID string) ([]*models.Session, error) {
+	indexKey := fmt.Sprintf("%s%s", constants.UserSessionsPrefix, userID)
+
+	sessionIDs, err := r.client.SMembers(ctx, indexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*models.Session, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		session, err := r.GetSession(ctx, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		if session == nil {
+			if err := r.client.SRem(ctx, indexKey, sessionID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+	return sessions, nil
+}
+
+// DeleteSession removes the session and drops it from the user's session index.
+func (r *SessionRepo) DeleteSession(ctx context.Context, userID, sessionID string) error {
+	key := fmt.Sprintf("%s%s", constants.SessionPrefix, sessionID)
+	if err := r.client.Del(ctx, key); err != nil {
+		return err
+	}
+
+	indexKey := fmt.Sprintf("%s%s", constants.UserSessionsPrefix, userID)
+	return r.client.SRem(ctx, indexKey, sessionID)
+}
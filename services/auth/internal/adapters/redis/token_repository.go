@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/revocation"
 	redisdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/redis"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
@@ -12,25 +13,28 @@ import (
 )
 
 type TokenRepo struct {
-	client *redisdb.Client
+	client     *redisdb.Client
+	revocation *revocation.Checker
 }
 
 func NewTokenRepository(client *redisdb.Client) repositories.TokenRepository {
 	return &TokenRepo{
-		client: client,
+		client:     client,
+		revocation: revocation.NewChecker(client),
 	}
 }
 
-// StoreRefreshToken stores a refresh token in Redis with an expiration time.
-func (r *TokenRepo) StoreRefreshToken(ctx context.Context, userID string, token string, expiry time.Duration) error {
-	key := fmt.Sprintf("%s%s", constants.RefreshTokenPrefix, userID)
+// StoreRefreshToken stores the refresh token of a session in Redis with an expiration time.
+// Storing a new token for the same session replaces the previous one.
+func (r *TokenRepo) StoreRefreshToken(ctx context.Context, sessionID string, token string, expiry time.Duration) error {
+	key := fmt.Sprintf("%s%s", constants.RefreshTokenPrefix, sessionID)
 
 	return r.client.Set(ctx, key, token, expiry)
 }
 
-// ValidateRefreshToken checks if the provided token matches the one stored in Redis for a specific user.
-func (r *TokenRepo) ValidateRefreshToken(ctx context.Context, userID string, token string) (bool, error) {
-	key := fmt.Sprintf("%s%s", constants.RefreshTokenPrefix, userID)
+// ValidateRefreshToken checks if the provided token matches the one stored in Redis for a specific session.
+func (r *TokenRepo) ValidateRefreshToken(ctx context.Context, sessionID string, token string) (bool, error) {
+	key := fmt.Sprintf("%s%s", constants.RefreshTokenPrefix, sessionID)
 
 	storedToken, err := r.client.Get(ctx, key)
 	if err == redis.Nil {
@@ -42,12 +46,26 @@ func (r *TokenRepo) ValidateRefreshToken(ctx context.Context, userID string, tok
 	return storedToken == token, nil
 }
 
-// DeleteRefreshToken deletes the stored refresh token from Redis for the given user ID.
-func (r *TokenRepo) DeleteRefreshToken(ctx context.Context, userID string) error {
-	key := fmt.Sprintf("%s%s", constants.RefreshTokenPrefix, userID)
+// DeleteRefreshToken deletes the stored refresh token from Redis for the given session ID.
+func (r *TokenRepo) DeleteRefreshToken(ctx context.Context, sessionID string) error {
+	key := fmt.Sprintf("%s%s", constants.RefreshTokenPrefix, sessionID)
 	return r.client.Del(ctx, key)
 }
 
+// MarkRefreshTokenUsed records that the refresh token with the given ID has been rotated.
+// It returns false if the token was already marked, which means it is being reused.
+// The marker stores the session (token family) and lives until the token would expire.
+func (r *TokenRepo) MarkRefreshTokenUsed(ctx context.Context, tokenID string, sessionID string, expiry time.Duration) (bool, error) {
+	key := fmt.Sprintf("%s%s", constants.UsedRefreshTokenPrefix, tokenID)
+	return r.client.SetNX(ctx, key, sessionID, expiry)
+}
+
+// IsRefreshTokenUsed checks if the refresh token with the given ID has already been rotated.
+func (r *TokenRepo) IsRefreshTokenUsed(ctx context.Context, tokenID string) (bool, error) {
+	key := fmt.Sprintf("%s%s", constants.UsedRefreshTokenPrefix, tokenID)
+	return r.client.Exists(ctx, key)
+}
+
 // BlacklistToken stores a token ID in Redis to mark it as blacklisted.
 func (r *TokenRepo) BlacklistToken(ctx context.Context, tokenID string, expiry time.Duration) error {
 	key := fmt.Sprintf("%s%s", constants.BlacklistPrefix, tokenID)
@@ -56,15 +74,17 @@ func (r *TokenRepo) BlacklistToken(ctx context.Context, tokenID string, expiry t
 
 // IsTokenBlacklisted checks if a given token ID is in the blacklist.
 func (r *TokenRepo) IsTokenBlacklisted(ctx context.Context, tokenID string) (bool, error) {
-	key := fmt.Sprintf("%s%s", constants.BlacklistPrefix, tokenID)
+	return r.revocation.IsTokenBlacklisted(ctx, tokenID)
+}
 
-	// Check if key exists using Get method
-	_, err := r.client.Get(ctx, key)
-	if err == redis.Nil {
-		return false, nil
-	} else if err != nil {
-		return false, err
-	}
+// RevokeUserTokens marks every token issued to the user before revokedAt as revoked.
+// The marker is kept for as long as the longest-lived token can still be valid.
+func (r *TokenRepo) RevokeUserTokens(ctx context.Context, userID string, revokedAt time.Time, expiry time.Duration) error {
+	key := fmt.Sprintf("%s%s", constants.TokensRevokedAfterPrefix, userID)
+	return r.client.Set(ctx, key, revokedAt.Unix(), expiry)
+}
 
-	return true, nil
+// IsUserTokenRevoked checks if a token issued at issuedAt was revoked by RevokeUserTokens.
+func (r *TokenRepo) IsUserTokenRevoked(ctx context.Context, userID string, issuedAt time.Time) (bool, error) {
+	return r.revocation.IsUserTokenRevoked(ctx, userID, issuedAt)
 }
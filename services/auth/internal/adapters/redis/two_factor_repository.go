@@ -0,0 +1,48 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	redisdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/redis"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+type TwoFactorRepo struct {
+	client *redisdb.Client
+}
+
+func NewTwoFactorRepository(client *redisdb.Client) repositories.TwoFactorRepository {
+	return &TwoFactorRepo{
+		client: client,
+	}
+}
+
+// StoreChallenge remembers which admin passed the password step for the challenge token
+func (r *TwoFactorRepo) StoreChallenge(ctx context.Context, challengeToken string, adminID string, expiry time.Duration) error {
+	return r.client.Set(ctx, constants.TwoFactorChallengePrefix+challengeToken, adminID, expiry)
+}
+
+// GetChallenge returns the admin ID of the challenge, or an empty string if it does not exist
+func (r *TwoFactorRepo) GetChallenge(ctx context.Context, challengeToken string) (string, error) {
+	adminID, err := r.client.Get(ctx, constants.TwoFactorChallengePrefix+challengeToken)
+	if err == redis.Nil {
+		return "", nil
+	}
+	return adminID, err
+}
+
+func (r *TwoFactorRepo) DeleteChallenge(ctx context.Context, challengeToken string) error {
+	return r.client.Del(ctx, constants.TwoFactorChallengePrefix+challengeToken)
+}
+
+// MarkCodeUsed records that the code of the given time step was used.
+// It returns false if the code was already used, so it cannot be replayed.
+func (r *TwoFactorRepo) MarkCodeUsed(ctx context.Context, adminID string, counter int64, expiry time.Duration) (bool, error) {
+	key := fmt.Sprintf("%s%s:%d", constants.UsedTOTPCodePrefix, adminID, counter)
+	return r.client.SetNX(ctx, key, "1", expiry)
+}
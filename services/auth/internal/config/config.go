@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/viper"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
 )
 
 type Config struct {
@@ -18,6 +21,7 @@ type Config struct {
 	OTP          OTPConfig          `mapstructure:"otp"`
 	Registration RegistrationConfig `mapstructure:"registration"`
 	Security     SecurityConfig     `mapstructure:"security"`
+	SMS          SMSConfig          `mapstructure:"sms"`
 }
 
 type GRPCConfig struct {
@@ -54,20 +58,68 @@ type EmailConfig struct {
 	FromName  string `mapstructure:"from_name"`
 }
 
+type SMSConfig struct {
+	Provider string `mapstructure:"provider"`  // Only "file" is supported for now
+	FilePath string `mapstructure:"file_path"` // Where the file provider writes messages; empty logs only
+}
+
 type AuthConfig struct {
-	JWT JWTConfig `mapstructure:"jwt"`
+	JWT             JWTConfig             `mapstructure:"jwt"`
+	AccountDeletion AccountDeletionConfig `mapstructure:"account_deletion"`
+	LoginAlert      LoginAlertConfig      `mapstructure:"login_alert"`
+	OIDC            OIDCConfig            `mapstructure:"oidc"`
+}
+
+type OIDCConfig struct {
+	StateExpiryMinutes int                  `mapstructure:"state_expiry_minutes"` // How long a started sign-in waits for the provider's redirect
+	Providers          []OIDCProviderConfig `mapstructure:"providers"`
+}
+
+// OIDCProviderConfig configures an OpenID Connect provider users can sign in with.
+// Any compliant issuer works, including a local mock issuer during development.
+type OIDCProviderConfig struct {
+	Name         string   `mapstructure:"name"`          // Used in the gateway routes, e.g. /auth/oidc/google/start
+	Issuer       string   `mapstructure:"issuer"`        // Discovery is read from <issuer>/.well-known/openid-configuration
+	ClientID     string   `mapstructure:"client_id"`     // Client registered with the provider
+	ClientSecret string   `mapstructure:"client_secret"` // Can be set with OIDC_<NAME>_CLIENT_SECRET instead
+	RedirectURL  string   `mapstructure:"redirect_url"`  // Gateway callback, e.g. https://example.com/api/v1/auth/oidc/google/callback
+	Scopes       []string `mapstructure:"scopes"`        // Defaults to openid, email and profile
+}
+
+type LoginAlertConfig struct {
+	ReportURL         string `mapstructure:"report_url"`          // "This wasn't me" link in new-device alerts; the token is appended as ?token=
+	ReportExpiryHours int    `mapstructure:"report_expiry_hours"` // How long the link stays valid
+}
+
+type AccountDeletionConfig struct {
+	GracePeriodDays      int `mapstructure:"grace_period_days"`      // How long a deleted account can still be restored
+	PurgeIntervalMinutes int `mapstructure:"purge_interval_minutes"` // How often accounts past their grace period are purged
+	PurgeRetryMinutes    int `mapstructure:"purge_retry_minutes"`    // How long a purge waits for confirmations before user.deleted is published again
 }
 
 type JWTConfig struct {
-	SecretKey          string `mapstructure:"secret_key"`
-	AccessTokenMinutes int    `mapstructure:"access_token_minutes"`
-	RefreshTokenDays   int    `mapstructure:"refresh_token_days"`
-	Issuer             string `mapstructure:"issuer"`
+	SecretKey          string         `mapstructure:"secret_key"`
+	AccessTokenMinutes int            `mapstructure:"access_token_minutes"`
+	RefreshTokenDays   int            `mapstructure:"refresh_token_days"`
+	Issuer             string         `mapstructure:"issuer"`
+	SigningKey         JWTKeyConfig   `mapstructure:"signing_key"`       // Key new tokens are signed with; HS256 with secret_key when unset
+	VerificationKeys   []JWTKeyConfig `mapstructure:"verification_keys"` // Previous keys still accepted during a rotation
+}
+
+type JWTKeyConfig struct {
+	ID             string `mapstructure:"id"`
+	Algorithm      string `mapstructure:"algorithm"` // RS256 or EdDSA
+	PrivateKeyFile string `mapstructure:"private_key_file"`
+	PublicKeyFile  string `mapstructure:"public_key_file"`
 }
 
 type AdminConfig struct {
-	DefaultEmail    string `mapstructure:"default_email"`
-	DefaultPassword string `mapstructure:"default_password"`
+	DefaultEmail      string `mapstructure:"default_email"`
+	DefaultPassword   string `mapstructure:"default_password"`
+	Require2FA        bool   `mapstructure:"require_2fa"`         // Admins must enroll a TOTP authenticator before getting tokens (default true)
+	TOTPIssuer        string `mapstructure:"totp_issuer"`         // Name shown in the authenticator app
+	InviteURL         string `mapstructure:"invite_url"`          // Page that accepts invites; the token is appended as ?token=
+	InviteExpiryHours int    `mapstructure:"invite_expiry_hours"` // How long invite links stay valid
 }
 
 type RabbitMQConfig struct {
@@ -81,16 +133,31 @@ type OTPConfig struct {
 }
 
 type RegistrationConfig struct {
-	PendingExpiryHours int `mapstructure:"pending_expiry_hours"`
+	PendingExpiryHours     int `mapstructure:"pending_expiry_hours"`
+	ResendCooldownSeconds  int `mapstructure:"resend_cooldown_seconds"`  // Minimum time between two codes sent to the same email
+	MaxOTPSendsPerDay      int `mapstructure:"max_otp_sends_per_day"`    // Codes an email can be sent in 24 hours, including the first one
+	MaxVerifyAttempts      int `mapstructure:"max_verify_attempts"`      // Wrong codes accepted before a new code must be requested
+	CleanupIntervalMinutes int `mapstructure:"cleanup_interval_minutes"` // How often expired pending registrations are removed
 }
 
 type SecurityConfig struct {
-	PasswordMinLength int `mapstructure:"password_min_length"`
+	PasswordMinLength int                   `mapstructure:"password_min_length"`
+	LoginProtection   LoginProtectionConfig `mapstructure:"login_protection"`
+}
+
+type LoginProtectionConfig struct {
+	MaxAttemptsPerEmail         int `mapstructure:"max_attempts_per_email"`
+	MaxAttemptsPerIP            int `mapstructure:"max_attempts_per_ip"`
+	AttemptWindowMinutes        int `mapstructure:"attempt_window_minutes"`
+	BaseLockoutMinutes          int `mapstructure:"base_lockout_minutes"`
+	MaxLockoutMinutes           int `mapstructure:"max_lockout_minutes"`
+	LockoutHistoryWindowMinutes int `mapstructure:"lockout_history_window_minutes"`
 }
 
 func LoadConfig(path string) (*Config, error) {
 	viper.SetConfigFile(path)
 	viper.AutomaticEnv()
+	viper.SetDefault("admin.require_2fa", true)
 
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
@@ -129,5 +196,98 @@ func LoadConfig(path string) (*Config, error) {
 		config.Admin.DefaultPassword = adminPassword
 	}
 
+	if require2FA := os.Getenv("ADMIN_REQUIRE_2FA"); require2FA != "" {
+		config.Admin.Require2FA, _ = strconv.ParseBool(require2FA)
+	}
+	if config.Admin.TOTPIssuer == "" {
+		config.Admin.TOTPIssuer = constants.DefaultTOTPIssuer
+	}
+	if inviteURL := os.Getenv("ADMIN_INVITE_URL"); inviteURL != "" {
+		config.Admin.InviteURL = inviteURL
+	}
+	if config.Admin.InviteExpiryHours <= 0 {
+		config.Admin.InviteExpiryHours = constants.DefaultAdminInviteExpiry
+	}
+
+	if keyID := os.Getenv("JWT_SIGNING_KEY_ID"); keyID != "" {
+		config.Auth.JWT.SigningKey.ID = keyID
+	}
+	if keyFile := os.Getenv("JWT_SIGNING_KEY_FILE"); keyFile != "" {
+		config.Auth.JWT.SigningKey.PrivateKeyFile = keyFile
+	}
+
+	if config.Auth.AccountDeletion.GracePeriodDays <= 0 {
+		config.Auth.AccountDeletion.GracePeriodDays = constants.DefaultDeletionGracePeriod
+	}
+	if config.Auth.AccountDeletion.PurgeIntervalMinutes <= 0 {
+		config.Auth.AccountDeletion.PurgeIntervalMinutes = constants.DefaultPurgeInterval
+	}
+	if config.Auth.AccountDeletion.PurgeRetryMinutes <= 0 {
+		config.Auth.AccountDeletion.PurgeRetryMinutes = constants.DefaultPurgeRetry
+	}
+
+	if reportURL := os.Getenv("LOGIN_ALERT_REPORT_URL"); reportURL != "" {
+		config.Auth.LoginAlert.ReportURL = reportURL
+	}
+	if config.Auth.LoginAlert.ReportURL == "" {
+		return nil, fmt.Errorf("auth.login_alert.report_url (LOGIN_ALERT_REPORT_URL) is required for new-device login alerts")
+	}
+	if config.Auth.LoginAlert.ReportExpiryHours <= 0 {
+		config.Auth.LoginAlert.ReportExpiryHours = constants.DefaultLoginAlertReportExpiry
+	}
+
+	if config.Auth.OIDC.StateExpiryMinutes <= 0 {
+		config.Auth.OIDC.StateExpiryMinutes = constants.DefaultOIDCStateExpiry
+	}
+	for i, provider := range config.Auth.OIDC.Providers {
+		if secret := os.Getenv("OIDC_" + strings.ToUpper(provider.Name) + "_CLIENT_SECRET"); secret != "" {
+			config.Auth.OIDC.Providers[i].ClientSecret = secret
+		}
+	}
+
+	setLoginProtectionDefaults(&config.Security.LoginProtection)
+	setRegistrationDefaults(&config.Registration)
+
 	return &config, nil
 }
+
+// setRegistrationDefaults fills in any registration values missing from the config file
+func setRegistrationDefaults(cfg *RegistrationConfig) {
+	if cfg.PendingExpiryHours <= 0 {
+		cfg.PendingExpiryHours = constants.DefaultPendingExpiry
+	}
+	if cfg.ResendCooldownSeconds <= 0 {
+		cfg.ResendCooldownSeconds = constants.DefaultOTPResendCooldown
+	}
+	if cfg.MaxOTPSendsPerDay <= 0 {
+		cfg.MaxOTPSendsPerDay = constants.DefaultMaxOTPSendsPerDay
+	}
+	if cfg.MaxVerifyAttempts <= 0 {
+		cfg.MaxVerifyAttempts = constants.DefaultMaxOTPVerifyAttempts
+	}
+	if cfg.CleanupIntervalMinutes <= 0 {
+		cfg.CleanupIntervalMinutes = constants.DefaultPendingCleanupInterval
+	}
+}
+
+// setLoginProtectionDefaults fills in any login protection values missing from the config file
+func setLoginProtectionDefaults(cfg *LoginProtectionConfig) {
+	if cfg.MaxAttemptsPerEmail <= 0 {
+		cfg.MaxAttemptsPerEmail = constants.DefaultMaxFailedAttemptsPerEmail
+	}
+	if cfg.MaxAttemptsPerIP <= 0 {
+		cfg.MaxAttemptsPerIP = constants.DefaultMaxFailedAttemptsPerIP
+	}
+	if cfg.AttemptWindowMinutes <= 0 {
+		cfg.AttemptWindowMinutes = constants.DefaultFailedAttemptWindow
+	}
+	if cfg.BaseLockoutMinutes <= 0 {
+		cfg.BaseLockoutMinutes = constants.DefaultBaseLockout
+	}
+	if cfg.MaxLockoutMinutes <= 0 {
+		cfg.MaxLockoutMinutes = constants.DefaultMaxLockout
+	}
+	if cfg.LockoutHistoryWindowMinutes <= 0 {
+		cfg.LockoutHistoryWindowMinutes = constants.DefaultLockoutHistoryWindow
+	}
+}
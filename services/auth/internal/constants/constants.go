@@ -1,23 +1,56 @@
 package constants
 
+import "github.com/mohamedfawas/qubool-kallyanam/pkg/auth/revocation"
+
 // Redis key prefixes
 const (
-	RefreshTokenPrefix = "refresh_token:"
-	BlacklistPrefix    = "blacklist:"
-	OTPPrefix          = "otp:"
+	RefreshTokenPrefix       = "refresh_token:"
+	BlacklistPrefix          = revocation.BlacklistPrefix
+	OTPPrefix                = "otp:"
+	PasswordResetOTPPrefix   = "password_reset_otp:"
+	TokensRevokedAfterPrefix = revocation.TokensRevokedAfterPrefix
+	LoginAttemptsPrefix      = "login_attempts:"
+	LoginLockoutPrefix       = "login_lockout:"
+	LoginLockoutCountPrefix  = "login_lockout_count:"
+	SessionPrefix            = "session:"
+	UserSessionsPrefix       = "user_sessions:"
+	UsedRefreshTokenPrefix   = "used_refresh_token:"
+	PhoneVerifyOTPPrefix     = "phone_verify_otp:"
+	LoginOTPPrefix           = "login_otp:"
+	TwoFactorChallengePrefix = "two_factor_challenge:"
+	UsedTOTPCodePrefix       = "used_totp_code:"
+	LoginAlertReportPrefix   = "login_alert_report:"
+	ContactChangePrefix      = "contact_change:"
+	OTPResendCooldownPrefix  = "otp_resend_cooldown:"
+	OTPSendCountPrefix       = "otp_send_count:"
+	OTPVerifyAttemptsPrefix  = "otp_verify_attempts:"
+	SMSLimitPrefix           = "sms:"
+	OIDCStatePrefix          = "oidc_state:"
 )
 
 // gRPC headers (for internal service communication)
 const (
 	AuthorizationHeader = "authorization"
 	UserIDHeader        = "user-id"
+	SessionIDHeader     = "session-id"
+	ClientIPHeader      = "x-client-ip"
+	UserAgentHeader     = "x-user-agent"
 	BearerPrefix        = "Bearer "
 )
 
 // Event types for message broker
 const (
-	EventTypeLogin       = "login"
-	EventTypeUserDeleted = "user.deleted"
+	EventTypeLogin              = "login"
+	EventTypeUserDeleted        = "user.deleted"
+	EventTypeRefreshTokenReused = "security.refresh_token_reused"
+	EventTypeUserSuspended      = "user.suspended"
+	EventTypeUserReinstated     = "user.reinstated"
+	EventTypeUserDeactivated    = "user.deactivated"
+	EventTypeUserReactivated    = "user.reactivated"
+
+	EventTypeUserDeletionScheduled = "user.deletion_scheduled"
+	EventTypeUserDeletionCancelled = "user.deletion_cancelled"
+	EventTypeUserContactChanged    = "user.contact_changed"
 )
 
 // Topics for message broker
@@ -26,6 +59,18 @@ const (
 	TopicUserDeleted           = "user.deleted"
 	TopicSubscriptionActivated = "subscription.activated"
 	TopicSubscriptionExtended  = "subscription.extended"
+	TopicSubscriptionExpired   = "subscription.expired"
+	TopicSubscriptionExpiring  = "subscription.expiring"
+	TopicRefreshTokenReused    = "security.refresh_token_reused"
+	TopicUserSuspended         = "user.suspended"
+	TopicUserReinstated        = "user.reinstated"
+	TopicUserDeactivated       = "user.deactivated"
+	TopicUserReactivated       = "user.reactivated"
+
+	TopicUserDeletionScheduled = "user.deletion_scheduled"
+	TopicUserDeletionCancelled = "user.deletion_cancelled"
+	TopicUserPurgeCompleted    = "user.purge_completed"
+	TopicUserContactChanged    = "user.contact_changed"
 )
 
 // Auth service specific constants
@@ -34,3 +79,51 @@ const (
 	DefaultPendingExpiry = 1 // hours
 	MinPasswordLength    = 8
 )
+
+// Registration OTP defaults
+const (
+	DefaultOTPResendCooldown      = 60 // seconds
+	DefaultMaxOTPSendsPerDay      = 5
+	DefaultMaxOTPVerifyAttempts   = 5
+	DefaultPendingCleanupInterval = 30 // minutes
+	OTPSendCountWindow            = 24 // hours
+)
+
+// Two-factor authentication defaults
+const (
+	DefaultTwoFactorChallengeExpiry = 5 // minutes
+	DefaultRecoveryCodeCount        = 10
+	DefaultTOTPIssuer               = "Qubool Kallyanam"
+)
+
+// Admin management defaults
+const (
+	DefaultAdminInviteExpiry = 72 // hours
+)
+
+// New-device login alert defaults
+const (
+	DefaultLoginAlertReportExpiry = 7 * 24 // hours
+)
+
+// OpenID Connect sign-in defaults
+const (
+	DefaultOIDCStateExpiry = 10 // minutes
+)
+
+// Account deletion defaults
+const (
+	DefaultDeletionGracePeriod = 30  // days
+	DefaultPurgeInterval       = 60  // minutes
+	DefaultPurgeRetry          = 180 // minutes
+)
+
+// Login protection defaults
+const (
+	DefaultMaxFailedAttemptsPerEmail = 5
+	DefaultMaxFailedAttemptsPerIP    = 20
+	DefaultFailedAttemptWindow       = 15 // minutes
+	DefaultBaseLockout               = 5  // minutes
+	DefaultMaxLockout                = 24 * 60
+	DefaultLockoutHistoryWindow      = 24 * 60
+)
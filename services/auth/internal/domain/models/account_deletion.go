@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Account deletion statuses
+const (
+	AccountDeletionPending   = "pending"   // Within the grace period, the user can still restore the account
+	AccountDeletionPurging   = "purging"   // Purge requested, waiting for every service to confirm
+	AccountDeletionCompleted = "completed" // Every service confirmed its data was purged
+	AccountDeletionCancelled = "cancelled" // Restored by the user during the grace period
+)
+
+// Purge steps each service confirms through a user.purge_completed event
+const (
+	PurgeStepUserData = "user"
+	PurgeStepMedia    = "media"
+	PurgeStepChat     = "chat"
+	PurgeStepPayment  = "payment"
+)
+
+// AccountDeletion records a user's deletion request and the progress of the
+// purge across services. Records are kept after completion for auditing.
+type AccountDeletion struct {
+	ID               uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID           uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Status           string     `gorm:"size:20;not null"`
+	RequestedAt      time.Time  `gorm:"not null"`
+	PurgeAfter       time.Time  `gorm:"not null"`
+	PurgeStartedAt   *time.Time `gorm:"default:null"`
+	UserDataPurgedAt *time.Time `gorm:"default:null"`
+	MediaPurgedAt    *time.Time `gorm:"default:null"`
+	ChatPurgedAt     *time.Time `gorm:"default:null"`
+	PaymentPurgedAt  *time.Time `gorm:"default:null"`
+	CompletedAt      *time.Time `gorm:"default:null"`
+	CancelledAt      *time.Time `gorm:"default:null"`
+	CreatedAt        time.Time  `gorm:"not null"`
+	UpdatedAt        time.Time  `gorm:"not null"`
+}
+
+// TableName specifies table name for GORM
+func (AccountDeletion) TableName() string {
+	return "account_deletions"
+}
+
+func (d *AccountDeletion) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// PurgeStepColumn returns the column recording a service's confirmation, or "" for unknown steps
+func PurgeStepColumn(step string) string {
+	switch step {
+	case PurgeStepUserData:
+		return "user_data_purged_at"
+	case PurgeStepMedia:
+		return "media_purged_at"
+	case PurgeStepChat:
+		return "chat_purged_at"
+	case PurgeStepPayment:
+		return "payment_purged_at"
+	default:
+		return ""
+	}
+}
+
+// IsPurgeComplete reports whether every service confirmed its purge
+func (d *AccountDeletion) IsPurgeComplete() bool {
+	return d.UserDataPurgedAt != nil &&
+		d.MediaPurgedAt != nil &&
+		d.ChatPurgedAt != nil &&
+		d.PaymentPurgedAt != nil
+}
@@ -8,13 +8,17 @@ import (
 )
 
 type Admin struct {
-	ID           uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	Email        string         `gorm:"size:255;not null;uniqueIndex:idx_admins_email"`
-	PasswordHash string         `gorm:"size:255;not null"`
-	IsActive     bool           `gorm:"not null;default:true"`
-	CreatedAt    time.Time      `gorm:"not null"`
-	UpdatedAt    time.Time      `gorm:"not null"`
-	DeletedAt    gorm.DeletedAt `gorm:"index;column:deleted_at"`
+	ID                uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Email             string         `gorm:"size:255;not null;uniqueIndex:idx_admins_email"`
+	PasswordHash      string         `gorm:"size:255;not null"`
+	IsActive          bool           `gorm:"not null;default:true"`
+	Role              string         `gorm:"size:50;not null;default:super_admin"` // Name of the AdminRole deciding the admin's permissions
+	TOTPSecret        string         `gorm:"column:totp_secret;size:64"`
+	TOTPEnabled       bool           `gorm:"column:totp_enabled;not null;default:false"` // Set once the first code from the authenticator app is confirmed
+	TOTPRecoveryCodes string         `gorm:"column:totp_recovery_codes;type:text"`       // Comma separated SHA-256 hashes of the unused recovery codes
+	CreatedAt         time.Time      `gorm:"not null"`
+	UpdatedAt         time.Time      `gorm:"not null"`
+	DeletedAt         gorm.DeletedAt `gorm:"index;column:deleted_at"`
 }
 
 func (a *Admin) BeforeCreate(tx *gorm.DB) error {
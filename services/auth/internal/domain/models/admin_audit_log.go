@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Admin audit actions
+const (
+	AdminAuditInvited        = "admin.invited"
+	AdminAuditInviteAccepted = "admin.invite_accepted"
+	AdminAuditCreated        = "admin.created"
+	AdminAuditDeactivated    = "admin.deactivated"
+	AdminAuditPasswordReset  = "admin.password_reset"
+)
+
+// AdminAuditLog records a change made to an admin account and who made it
+type AdminAuditLog struct {
+	ID            int64      `gorm:"primaryKey"`
+	ActorAdminID  *uuid.UUID `gorm:"type:uuid"` // Nil when the admin acted on their own account, e.g. accepting an invite
+	Action        string     `gorm:"size:50;not null"`
+	TargetAdminID *uuid.UUID `gorm:"type:uuid"` // Nil for invites that have not been accepted yet
+	TargetEmail   string     `gorm:"size:255;not null"`
+	Details       string     `gorm:"type:text"`
+	CreatedAt     time.Time  `gorm:"not null"`
+}
+
+// TableName specifies table name for GORM
+func (AdminAuditLog) TableName() string {
+	return "admin_audit_logs"
+}
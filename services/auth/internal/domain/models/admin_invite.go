@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AdminInvite lets someone create their own admin account by choosing a password.
+// Only a hash of the invite token is stored; the token itself is emailed.
+type AdminInvite struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Email      string     `gorm:"size:255;not null"`
+	Role       string     `gorm:"size:50;not null"`
+	TokenHash  string     `gorm:"size:64;not null;uniqueIndex"`
+	InvitedBy  uuid.UUID  `gorm:"type:uuid;not null"`
+	ExpiresAt  time.Time  `gorm:"not null"`
+	AcceptedAt *time.Time `gorm:"default:null"`
+	CreatedAt  time.Time  `gorm:"not null"`
+}
+
+// TableName specifies table name for GORM
+func (AdminInvite) TableName() string {
+	return "admin_invites"
+}
+
+func (i *AdminInvite) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsUsable reports whether the invite can still be accepted at the given time
+func (i *AdminInvite) IsUsable(now time.Time) bool {
+	return i.AcceptedAt == nil && now.Before(i.ExpiresAt)
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// AdminRole is a staff role that admins can be assigned, such as moderator or finance
+type AdminRole struct {
+	Name        string    `gorm:"size:50;primaryKey"`
+	Description string    `gorm:"size:255;not null"`
+	CreatedAt   time.Time `gorm:"not null"`
+}
+
+// TableName specifies table name for GORM
+func (AdminRole) TableName() string {
+	return "admin_roles"
+}
+
+// AdminRolePermission grants a single permission to an admin role
+type AdminRolePermission struct {
+	Role       string `gorm:"size:50;primaryKey"`
+	Permission string `gorm:"size:100;primaryKey"`
+}
+
+// TableName specifies table name for GORM
+func (AdminRolePermission) TableName() string {
+	return "admin_role_permissions"
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Authentication event types
+const (
+	AuthEventLogin          = "login"
+	AuthEventLoginOTP       = "login_otp"
+	AuthEventOIDCLogin      = "oidc_login"
+	AuthEventAccountRestore = "account_restore"
+	AuthEventLogout         = "logout"
+	AuthEventTokenRefresh   = "token_refresh"
+	AuthEventPasswordReset  = "password_reset"
+	AuthEventLoginReported  = "login_reported"
+	AuthEventEmailChange    = "email_change"
+	AuthEventPhoneChange    = "phone_change"
+	AuthEventAdminLogin     = "admin_login"
+	AuthEventAdmin2FA       = "admin_2fa"
+)
+
+// Kinds of account an authentication event belongs to
+const (
+	AuthAccountUser  = "user"
+	AuthAccountAdmin = "admin"
+)
+
+// Authentication event outcomes
+const (
+	AuthOutcomeSuccess = "success"
+	AuthOutcomeFailure = "failure"
+)
+
+// AuthEvent records an authentication attempt for the security audit log
+type AuthEvent struct {
+	ID          int64      `gorm:"primaryKey"`
+	AccountID   *uuid.UUID `gorm:"type:uuid"` // Nil when the attempt did not match an account
+	AccountType string     `gorm:"size:10;not null"`
+	EventType   string     `gorm:"size:50;not null"`
+	Identifier  string     `gorm:"size:255"` // Email or phone number the attempt was made with
+	IPAddress   string     `gorm:"size:45"`
+	UserAgent   string     `gorm:"type:text"`
+	Outcome     string     `gorm:"size:10;not null"`
+	Reason      string     `gorm:"size:50"` // Why a failed attempt was rejected
+	CreatedAt   time.Time  `gorm:"not null"`
+}
+
+// TableName specifies table name for GORM
+func (AuthEvent) TableName() string {
+	return "auth_events"
+}
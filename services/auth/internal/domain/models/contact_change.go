@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// Contact fields a user can change
+const (
+	ContactFieldEmail = "email"
+	ContactFieldPhone = "phone"
+)
+
+// ContactChange is a requested email or phone change waiting for the OTP sent
+// to the new address or number
+type ContactChange struct {
+	UserID      string    `json:"user_id"`
+	Field       string    `json:"field"`
+	NewValue    string    `json:"new_value"`
+	OTP         string    `json:"otp"`
+	RequestedAt time.Time `json:"requested_at"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KnownDevice is a device and network a user has logged in from before.
+// Logins from a device or IP range without a matching entry trigger a new-device alert.
+type KnownDevice struct {
+	ID          int64     `gorm:"primaryKey"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null"`
+	Device      string    `gorm:"size:100;not null"` // Approximate device description, e.g. "Chrome on Windows"
+	IPRange     string    `gorm:"size:50;not null"`  // Network prefix of the login IP, e.g. "203.0.113.0/24"
+	FirstSeenAt time.Time `gorm:"not null"`
+	LastSeenAt  time.Time `gorm:"not null"`
+}
+
+// TableName specifies table name for GORM
+func (KnownDevice) TableName() string {
+	return "known_devices"
+}
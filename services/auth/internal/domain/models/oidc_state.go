@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// OIDCState is an OpenID Connect sign-in that was started and waits for the
+// provider to redirect the user back. It is looked up by the random state
+// parameter sent to the provider.
+type OIDCState struct {
+	Provider     string    `json:"provider"`
+	Nonce        string    `json:"nonce"`                  // Must match the nonce claim of the returned ID token
+	CodeVerifier string    `json:"code_verifier"`          // PKCE verifier for the code exchange
+	LinkUserID   string    `json:"link_user_id,omitempty"` // Set when a logged-in user links the provider account
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// Session represents a single logged-in device of a user.
+// Each session owns one refresh token, so devices can be signed out independently.
+type Session struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"user_id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
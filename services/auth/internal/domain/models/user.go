@@ -9,17 +9,24 @@ import (
 
 // User represents a user in the system
 type User struct {
-	ID           uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	Email        string         `gorm:"size:255;not null;uniqueIndex:users_email_unique_active,where:deleted_at IS NULL"`
-	Phone        string         `gorm:"size:20;not null;uniqueIndex:users_phone_unique_active,where:deleted_at IS NULL"`
-	PasswordHash string         `gorm:"size:255;not null"`
-	Verified     bool           `gorm:"not null;default:false"`
-	PremiumUntil *time.Time     `gorm:"default:null"`
-	LastLoginAt  *time.Time     `gorm:"column:last_login_at;default:null"`
-	IsActive     bool           `gorm:"not null;default:true"`
-	CreatedAt    time.Time      `gorm:"not null"`
-	UpdatedAt    time.Time      `gorm:"not null"`
-	DeletedAt    gorm.DeletedAt `gorm:"index;column:deleted_at"`
+	ID                    uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Email                 string         `gorm:"size:255;not null;uniqueIndex:users_email_unique_active,where:deleted_at IS NULL"`
+	Phone                 string         `gorm:"size:20;not null;uniqueIndex:users_phone_unique_active,where:deleted_at IS NULL AND phone <> ''"`
+	PasswordHash          string         `gorm:"size:255;not null"`
+	Verified              bool           `gorm:"not null;default:false"`
+	PhoneVerified         bool           `gorm:"column:phone_verified;not null;default:false"`
+	PremiumUntil          *time.Time     `gorm:"default:null"`
+	LastLoginAt           *time.Time     `gorm:"column:last_login_at;default:null"`
+	IsActive              bool           `gorm:"not null;default:true"`
+	SuspendedAt           *time.Time     `gorm:"column:suspended_at;default:null"`
+	SuspendedUntil        *time.Time     `gorm:"column:suspended_until;default:null"`
+	SuspensionReason      string         `gorm:"column:suspension_reason;size:500"`
+	BannedAt              *time.Time     `gorm:"column:banned_at;default:null"`
+	DeactivatedAt         *time.Time     `gorm:"column:deactivated_at;default:null"`
+	PasswordResetRequired bool           `gorm:"column:password_reset_required;not null;default:false"` // Logins are refused until the password is reset
+	CreatedAt             time.Time      `gorm:"not null"`
+	UpdatedAt             time.Time      `gorm:"not null"`
+	DeletedAt             gorm.DeletedAt `gorm:"index;column:deleted_at"`
 }
 
 // BeforeCreate generates a UUID if not present
@@ -37,3 +44,24 @@ func (u *User) IsPremium() bool {
 	}
 	return time.Now().Before(*u.PremiumUntil)
 }
+
+// IsBanned reports whether the account has been permanently banned
+func (u *User) IsBanned() bool {
+	return u.BannedAt != nil
+}
+
+// IsSuspended reports whether a suspension is in effect at the given time.
+// A suspension without an end time lasts until an administrator lifts it,
+// otherwise it ends on its own once SuspendedUntil has passed.
+func (u *User) IsSuspended(now time.Time) bool {
+	if u.SuspendedAt == nil {
+		return false
+	}
+	return u.SuspendedUntil == nil || now.Before(*u.SuspendedUntil)
+}
+
+// IsDeactivated reports whether the user has hidden their account.
+// The account is reactivated on the next login.
+func (u *User) IsDeactivated() bool {
+	return u.DeactivatedAt != nil
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentity links a user to an account at an external OpenID Connect provider.
+// The provider's subject identifier never changes, so logins are matched on it
+// rather than on the email address.
+type UserIdentity struct {
+	ID          int64     `gorm:"primaryKey"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null"`
+	Provider    string    `gorm:"size:50;not null"`  // Provider name from the config, e.g. "google"
+	Subject     string    `gorm:"size:255;not null"` // "sub" claim of the provider's ID tokens
+	Email       string    `gorm:"size:255"`          // Email the provider reported at the last login
+	CreatedAt   time.Time `gorm:"not null"`
+	LastLoginAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies table name for GORM
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+)
+
+type AccountDeletionRepository interface {
+	CreateDeletion(ctx context.Context, deletion *models.AccountDeletion) error
+	UpdateDeletion(ctx context.Context, deletion *models.AccountDeletion) error
+	// SetPurgedAt sets a single purge step column so confirmations arriving together do not overwrite each other
+	SetPurgedAt(ctx context.Context, deletionID uuid.UUID, column string, at time.Time) error
+	// GetOpenDeletion returns the user's pending or purging deletion, or nil if there is none
+	GetOpenDeletion(ctx context.Context, userID string) (*models.AccountDeletion, error)
+	// GetDueDeletions returns pending deletions whose grace period ended before the given time
+	GetDueDeletions(ctx context.Context, before time.Time, limit int) ([]*models.AccountDeletion, error)
+	// GetStalledPurges returns purging deletions that have not made progress since the given time
+	GetStalledPurges(ctx context.Context, before time.Time, limit int) ([]*models.AccountDeletion, error)
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+)
+
+type AdminAuditRepository interface {
+	RecordAdminAudit(ctx context.Context, entry *models.AdminAuditLog) error
+}
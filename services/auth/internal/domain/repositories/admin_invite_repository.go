@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+)
+
+type AdminInviteRepository interface {
+	CreateInvite(ctx context.Context, invite *models.AdminInvite) error
+	GetInviteByTokenHash(ctx context.Context, tokenHash string) (*models.AdminInvite, error)
+	MarkInviteAccepted(ctx context.Context, inviteID string, acceptedAt time.Time) error
+}
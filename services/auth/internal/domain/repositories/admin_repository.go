@@ -8,7 +8,11 @@ import (
 
 type AdminRepository interface {
 	GetAdminByEmail(ctx context.Context, email string) (*models.Admin, error)
+	GetAdminByID(ctx context.Context, id string) (*models.Admin, error)
 	CreateAdmin(ctx context.Context, admin *models.Admin) error
 	UpdateAdmin(ctx context.Context, admin *models.Admin) error
 	CheckAdminExists(ctx context.Context) (bool, error)
+	ListAdmins(ctx context.Context) ([]*models.Admin, error)
+	AdminRoleExists(ctx context.Context, role string) (bool, error)
+	GetRolePermissions(ctx context.Context, role string) ([]string, error)
 }
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+)
+
+type AuthEventRepository interface {
+	RecordAuthEvent(ctx context.Context, event *models.AuthEvent) error
+	ListAuthEvents(ctx context.Context, params ListAuthEventsParams) ([]*models.AuthEvent, int64, error)
+}
+
+type ListAuthEventsParams struct {
+	AccountID   string
+	AccountType string
+	EventType   string // Optional
+	Outcome     string // Optional
+	Limit       int
+	Offset      int
+}
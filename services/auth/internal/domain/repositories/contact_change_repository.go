@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+)
+
+// ContactChangeRepository stores email and phone changes until the new contact is verified
+type ContactChangeRepository interface {
+	StoreContactChange(ctx context.Context, change *models.ContactChange, expiry time.Duration) error
+	GetContactChange(ctx context.Context, userID, field string) (*models.ContactChange, error)
+	DeleteContactChange(ctx context.Context, userID, field string) error
+}
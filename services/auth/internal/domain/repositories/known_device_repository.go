@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+)
+
+// KnownDeviceRepository stores the devices and networks users have logged in from
+type KnownDeviceRepository interface {
+	GetKnownDevices(ctx context.Context, userID string) ([]*models.KnownDevice, error)
+	// SaveKnownDevice adds the device or, if the user already used it from the same IP range, updates when it was last seen
+	SaveKnownDevice(ctx context.Context, device *models.KnownDevice) error
+}
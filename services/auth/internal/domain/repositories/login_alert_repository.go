@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// LoginAlertRepository stores the tokens of the "this wasn't me" links sent in new-device login alerts
+type LoginAlertRepository interface {
+	StoreReportToken(ctx context.Context, token, userID string, expiry time.Duration) error
+	GetReportToken(ctx context.Context, token string) (string, error)
+	DeleteReportToken(ctx context.Context, token string) error
+}
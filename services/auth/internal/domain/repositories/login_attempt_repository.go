@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+type LoginAttemptRepository interface {
+	IncrementFailedAttempts(ctx context.Context, identifier string, window time.Duration) (int64, error)
+	ResetFailedAttempts(ctx context.Context, identifier string) error
+	IncrementLockoutCount(ctx context.Context, identifier string, window time.Duration) (int64, error)
+	Lock(ctx context.Context, identifier string, duration time.Duration) error
+	GetLockoutRemaining(ctx context.Context, identifier string) (time.Duration, error)
+}
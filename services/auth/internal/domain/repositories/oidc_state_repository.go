@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+)
+
+// OIDCStateRepository stores OpenID Connect sign-ins until the provider redirects back
+type OIDCStateRepository interface {
+	StoreOIDCState(ctx context.Context, state string, oidcState *models.OIDCState, expiry time.Duration) error
+	GetOIDCState(ctx context.Context, state string) (*models.OIDCState, error)
+	DeleteOIDCState(ctx context.Context, state string) error
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// OTPLimitRepository tracks how often OTPs are sent to and checked for an identifier
+type OTPLimitRepository interface {
+	StartResendCooldown(ctx context.Context, identifier string, cooldown time.Duration) (bool, error)
+	IncrementSendCount(ctx context.Context, identifier string, window time.Duration) (int64, error)
+	IncrementVerifyAttempts(ctx context.Context, identifier string, window time.Duration) (int64, error)
+	ResetVerifyAttempts(ctx context.Context, identifier string) error
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
 )
@@ -10,4 +11,5 @@ type RegistrationRepository interface {
 	CreatePendingRegistration(ctx context.Context, registration *models.PendingRegistration) error
 	GetPendingRegistration(ctx context.Context, field, value string) (*models.PendingRegistration, error)
 	DeletePendingRegistration(ctx context.Context, id int) error
+	DeleteExpiredPendingRegistrations(ctx context.Context, now time.Time) (int64, error)
 }
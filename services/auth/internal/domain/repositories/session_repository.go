@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+)
+
+type SessionRepository interface {
+	SaveSession(ctx context.Context, session *models.Session, expiry time.Duration) error
+	GetSession(ctx context.Context, sessionID string) (*models.Session, error)
+	ListSessions(ctx context.Context, userID string) ([]*models.Session, error)
+	DeleteSession(ctx context.Context, userID, sessionID string) error
+}
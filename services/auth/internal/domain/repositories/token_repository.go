@@ -6,9 +6,13 @@ import (
 )
 
 type TokenRepository interface {
-	StoreRefreshToken(ctx context.Context, userID string, token string, expiry time.Duration) error
-	ValidateRefreshToken(ctx context.Context, userID string, token string) (bool, error)
-	DeleteRefreshToken(ctx context.Context, userID string) error
+	StoreRefreshToken(ctx context.Context, sessionID string, token string, expiry time.Duration) error
+	ValidateRefreshToken(ctx context.Context, sessionID string, token string) (bool, error)
+	DeleteRefreshToken(ctx context.Context, sessionID string) error
+	MarkRefreshTokenUsed(ctx context.Context, tokenID string, sessionID string, expiry time.Duration) (bool, error)
+	IsRefreshTokenUsed(ctx context.Context, tokenID string) (bool, error)
 	BlacklistToken(ctx context.Context, tokenID string, expiry time.Duration) error
 	IsTokenBlacklisted(ctx context.Context, tokenID string) (bool, error)
+	RevokeUserTokens(ctx context.Context, userID string, revokedAt time.Time, expiry time.Duration) error
+	IsUserTokenRevoked(ctx context.Context, userID string, issuedAt time.Time) (bool, error)
 }
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// TwoFactorRepository stores the short-lived state of the second login step
+type TwoFactorRepository interface {
+	StoreChallenge(ctx context.Context, challengeToken string, adminID string, expiry time.Duration) error
+	GetChallenge(ctx context.Context, challengeToken string) (string, error)
+	DeleteChallenge(ctx context.Context, challengeToken string) error
+	MarkCodeUsed(ctx context.Context, adminID string, counter int64, expiry time.Duration) (bool, error)
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+)
+
+// UserIdentityRepository stores the external OpenID Connect accounts linked to users
+type UserIdentityRepository interface {
+	// GetIdentity returns the identity with the provider's subject, or nil if it is not linked to a user
+	GetIdentity(ctx context.Context, provider, subject string) (*models.UserIdentity, error)
+	CreateIdentity(ctx context.Context, identity *models.UserIdentity) error
+	// UpdateIdentityLogin stores the email reported at the latest login and when it happened
+	UpdateIdentityLogin(ctx context.Context, identity *models.UserIdentity) error
+}
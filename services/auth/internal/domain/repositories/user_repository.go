@@ -12,9 +12,13 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user *models.User) error
 	UpdateUser(ctx context.Context, user *models.User) error
 	SoftDeleteUser(ctx context.Context, userID string) error
+	PurgeUser(ctx context.Context, userID string) error
 	UpdateLastLogin(ctx context.Context, userID string) error
 	UpdatePremiumUntil(ctx context.Context, userID string, premiumUntil time.Time) error
+	ClearPremiumUntil(ctx context.Context, userID string, expiredAt time.Time) (bool, error)
 	IsRegistered(ctx context.Context, field, value string) (bool, error)
+	ChangeEmail(ctx context.Context, userID, email string) (bool, error)
+	ChangePhone(ctx context.Context, userID, phone string) (bool, error)
 	GetUsers(ctx context.Context, params GetUsersParams) ([]*models.User, int64, error)
 }
 
@@ -0,0 +1,283 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/messaging/rabbitmq"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+)
+
+// purgeBatchSize limits how many accounts are purged per run of the purge worker
+const purgeBatchSize = 100
+
+// AccountDeletionService runs the deletion saga. A deletion request starts a
+// grace period during which the user can restore the account. Once it ends the
+// account is purged: user.deleted is published and every service that holds
+// user data confirms its purge with a user.purge_completed event. Subscriber
+// queues are not durable, so a purge that stops making progress has its
+// user.deleted event published again.
+type AccountDeletionService struct {
+	deletionRepo  repositories.AccountDeletionRepository
+	userRepo      repositories.UserRepository
+	messageBroker *rabbitmq.Client
+	gracePeriod   time.Duration
+	retryAfter    time.Duration
+	logger        logging.Logger
+}
+
+func NewAccountDeletionService(
+	deletionRepo repositories.AccountDeletionRepository,
+	userRepo repositories.UserRepository,
+	messageBroker *rabbitmq.Client,
+	gracePeriod time.Duration,
+	retryAfter time.Duration,
+	logger logging.Logger,
+) *AccountDeletionService {
+	return &AccountDeletionService{
+		deletionRepo:  deletionRepo,
+		userRepo:      userRepo,
+		messageBroker: messageBroker,
+		gracePeriod:   gracePeriod,
+		retryAfter:    retryAfter,
+		logger:        logger,
+	}
+}
+
+// ScheduleDeletion starts the grace period for the user's account
+func (s *AccountDeletionService) ScheduleDeletion(ctx context.Context, userID uuid.UUID) (*models.AccountDeletion, error) {
+	existing, err := s.deletionRepo.GetOpenDeletion(ctx, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check account deletion: %w", err)
+	}
+	if existing != nil {
+		return nil, autherrors.ErrDeletionAlreadyScheduled
+	}
+
+	now := indianstandardtime.Now()
+	deletion := &models.AccountDeletion{
+		UserID:      userID,
+		Status:      models.AccountDeletionPending,
+		RequestedAt: now,
+		PurgeAfter:  now.Add(s.gracePeriod),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.deletionRepo.CreateDeletion(ctx, deletion); err != nil {
+		return nil, fmt.Errorf("failed to schedule account deletion: %w", err)
+	}
+
+	s.publish(constants.TopicUserDeletionScheduled, map[string]interface{}{
+		"user_id":     userID.String(),
+		"purge_after": deletion.PurgeAfter,
+		"event_type":  constants.EventTypeUserDeletionScheduled,
+		"timestamp":   now,
+	})
+
+	s.logger.Info("Account deletion scheduled", "userID", userID, "purgeAfter", deletion.PurgeAfter)
+	return deletion, nil
+}
+
+// GetPendingDeletion returns the user's deletion if it is still within the grace period
+func (s *AccountDeletionService) GetPendingDeletion(ctx context.Context, userID string) (*models.AccountDeletion, error) {
+	deletion, err := s.deletionRepo.GetOpenDeletion(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account deletion: %w", err)
+	}
+	if deletion == nil || deletion.Status != models.AccountDeletionPending {
+		return nil, nil
+	}
+	return deletion, nil
+}
+
+// CancelDeletion ends the grace period without purging the account
+func (s *AccountDeletionService) CancelDeletion(ctx context.Context, userID string) error {
+	deletion, err := s.GetPendingDeletion(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if deletion == nil {
+		return autherrors.ErrNoPendingDeletion
+	}
+
+	now := indianstandardtime.Now()
+	deletion.Status = models.AccountDeletionCancelled
+	deletion.CancelledAt = &now
+	deletion.UpdatedAt = now
+	if err := s.deletionRepo.UpdateDeletion(ctx, deletion); err != nil {
+		return fmt.Errorf("failed to cancel account deletion: %w", err)
+	}
+
+	s.publish(constants.TopicUserDeletionCancelled, map[string]interface{}{
+		"user_id":    userID,
+		"event_type": constants.EventTypeUserDeletionCancelled,
+		"timestamp":  now,
+	})
+
+	s.logger.Info("Account deletion cancelled", "userID", userID)
+	return nil
+}
+
+// PurgeDueAccounts starts the purge of every account whose grace period has ended
+// and retries purges that are still waiting for confirmations after retryAfter.
+func (s *AccountDeletionService) PurgeDueAccounts(ctx context.Context) (int, error) {
+	now := indianstandardtime.Now()
+	deletions, err := s.deletionRepo.GetDueDeletions(ctx, now, purgeBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get due account deletions: %w", err)
+	}
+
+	purged := 0
+	for _, deletion := range deletions {
+		if err := s.startPurge(ctx, deletion); err != nil {
+			s.logger.Error("Failed to start account purge", "userID", deletion.UserID, "error", err)
+			continue
+		}
+		purged++
+	}
+
+	stalled, err := s.deletionRepo.GetStalledPurges(ctx, now.Add(-s.retryAfter), purgeBatchSize)
+	if err != nil {
+		return purged, fmt.Errorf("failed to get stalled account purges: %w", err)
+	}
+
+	for _, deletion := range stalled {
+		if err := s.retryPurge(ctx, deletion); err != nil {
+			s.logger.Error("Failed to retry account purge", "userID", deletion.UserID, "error", err)
+		}
+	}
+
+	return purged, nil
+}
+
+// RecordPurgeCompleted stores a service's confirmation and completes the
+// deletion once every service has confirmed.
+func (s *AccountDeletionService) RecordPurgeCompleted(ctx context.Context, userID, step string) error {
+	deletion, err := s.deletionRepo.GetOpenDeletion(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get account deletion: %w", err)
+	}
+	if deletion == nil || deletion.Status != models.AccountDeletionPurging {
+		s.logger.Warn("Purge confirmation without a purge in progress", "userID", userID, "step", step)
+		return nil
+	}
+
+	column := models.PurgeStepColumn(step)
+	if column == "" {
+		s.logger.Warn("Unknown purge step", "userID", userID, "step", step)
+		return nil
+	}
+
+	if err := s.deletionRepo.SetPurgedAt(ctx, deletion.ID, column, indianstandardtime.Now()); err != nil {
+		return fmt.Errorf("failed to record purge step: %w", err)
+	}
+	s.logger.Info("Purge step confirmed", "userID", userID, "step", step)
+
+	// Reload to see the confirmations other services sent in the meantime
+	deletion, err = s.deletionRepo.GetOpenDeletion(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get account deletion: %w", err)
+	}
+	if deletion == nil || !deletion.IsPurgeComplete() {
+		return nil
+	}
+
+	// The auth record goes last so an unfinished purge can still be traced to the account
+	if err := s.userRepo.PurgeUser(ctx, userID); err != nil {
+		return fmt.Errorf("failed to purge user: %w", err)
+	}
+
+	now := indianstandardtime.Now()
+	deletion.Status = models.AccountDeletionCompleted
+	deletion.CompletedAt = &now
+	deletion.UpdatedAt = now
+	if err := s.deletionRepo.UpdateDeletion(ctx, deletion); err != nil {
+		return fmt.Errorf("failed to complete account deletion: %w", err)
+	}
+
+	s.logger.Info("Account purge completed", "userID", userID)
+	return nil
+}
+
+// RunPurgeWorker purges due accounts at the given interval until the context is cancelled
+func (s *AccountDeletionService) RunPurgeWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.PurgeDueAccounts(ctx)
+			if err != nil {
+				s.logger.Error("Account purge run failed", "error", err)
+				continue
+			}
+			if purged > 0 {
+				s.logger.Info("Started account purges", "count", purged)
+			}
+		}
+	}
+}
+
+func (s *AccountDeletionService) startPurge(ctx context.Context, deletion *models.AccountDeletion) error {
+	userID := deletion.UserID.String()
+	if err := s.userRepo.SoftDeleteUser(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	now := indianstandardtime.Now()
+	deletion.Status = models.AccountDeletionPurging
+	deletion.PurgeStartedAt = &now
+	deletion.UpdatedAt = now
+	if err := s.deletionRepo.UpdateDeletion(ctx, deletion); err != nil {
+		return fmt.Errorf("failed to update account deletion: %w", err)
+	}
+
+	s.publish(constants.TopicUserDeleted, map[string]interface{}{
+		"user_id":    userID,
+		"event_type": constants.EventTypeUserDeleted,
+		"timestamp":  now,
+	})
+
+	s.logger.Info("Account purge started", "userID", userID)
+	return nil
+}
+
+// retryPurge publishes user.deleted again for a purge some services never confirmed.
+// Services purge idempotently, so the ones that already confirmed just confirm again.
+func (s *AccountDeletionService) retryPurge(ctx context.Context, deletion *models.AccountDeletion) error {
+	userID := deletion.UserID.String()
+
+	now := indianstandardtime.Now()
+	deletion.UpdatedAt = now
+	if err := s.deletionRepo.UpdateDeletion(ctx, deletion); err != nil {
+		return fmt.Errorf("failed to update account deletion: %w", err)
+	}
+
+	s.publish(constants.TopicUserDeleted, map[string]interface{}{
+		"user_id":    userID,
+		"event_type": constants.EventTypeUserDeleted,
+		"timestamp":  now,
+	})
+
+	s.logger.Warn("Account purge stalled, user.deleted published again", "userID", userID, "purgeStartedAt", deletion.PurgeStartedAt)
+	return nil
+}
+
+func (s *AccountDeletionService) publish(topic string, event map[string]interface{}) {
+	if s.messageBroker == nil {
+		return
+	}
+	if err := s.messageBroker.Publish(topic, event); err != nil {
+		s.logger.Error("Failed to publish account deletion event", "topic", topic, "userID", event["user_id"], "error", err)
+	}
+}
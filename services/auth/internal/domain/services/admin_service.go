@@ -2,9 +2,17 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/email"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/encryption"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
@@ -13,18 +21,45 @@ import (
 	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
 )
 
+// AdminService manages admin accounts. Every method that changes an account
+// requires the acting admin to hold the admins:manage permission and records
+// the change in the admin audit log.
 type AdminService struct {
-	adminRepo repositories.AdminRepository
-	logger    logging.Logger
+	adminRepo       repositories.AdminRepository
+	inviteRepo      repositories.AdminInviteRepository
+	auditRepo       repositories.AdminAuditRepository
+	tokenRepo       repositories.TokenRepository
+	sessionService  *SessionService
+	emailClient     *email.Client
+	inviteURL       string
+	inviteExpiry    time.Duration
+	refreshTokenTTL time.Duration
+	logger          logging.Logger
 }
 
 func NewAdminService(
 	adminRepo repositories.AdminRepository,
+	inviteRepo repositories.AdminInviteRepository,
+	auditRepo repositories.AdminAuditRepository,
+	tokenRepo repositories.TokenRepository,
+	sessionService *SessionService,
+	emailClient *email.Client,
+	inviteURL string,
+	inviteExpiry time.Duration,
+	refreshTokenTTL time.Duration,
 	logger logging.Logger,
 ) *AdminService {
 	return &AdminService{
-		adminRepo: adminRepo,
-		logger:    logger,
+		adminRepo:       adminRepo,
+		inviteRepo:      inviteRepo,
+		auditRepo:       auditRepo,
+		tokenRepo:       tokenRepo,
+		sessionService:  sessionService,
+		emailClient:     emailClient,
+		inviteURL:       inviteURL,
+		inviteExpiry:    inviteExpiry,
+		refreshTokenTTL: refreshTokenTTL,
+		logger:          logger,
 	}
 }
 
@@ -58,6 +93,7 @@ func (s *AdminService) InitializeDefaultAdmin(ctx context.Context, defaultEmail,
 		Email:        defaultEmail,
 		PasswordHash: hashedPassword,
 		IsActive:     true,
+		Role:         string(jwt.AdminRoleSuperAdmin),
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}
@@ -69,3 +105,321 @@ func (s *AdminService) InitializeDefaultAdmin(ctx context.Context, defaultEmail,
 	s.logger.Info("Default admin account created successfully", "email", defaultEmail)
 	return nil
 }
+
+// ListAdmins returns all admin accounts
+func (s *AdminService) ListAdmins(ctx context.Context, actorID string) ([]*models.Admin, error) {
+	if _, err := s.Authorize(ctx, actorID, jwt.PermissionAdminsManage); err != nil {
+		return nil, err
+	}
+	return s.adminRepo.ListAdmins(ctx)
+}
+
+// CreateAdmin creates an active admin account with the given password and role
+func (s *AdminService) CreateAdmin(ctx context.Context, actorID, adminEmail, password, role string) (*models.Admin, error) {
+	actor, err := s.Authorize(ctx, actorID, jwt.PermissionAdminsManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateNewAdmin(ctx, adminEmail, role); err != nil {
+		return nil, err
+	}
+
+	admin, err := s.createAdmin(ctx, adminEmail, password, role)
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordAudit(ctx, actor, models.AdminAuditCreated, admin, admin.Email, "role="+role)
+	s.logger.Info("Admin account created", "adminId", admin.ID, "role", role, "createdBy", actor.ID)
+	return admin, nil
+}
+
+// InviteAdmin emails an invite link that lets the recipient create an admin account with the given role
+func (s *AdminService) InviteAdmin(ctx context.Context, actorID, adminEmail, role string) (*models.AdminInvite, error) {
+	actor, err := s.Authorize(ctx, actorID, jwt.PermissionAdminsManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateNewAdmin(ctx, adminEmail, role); err != nil {
+		return nil, err
+	}
+
+	token, err := randomHex(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate invite token: %w", err)
+	}
+
+	now := indianstandardtime.Now()
+	invite := &models.AdminInvite{
+		Email:     adminEmail,
+		Role:      role,
+		TokenHash: hashInviteToken(token),
+		InvitedBy: actor.ID,
+		ExpiresAt: now.Add(s.inviteExpiry),
+		CreatedAt: now,
+	}
+
+	if err := s.inviteRepo.CreateInvite(ctx, invite); err != nil {
+		s.logger.Error("Failed to create admin invite", "email", adminEmail, "error", err)
+		return nil, fmt.Errorf("failed to create admin invite: %w", err)
+	}
+
+	if err := s.emailClient.SendAdminInviteEmail(adminEmail, role, s.inviteLink(token), s.inviteExpiry); err != nil {
+		s.logger.Error("Failed to send admin invite email", "email", adminEmail, "error", err)
+		return nil, fmt.Errorf("failed to send admin invite email: %w", err)
+	}
+
+	s.recordAudit(ctx, actor, models.AdminAuditInvited, nil, adminEmail, "role="+role)
+	s.logger.Info("Admin invited", "email", adminEmail, "role", role, "invitedBy", actor.ID)
+	return invite, nil
+}
+
+// AcceptInvite creates the invited admin account with the password chosen by the invitee
+func (s *AdminService) AcceptInvite(ctx context.Context, token, password string) (*models.Admin, error) {
+	if token == "" {
+		return nil, autherrors.ErrAdminInviteNotFound
+	}
+
+	invite, err := s.inviteRepo.GetInviteByTokenHash(ctx, hashInviteToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve admin invite: %w", err)
+	}
+	if invite == nil || !invite.IsUsable(indianstandardtime.Now()) {
+		return nil, autherrors.ErrAdminInviteNotFound
+	}
+
+	existing, err := s.adminRepo.GetAdminByEmail(ctx, invite.Email)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving admin: %w", err)
+	}
+	if existing != nil {
+		return nil, autherrors.ErrAdminAlreadyExists
+	}
+
+	admin, err := s.createAdmin(ctx, invite.Email, password, invite.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.inviteRepo.MarkInviteAccepted(ctx, invite.ID.String(), indianstandardtime.Now()); err != nil {
+		s.logger.Error("Failed to mark admin invite as accepted", "inviteId", invite.ID, "error", err)
+	}
+
+	s.recordAudit(ctx, nil, models.AdminAuditInviteAccepted, admin, admin.Email, "invited_by="+invite.InvitedBy.String())
+	s.logger.Info("Admin invite accepted", "adminId", admin.ID, "role", admin.Role)
+	return admin, nil
+}
+
+// DeactivateAdmin disables the admin account and signs it out everywhere
+func (s *AdminService) DeactivateAdmin(ctx context.Context, actorID, adminID string) (*models.Admin, error) {
+	actor, err := s.Authorize(ctx, actorID, jwt.PermissionAdminsManage)
+	if err != nil {
+		return nil, err
+	}
+	if actorID == adminID {
+		return nil, autherrors.ErrCannotModifySelf
+	}
+
+	admin, err := s.getTargetAdmin(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	admin.IsActive = false
+	admin.UpdatedAt = indianstandardtime.Now()
+	if err := s.adminRepo.UpdateAdmin(ctx, admin); err != nil {
+		s.logger.Error("Failed to deactivate admin", "adminId", adminID, "error", err)
+		return nil, fmt.Errorf("failed to deactivate admin: %w", err)
+	}
+
+	s.signOutEverywhere(ctx, admin)
+
+	s.recordAudit(ctx, actor, models.AdminAuditDeactivated, admin, admin.Email, "")
+	s.logger.Info("Admin deactivated", "adminId", adminID, "deactivatedBy", actor.ID)
+	return admin, nil
+}
+
+// ResetAdminPassword sets a new password for the admin and signs it out everywhere.
+// resetTwoFactor also removes the admin's authenticator, for example after it was lost,
+// so the admin enrolls again on the next login.
+func (s *AdminService) ResetAdminPassword(ctx context.Context, actorID, adminID, newPassword string, resetTwoFactor bool) (*models.Admin, error) {
+	actor, err := s.Authorize(ctx, actorID, jwt.PermissionAdminsManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validation.ValidatePassword(newPassword, validation.DefaultPasswordPolicy()) {
+		return nil, autherrors.ErrWeakPassword
+	}
+
+	admin, err := s.getTargetAdmin(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := encryption.HashPassword(newPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	admin.PasswordHash = hashedPassword
+	if resetTwoFactor {
+		admin.TOTPSecret = ""
+		admin.TOTPEnabled = false
+		admin.TOTPRecoveryCodes = ""
+	}
+	admin.UpdatedAt = indianstandardtime.Now()
+
+	if err := s.adminRepo.UpdateAdmin(ctx, admin); err != nil {
+		s.logger.Error("Failed to reset admin password", "adminId", adminID, "error", err)
+		return nil, fmt.Errorf("failed to reset admin password: %w", err)
+	}
+
+	s.signOutEverywhere(ctx, admin)
+
+	s.recordAudit(ctx, actor, models.AdminAuditPasswordReset, admin, admin.Email, fmt.Sprintf("reset_two_factor=%t", resetTwoFactor))
+	s.logger.Info("Admin password reset", "adminId", adminID, "resetBy", actor.ID, "resetTwoFactor", resetTwoFactor)
+	return admin, nil
+}
+
+// Authorize returns the acting admin if it is active and its role grants the permission
+func (s *AdminService) Authorize(ctx context.Context, actorID string, permission jwt.Permission) (*models.Admin, error) {
+	if actorID == "" {
+		return nil, autherrors.ErrAdminPermissionDenied
+	}
+
+	actor, err := s.adminRepo.GetAdminByID(ctx, actorID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving admin: %w", err)
+	}
+	if actor == nil || !actor.IsActive {
+		return nil, autherrors.ErrAdminPermissionDenied
+	}
+
+	permissions, err := s.adminRepo.GetRolePermissions(ctx, actor.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load admin permissions: %w", err)
+	}
+	for _, granted := range permissions {
+		if jwt.Permission(granted) == permission {
+			return actor, nil
+		}
+	}
+
+	s.logger.Debug("Admin lacks permission", "adminId", actorID, "role", actor.Role, "permission", permission)
+	return nil, autherrors.ErrAdminPermissionDenied
+}
+
+// validateNewAdmin checks the email and role of an admin about to be created or invited
+func (s *AdminService) validateNewAdmin(ctx context.Context, adminEmail, role string) error {
+	if !validation.ValidateEmail(adminEmail) {
+		return autherrors.ErrInvalidAdminInput
+	}
+
+	exists, err := s.adminRepo.AdminRoleExists(ctx, role)
+	if err != nil {
+		return fmt.Errorf("failed to check admin role: %w", err)
+	}
+	if !exists {
+		return autherrors.ErrInvalidAdminRole
+	}
+
+	existing, err := s.adminRepo.GetAdminByEmail(ctx, adminEmail)
+	if err != nil {
+		return fmt.Errorf("error retrieving admin: %w", err)
+	}
+	if existing != nil {
+		return autherrors.ErrAdminAlreadyExists
+	}
+
+	return nil
+}
+
+func (s *AdminService) createAdmin(ctx context.Context, adminEmail, password, role string) (*models.Admin, error) {
+	if !validation.ValidatePassword(password, validation.DefaultPasswordPolicy()) {
+		return nil, autherrors.ErrWeakPassword
+	}
+
+	hashedPassword, err := encryption.HashPassword(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash admin password: %w", err)
+	}
+
+	now := indianstandardtime.Now()
+	admin := &models.Admin{
+		Email:        adminEmail,
+		PasswordHash: hashedPassword,
+		IsActive:     true,
+		Role:         role,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := s.adminRepo.CreateAdmin(ctx, admin); err != nil {
+		s.logger.Error("Failed to create admin", "email", adminEmail, "error", err)
+		return nil, fmt.Errorf("failed to create admin: %w", err)
+	}
+
+	return admin, nil
+}
+
+func (s *AdminService) getTargetAdmin(ctx context.Context, adminID string) (*models.Admin, error) {
+	if _, err := uuid.Parse(adminID); err != nil {
+		return nil, autherrors.ErrInvalidAdminInput
+	}
+
+	admin, err := s.adminRepo.GetAdminByID(ctx, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving admin: %w", err)
+	}
+	if admin == nil {
+		return nil, autherrors.ErrAdminNotFound
+	}
+	return admin, nil
+}
+
+// signOutEverywhere revokes the admin's sessions and every access token issued so far
+func (s *AdminService) signOutEverywhere(ctx context.Context, admin *models.Admin) {
+	adminID := admin.ID.String()
+	if _, err := s.sessionService.RevokeAllSessions(ctx, adminID); err != nil {
+		s.logger.Error("Failed to revoke admin sessions", "adminId", adminID, "error", err)
+	}
+	if err := s.tokenRepo.RevokeUserTokens(ctx, adminID, indianstandardtime.Now(), s.refreshTokenTTL); err != nil {
+		s.logger.Error("Failed to revoke admin tokens", "adminId", adminID, "error", err)
+	}
+}
+
+// recordAudit stores an audit log entry. Failures are logged so they never undo the change itself.
+func (s *AdminService) recordAudit(ctx context.Context, actor *models.Admin, action string, target *models.Admin, targetEmail, details string) {
+	entry := &models.AdminAuditLog{
+		Action:      action,
+		TargetEmail: targetEmail,
+		Details:     details,
+		CreatedAt:   indianstandardtime.Now(),
+	}
+	if actor != nil {
+		entry.ActorAdminID = &actor.ID
+	}
+	if target != nil {
+		entry.TargetAdminID = &target.ID
+	}
+
+	if err := s.auditRepo.RecordAdminAudit(ctx, entry); err != nil {
+		s.logger.Error("Failed to record admin audit log", "action", action, "targetEmail", targetEmail, "error", err)
+	}
+}
+
+func (s *AdminService) inviteLink(token string) string {
+	separator := "?"
+	if strings.Contains(s.inviteURL, "?") {
+		separator = "&"
+	}
+	return s.inviteURL + separator + "token=" + url.QueryEscape(token)
+}
+
+func hashInviteToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
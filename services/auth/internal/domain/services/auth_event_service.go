@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+)
+
+// ReasonTwoFactorRequired is recorded when an admin password was accepted
+// but the login still waits for a two-factor code
+const ReasonTwoFactorRequired = "two_factor_required"
+
+// authEventReasons maps the errors a failed attempt can end with to the
+// reason stored with the event. Unknown errors are stored as internal_error.
+var authEventReasons = []struct {
+	err    error
+	reason string
+}{
+	{autherrors.ErrInvalidCredentials, "invalid_credentials"},
+	{autherrors.ErrAccountLocked, "account_locked"},
+	{autherrors.ErrAccountDisabled, "account_disabled"},
+	{autherrors.ErrAccountNotVerified, "account_not_verified"},
+	{autherrors.ErrAccountSuspended, "account_suspended"},
+	{autherrors.ErrAccountBanned, "account_banned"},
+	{autherrors.ErrAccountPendingDeletion, "account_pending_deletion"},
+	{autherrors.ErrPasswordResetRequired, "password_reset_required"},
+	{autherrors.ErrNoPendingDeletion, "no_pending_deletion"},
+	{autherrors.ErrUserNotFound, "user_not_found"},
+	{autherrors.ErrInvalidOTP, "invalid_otp"},
+	{autherrors.ErrInvalidInput, "invalid_input"},
+	{autherrors.ErrWeakPassword, "weak_password"},
+	{autherrors.ErrInvalidToken, "invalid_token"},
+	{autherrors.ErrInvalidRefreshToken, "invalid_refresh_token"},
+	{autherrors.ErrRefreshTokenReused, "refresh_token_reused"},
+	{autherrors.ErrInvalidOIDCState, "invalid_oidc_state"},
+	{autherrors.ErrOIDCAuthenticationFailed, "oidc_authentication_failed"},
+	{autherrors.ErrOIDCEmailNotVerified, "oidc_email_not_verified"},
+	{autherrors.ErrAdminNotFound, "admin_not_found"},
+	{autherrors.ErrAdminAccountDisabled, "admin_account_disabled"},
+	{autherrors.ErrTwoFactorChallengeNotFound, "two_factor_challenge_expired"},
+	{autherrors.ErrInvalidTwoFactorCode, "invalid_two_factor_code"},
+}
+
+// AuthEventService keeps the security audit log of authentication attempts.
+// Recording never fails the attempt itself; storage errors are only logged.
+type AuthEventService struct {
+	authEventRepo repositories.AuthEventRepository
+	adminService  *AdminService
+	logger        logging.Logger
+}
+
+func NewAuthEventService(
+	authEventRepo repositories.AuthEventRepository,
+	adminService *AdminService,
+	logger logging.Logger,
+) *AuthEventService {
+	return &AuthEventService{
+		authEventRepo: authEventRepo,
+		adminService:  adminService,
+		logger:        logger,
+	}
+}
+
+// RecordUserEvent records an attempt made with a user identifier. The user is
+// nil when the identifier did not match an account.
+func (s *AuthEventService) RecordUserEvent(ctx context.Context, eventType string, user *models.User, identifier, clientIP, userAgent string, err error) {
+	event := &models.AuthEvent{
+		AccountType: models.AuthAccountUser,
+		EventType:   eventType,
+		Identifier:  identifier,
+		IPAddress:   clientIP,
+		UserAgent:   userAgent,
+	}
+	if user != nil {
+		event.AccountID = &user.ID
+	}
+	s.record(ctx, event, err)
+}
+
+// RecordAdminEvent records an admin login attempt. The admin is nil when the
+// email did not match an admin account.
+func (s *AuthEventService) RecordAdminEvent(ctx context.Context, eventType string, admin *models.Admin, identifier, clientIP, userAgent string, err error) {
+	event := &models.AuthEvent{
+		AccountType: models.AuthAccountAdmin,
+		EventType:   eventType,
+		Identifier:  identifier,
+		IPAddress:   clientIP,
+		UserAgent:   userAgent,
+	}
+	if admin != nil {
+		event.AccountID = &admin.ID
+	}
+	s.record(ctx, event, err)
+}
+
+// RecordAdminChallenge records an admin password that was accepted while the
+// login still waits for the two-factor code
+func (s *AuthEventService) RecordAdminChallenge(ctx context.Context, admin *models.Admin, clientIP, userAgent string) {
+	s.record(ctx, &models.AuthEvent{
+		AccountID:   &admin.ID,
+		AccountType: models.AuthAccountAdmin,
+		EventType:   models.AuthEventAdminLogin,
+		Identifier:  admin.Email,
+		IPAddress:   clientIP,
+		UserAgent:   userAgent,
+		Outcome:     models.AuthOutcomeSuccess,
+		Reason:      ReasonTwoFactorRequired,
+	}, nil)
+}
+
+// RecordTokenEvent records a logout or token refresh. The account comes from
+// the token claims, which are nil when the token could not be validated.
+func (s *AuthEventService) RecordTokenEvent(ctx context.Context, eventType string, claims *jwt.Claims, clientIP, userAgent string, err error) {
+	event := &models.AuthEvent{
+		AccountType: models.AuthAccountUser,
+		EventType:   eventType,
+		IPAddress:   clientIP,
+		UserAgent:   userAgent,
+	}
+	if claims != nil {
+		if claims.Role == jwt.RoleAdmin {
+			event.AccountType = models.AuthAccountAdmin
+		}
+		if accountID, parseErr := uuid.Parse(claims.UserID); parseErr == nil {
+			event.AccountID = &accountID
+		}
+	}
+	s.record(ctx, event, err)
+}
+
+// ListUserEvents returns the user's own security activity, newest first
+func (s *AuthEventService) ListUserEvents(ctx context.Context, userID string, limit, offset int) ([]*models.AuthEvent, int64, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, 0, autherrors.ErrInvalidInput
+	}
+	return s.list(ctx, repositories.ListAuthEventsParams{
+		AccountID:   userID,
+		AccountType: models.AuthAccountUser,
+		Limit:       limit,
+		Offset:      offset,
+	})
+}
+
+// GetUserEvents lets an admin with the users:read permission query a user's authentication events
+func (s *AuthEventService) GetUserEvents(ctx context.Context, actorID string, params repositories.ListAuthEventsParams) ([]*models.AuthEvent, int64, error) {
+	if _, err := s.adminService.Authorize(ctx, actorID, jwt.PermissionUsersRead); err != nil {
+		return nil, 0, err
+	}
+	if _, err := uuid.Parse(params.AccountID); err != nil {
+		return nil, 0, autherrors.ErrInvalidInput
+	}
+	if params.Outcome != "" && params.Outcome != models.AuthOutcomeSuccess && params.Outcome != models.AuthOutcomeFailure {
+		return nil, 0, autherrors.ErrInvalidInput
+	}
+
+	params.AccountType = models.AuthAccountUser
+	return s.list(ctx, params)
+}
+
+func (s *AuthEventService) list(ctx context.Context, params repositories.ListAuthEventsParams) ([]*models.AuthEvent, int64, error) {
+	events, total, err := s.authEventRepo.ListAuthEvents(ctx, params)
+	if err != nil {
+		s.logger.Error("Failed to list auth events", "accountId", params.AccountID, "error", err)
+		return nil, 0, fmt.Errorf("failed to list auth events: %w", err)
+	}
+	return events, total, nil
+}
+
+func (s *AuthEventService) record(ctx context.Context, event *models.AuthEvent, err error) {
+	if event.Outcome == "" {
+		event.Outcome = models.AuthOutcomeSuccess
+		if err != nil {
+			event.Outcome = models.AuthOutcomeFailure
+			event.Reason = authEventReason(err)
+		}
+	}
+	event.CreatedAt = indianstandardtime.Now()
+
+	if err := s.authEventRepo.RecordAuthEvent(ctx, event); err != nil {
+		s.logger.Error("Failed to record auth event", "eventType", event.EventType, "accountId", event.AccountID, "error", err)
+	}
+}
+
+func authEventReason(err error) string {
+	for _, known := range authEventReasons {
+		if errors.Is(err, known.err) {
+			return known.reason
+		}
+	}
+	return "internal_error"
+}
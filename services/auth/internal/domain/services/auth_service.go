@@ -11,6 +11,7 @@ import (
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/messaging/rabbitmq"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/encryption"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
@@ -25,14 +26,21 @@ type TokenPair struct {
 }
 
 type AuthService struct {
-	userRepo        repositories.UserRepository
-	tokenRepo       repositories.TokenRepository
-	adminRepo       repositories.AdminRepository
-	jwtManager      *jwt.Manager
-	logger          logging.Logger
-	accessTokenTTL  time.Duration
-	refreshTokenTTL time.Duration
-	messageBroker   *rabbitmq.Client
+	userRepo         repositories.UserRepository
+	tokenRepo        repositories.TokenRepository
+	adminRepo        repositories.AdminRepository
+	jwtManager       *jwt.Manager
+	logger           logging.Logger
+	accessTokenTTL   time.Duration
+	refreshTokenTTL  time.Duration
+	messageBroker    *rabbitmq.Client
+	loginProtection  *LoginProtectionService
+	sessionService   *SessionService
+	phoneService     *PhoneService
+	twoFactorService *TwoFactorService
+	accountDeletion  *AccountDeletionService
+	authEvents       *AuthEventService
+	loginAlerts      *LoginAlertService
 }
 
 func NewAuthService(
@@ -44,21 +52,44 @@ func NewAuthService(
 	accessTokenTTL time.Duration,
 	refreshTokenTTL time.Duration,
 	messageBroker *rabbitmq.Client,
+	loginProtection *LoginProtectionService,
+	sessionService *SessionService,
+	phoneService *PhoneService,
+	twoFactorService *TwoFactorService,
+	accountDeletion *AccountDeletionService,
+	authEvents *AuthEventService,
+	loginAlerts *LoginAlertService,
 ) *AuthService {
 	return &AuthService{
-		userRepo:        userRepo,
-		tokenRepo:       tokenRepo,
-		adminRepo:       adminRepo,
-		jwtManager:      jwtManager,
-		logger:          logger,
-		accessTokenTTL:  accessTokenTTL,
-		refreshTokenTTL: refreshTokenTTL,
-		messageBroker:   messageBroker,
+		userRepo:         userRepo,
+		tokenRepo:        tokenRepo,
+		adminRepo:        adminRepo,
+		jwtManager:       jwtManager,
+		logger:           logger,
+		accessTokenTTL:   accessTokenTTL,
+		refreshTokenTTL:  refreshTokenTTL,
+		messageBroker:    messageBroker,
+		loginProtection:  loginProtection,
+		sessionService:   sessionService,
+		phoneService:     phoneService,
+		twoFactorService: twoFactorService,
+		accountDeletion:  accountDeletion,
+		authEvents:       authEvents,
+		loginAlerts:      loginAlerts,
 	}
 }
 
-func (s *AuthService) Login(ctx context.Context, email, password string) (*TokenPair, error) {
-	user, err := s.userRepo.GetUser(ctx, "email", email)
+func (s *AuthService) Login(ctx context.Context, email, password, clientIP, userAgent string) (tokens *TokenPair, err error) {
+	var user *models.User
+	defer func() {
+		s.authEvents.RecordUserEvent(ctx, models.AuthEventLogin, user, email, clientIP, userAgent, err)
+	}()
+
+	if err := s.loginProtection.CheckLocked(ctx, LoginScopeUser, email, clientIP); err != nil {
+		return nil, err
+	}
+
+	user, err = s.userRepo.GetUser(ctx, "email", email)
 	if err != nil {
 		s.logger.Error("Failed to retrieve user", "email", email, "error", err)
 		return nil, fmt.Errorf("error retrieving user: %w", err)
@@ -66,7 +97,7 @@ func (s *AuthService) Login(ctx context.Context, email, password string) (*Token
 
 	if user == nil {
 		s.logger.Debug("User not found", "email", email)
-		return nil, autherrors.ErrInvalidCredentials
+		return nil, s.handleFailedLogin(ctx, LoginScopeUser, email, clientIP, "")
 	}
 
 	if !user.IsActive {
@@ -76,7 +107,14 @@ func (s *AuthService) Login(ctx context.Context, email, password string) (*Token
 
 	if !encryption.VerifyPassword(user.PasswordHash, password) {
 		s.logger.Debug("Invalid password", "email", email)
-		return nil, autherrors.ErrInvalidCredentials
+		return nil, s.handleFailedLogin(ctx, LoginScopeUser, email, clientIP, user.Email)
+	}
+
+	s.loginProtection.RecordSuccess(ctx, LoginScopeUser, email)
+
+	if err := CheckAccountStanding(user); err != nil {
+		s.logger.Debug("Login blocked by moderation", "email", email, "error", err)
+		return nil, err
 	}
 
 	if !user.Verified {
@@ -84,7 +122,96 @@ func (s *AuthService) Login(ctx context.Context, email, password string) (*Token
 		return nil, autherrors.ErrAccountNotVerified
 	}
 
-	tokens, err := s.generateTokens(user.ID)
+	tokens, err = s.completeLogin(ctx, user, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("User logged in successfully", "email", email, "userId", user.ID)
+	return tokens, nil
+}
+
+// LoginWithPhoneOTP authenticates a user with an OTP sent to their verified phone number.
+// Wrong codes count towards the same progressive lockout as wrong passwords.
+func (s *AuthService) LoginWithPhoneOTP(ctx context.Context, phone, otp, clientIP, userAgent string) (tokens *TokenPair, err error) {
+	// Attempts are counted per number, however it was formatted
+	if normalized, normErr := validation.NormalizePhone(phone); normErr == nil {
+		phone = normalized
+	}
+
+	var user *models.User
+	defer func() {
+		s.authEvents.RecordUserEvent(ctx, models.AuthEventLoginOTP, user, phone, clientIP, userAgent, err)
+	}()
+
+	if err := s.loginProtection.CheckLocked(ctx, LoginScopePhoneOTP, phone, clientIP); err != nil {
+		return nil, err
+	}
+
+	user, err = s.phoneService.VerifyLoginOTP(ctx, phone, otp)
+	if err != nil {
+		if err == autherrors.ErrInvalidOTP {
+			s.logger.Debug("Invalid login OTP", "phone", phone)
+			if lockErr := s.handleFailedLogin(ctx, LoginScopePhoneOTP, phone, clientIP, ""); lockErr == autherrors.ErrAccountLocked {
+				return nil, lockErr
+			}
+		}
+		return nil, err
+	}
+
+	if !user.IsActive {
+		s.logger.Debug("Account is disabled", "phone", phone)
+		return nil, autherrors.ErrAccountDisabled
+	}
+
+	s.loginProtection.RecordSuccess(ctx, LoginScopePhoneOTP, phone)
+
+	if err := CheckAccountStanding(user); err != nil {
+		s.logger.Debug("Login blocked by moderation", "phone", phone, "error", err)
+		return nil, err
+	}
+
+	tokens, err = s.completeLogin(ctx, user, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("User logged in with phone OTP", "userId", user.ID)
+	return tokens, nil
+}
+
+// completeLogin starts a new session for an authenticated user, issues its
+// tokens, publishes the login event and alerts the user about new devices
+func (s *AuthService) completeLogin(ctx context.Context, user *models.User, clientIP, userAgent string) (*TokenPair, error) {
+	if user.PasswordResetRequired {
+		s.logger.Debug("Login blocked until password reset", "userId", user.ID)
+		return nil, autherrors.ErrPasswordResetRequired
+	}
+
+	deletion, err := s.accountDeletion.GetPendingDeletion(ctx, user.ID.String())
+	if err != nil {
+		s.logger.Error("Failed to check account deletion", "userId", user.ID, "error", err)
+		return nil, err
+	}
+	if deletion != nil {
+		s.logger.Debug("Login blocked by scheduled deletion", "userId", user.ID)
+		return nil, autherrors.ErrAccountPendingDeletion
+	}
+
+	// Logging in again brings back a deactivated account
+	if user.IsDeactivated() {
+		if err := s.reactivate(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	session, err := s.sessionService.CreateSession(ctx, user.ID.String(), userAgent, clientIP)
+	if err != nil {
+		s.logger.Error("Failed to create session", "userId", user.ID, "error", err)
+		return nil, err
+	}
+
+	tokens, err := s.generateTokens(user.ID, session.ID)
 	if err != nil {
 		s.logger.Error("Failed to generate tokens", "userId", user.ID, "error", err)
 		return nil, fmt.Errorf("failed to generate tokens: %w", err)
@@ -112,12 +239,32 @@ func (s *AuthService) Login(ctx context.Context, email, password string) (*Token
 		}
 	}
 
-	s.logger.Info("User logged in successfully", "email", email, "userId", user.ID)
+	s.loginAlerts.CheckLogin(ctx, user, clientIP, userAgent)
+
 	return tokens, nil
 }
 
-func (s *AuthService) Logout(ctx context.Context, accessToken string) error {
-	claims, err := s.jwtManager.ValidateToken(accessToken)
+// handleFailedLogin records a failed login and returns the error to report to the caller.
+// Failures to record the attempt are logged so they never block a login response.
+func (s *AuthService) handleFailedLogin(ctx context.Context, scope, email, clientIP, ownerEmail string) error {
+	locked, err := s.loginProtection.RecordFailure(ctx, scope, email, clientIP, ownerEmail)
+	if err != nil {
+		s.logger.Error("Failed to record failed login", "email", email, "error", err)
+		return autherrors.ErrInvalidCredentials
+	}
+	if locked {
+		return autherrors.ErrAccountLocked
+	}
+	return autherrors.ErrInvalidCredentials
+}
+
+func (s *AuthService) Logout(ctx context.Context, accessToken, clientIP, userAgent string) (err error) {
+	var claims *jwt.Claims
+	defer func() {
+		s.authEvents.RecordTokenEvent(ctx, models.AuthEventLogout, claims, clientIP, userAgent, err)
+	}()
+
+	claims, err = s.jwtManager.ValidateToken(accessToken)
 	if err != nil {
 		s.logger.Debug("Logout failed - invalid token")
 		return autherrors.ErrInvalidToken
@@ -135,6 +282,17 @@ func (s *AuthService) Logout(ctx context.Context, accessToken string) error {
 		return autherrors.ErrInvalidToken
 	}
 
+	revoked, err := s.tokenRepo.IsUserTokenRevoked(ctx, claims.UserID, claims.IssuedAt.Time)
+	if err != nil {
+		s.logger.Error("Failed to check token revocation status", "error", err)
+		return fmt.Errorf("failed to check token revocation status: %w", err)
+	}
+
+	if revoked {
+		s.logger.Debug("Logout failed - token revoked")
+		return autherrors.ErrInvalidToken
+	}
+
 	expiryTime := time.Until(time.Unix(claims.ExpiresAt.Time.Unix(), 0))
 	err = s.tokenRepo.BlacklistToken(ctx, tokenID, expiryTime)
 	if err != nil {
@@ -143,59 +301,164 @@ func (s *AuthService) Logout(ctx context.Context, accessToken string) error {
 	}
 
 	userID := claims.UserID
-	err = s.tokenRepo.DeleteRefreshToken(ctx, fmt.Sprintf("%d", userID))
-	if err != nil {
-		s.logger.Error("Failed to delete refresh token", "error", err, "userId", userID)
+	if claims.SessionID != "" {
+		err = s.sessionService.RevokeSession(ctx, userID, claims.SessionID)
+		if err != nil && err != autherrors.ErrSessionNotFound {
+			s.logger.Error("Failed to revoke session", "error", err, "userId", userID, "sessionId", claims.SessionID)
+		}
 	}
 
 	s.logger.Info("User logged out successfully", "userId", userID)
 	return nil
 }
 
-func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error) {
-	claims, err := s.jwtManager.ValidateToken(refreshToken)
+// IsAccessTokenRevoked reports whether a still unexpired access token was
+// blacklisted by logout or issued before a "revoke all tokens" marker.
+func (s *AuthService) IsAccessTokenRevoked(ctx context.Context, accessToken string) (bool, error) {
+	claims, err := s.jwtManager.ValidateToken(accessToken)
+	if err != nil {
+		return false, autherrors.ErrInvalidToken
+	}
+
+	blacklisted, err := s.tokenRepo.IsTokenBlacklisted(ctx, claims.ID)
+	if err != nil {
+		s.logger.Error("Failed to check token blacklist status", "error", err)
+		return false, fmt.Errorf("failed to check token blacklist status: %w", err)
+	}
+	if blacklisted {
+		return true, nil
+	}
+
+	revoked, err := s.tokenRepo.IsUserTokenRevoked(ctx, claims.UserID, claims.IssuedAt.Time)
+	if err != nil {
+		s.logger.Error("Failed to check token revocation status", "error", err)
+		return false, fmt.Errorf("failed to check token revocation status: %w", err)
+	}
+	return revoked, nil
+}
+
+// RefreshToken issues a new token pair for the session the refresh token belongs to.
+// The new refresh token replaces the old one, so each token can only be used once.
+// Presenting an already rotated token again is treated as token theft: the whole
+// token family (the session) is revoked and a security event is published.
+func (s *AuthService) RefreshToken(ctx context.Context, refreshToken, clientIP, userAgent string) (tokens *TokenPair, err error) {
+	var claims *jwt.Claims
+	defer func() {
+		s.authEvents.RecordTokenEvent(ctx, models.AuthEventTokenRefresh, claims, clientIP, userAgent, err)
+	}()
+
+	claims, err = s.jwtManager.ValidateToken(refreshToken)
 	if err != nil {
 		s.logger.Debug("Refresh token validation failed", "error", err)
 		return nil, autherrors.ErrInvalidRefreshToken
 	}
 
 	userID := claims.UserID
-	if userID == "" {
-		s.logger.Debug("Refresh token missing user ID claim")
+	sessionID := claims.SessionID
+	if userID == "" || sessionID == "" || claims.ID == "" {
+		s.logger.Debug("Refresh token missing user ID, session ID or token ID claim")
+		return nil, autherrors.ErrInvalidRefreshToken
+	}
+
+	revoked, err := s.tokenRepo.IsUserTokenRevoked(ctx, userID, claims.IssuedAt.Time)
+	if err != nil {
+		s.logger.Error("Error checking refresh token revocation", "error", err)
+		return nil, fmt.Errorf("error checking refresh token revocation: %w", err)
+	}
+	if revoked {
+		s.logger.Debug("Refresh token was revoked", "userID", userID)
 		return nil, autherrors.ErrInvalidRefreshToken
 	}
 
-	valid, err := s.tokenRepo.ValidateRefreshToken(ctx, userID, refreshToken)
+	used, err := s.tokenRepo.IsRefreshTokenUsed(ctx, claims.ID)
+	if err != nil {
+		s.logger.Error("Error checking refresh token reuse", "error", err)
+		return nil, fmt.Errorf("error checking refresh token reuse: %w", err)
+	}
+	if used {
+		return nil, s.handleRefreshTokenReuse(ctx, userID, sessionID, clientIP)
+	}
+
+	valid, err := s.tokenRepo.ValidateRefreshToken(ctx, sessionID, refreshToken)
 	if err != nil {
 		s.logger.Error("Error validating refresh token", "error", err)
 		return nil, fmt.Errorf("error validating refresh token: %w", err)
 	}
 	if !valid {
-		s.logger.Debug("Refresh token does not match stored token")
+		s.logger.Debug("Refresh token does not match stored token", "sessionID", sessionID)
 		return nil, autherrors.ErrInvalidRefreshToken
 	}
 
+	session, err := s.sessionService.GetActiveSession(ctx, userID, sessionID)
+	if err != nil {
+		if err == autherrors.ErrSessionNotFound {
+			s.logger.Debug("Session for refresh token no longer exists", "sessionID", sessionID)
+			return nil, autherrors.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		s.logger.Error("Invalid UUID format in token", "error", err)
 		return nil, autherrors.ErrInvalidRefreshToken
 	}
 
-	newTokens, err := s.generateTokens(userUUID)
+	newTokens, err := s.generateTokens(userUUID, sessionID)
 	if err != nil {
 		s.logger.Error("Failed to generate new tokens", "error", err)
 		return nil, err
 	}
 
-	if err := s.tokenRepo.DeleteRefreshToken(ctx, userID); err != nil {
-		s.logger.Error("Failed to delete old refresh token", "error", err)
+	// The old token was replaced when the new one was stored; remember it was rotated
+	// so a later attempt to use it can be told apart from an ordinary invalid token.
+	// Losing this race means another request rotated the same token concurrently.
+	first, err := s.tokenRepo.MarkRefreshTokenUsed(ctx, claims.ID, sessionID, time.Until(claims.ExpiresAt.Time))
+	if err != nil {
+		s.logger.Error("Failed to mark refresh token as used", "sessionID", sessionID, "error", err)
+	} else if !first {
+		return nil, s.handleRefreshTokenReuse(ctx, userID, sessionID, clientIP)
+	}
+
+	if err := s.sessionService.TouchSession(ctx, session, clientIP); err != nil {
+		s.logger.Error("Failed to update session", "sessionID", sessionID, "error", err)
 	}
 
-	s.logger.Info("Tokens refreshed successfully", "userID", userID)
+	s.logger.Info("Tokens refreshed successfully", "userID", userID, "sessionID", sessionID)
 	return newTokens, nil
 }
 
-func (s *AuthService) generateTokens(userID uuid.UUID) (*TokenPair, error) {
+// handleRefreshTokenReuse revokes the token family a reused refresh token belongs to
+// and publishes a security event so the reuse can be investigated.
+func (s *AuthService) handleRefreshTokenReuse(ctx context.Context, userID, sessionID, clientIP string) error {
+	s.logger.Warn("Refresh token reuse detected, revoking token family",
+		"userID", userID,
+		"sessionID", sessionID,
+		"clientIP", clientIP)
+
+	if err := s.sessionService.RevokeSession(ctx, userID, sessionID); err != nil && err != autherrors.ErrSessionNotFound {
+		s.logger.Error("Failed to revoke token family", "userID", userID, "sessionID", sessionID, "error", err)
+	}
+
+	if s.messageBroker != nil {
+		reuseEvent := map[string]interface{}{
+			"user_id":    userID,
+			"session_id": sessionID,
+			"ip_address": clientIP,
+			"event_type": constants.EventTypeRefreshTokenReused,
+			"timestamp":  indianstandardtime.Now(),
+		}
+		if err := s.messageBroker.Publish(constants.TopicRefreshTokenReused, reuseEvent); err != nil {
+			s.logger.Error("Failed to publish refresh token reuse event", "userID", userID, "error", err)
+		} else {
+			s.logger.Info("Refresh token reuse event published", "userID", userID, "sessionID", sessionID)
+		}
+	}
+
+	return autherrors.ErrRefreshTokenReused
+}
+
+func (s *AuthService) generateTokens(userID uuid.UUID, sessionID string) (*TokenPair, error) {
 	userIDStr := userID.String()
 	user, err := s.userRepo.GetUser(context.Background(), "id", userIDStr)
 	if err != nil {
@@ -216,13 +479,14 @@ func (s *AuthService) generateTokens(userID uuid.UUID) (*TokenPair, error) {
 		role, //Assigning role "user" or "premium user"
 		true, // Indicates it’s a refreshable token
 		nil,
+		sessionID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
 	// Create refresh token (long-lived)
-	refreshToken, err := s.jwtManager.GenerateRefreshToken(userIDStr)
+	refreshToken, err := s.jwtManager.GenerateRefreshToken(userIDStr, sessionID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
@@ -230,7 +494,7 @@ func (s *AuthService) generateTokens(userID uuid.UUID) (*TokenPair, error) {
 	// Store refresh token in DB for future validation
 	err = s.tokenRepo.StoreRefreshToken(
 		context.Background(),
-		userIDStr,
+		sessionID,
 		refreshToken,
 		s.refreshTokenTTL,
 	)
@@ -245,7 +509,103 @@ func (s *AuthService) generateTokens(userID uuid.UUID) (*TokenPair, error) {
 	}, nil
 }
 
-func (s *AuthService) Delete(ctx context.Context, userID string, password string) error {
+// Delete schedules the account for deletion. The account is hidden and signed
+// out at once but only purged after the grace period, until then the user can
+// restore it with RestoreAccount.
+func (s *AuthService) Delete(ctx context.Context, userID string, password string) (*models.AccountDeletion, error) {
+	user, err := s.userRepo.GetUser(ctx, "id", userID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "userID", userID, "error", err)
+		return nil, fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil {
+		s.logger.Debug("User not found", "userID", userID)
+		return nil, autherrors.ErrUserNotFound
+	}
+
+	if !encryption.VerifyPassword(user.PasswordHash, password) {
+		s.logger.Debug("Invalid password for account deletion", "userID", userID)
+		return nil, autherrors.ErrInvalidCredentials
+	}
+
+	deletion, err := s.accountDeletion.ScheduleDeletion(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("Failed to schedule account deletion", "userID", userID, "error", err)
+		return nil, err
+	}
+
+	// Hide the account from other users during the grace period
+	if !user.IsDeactivated() {
+		now := indianstandardtime.Now()
+		user.DeactivatedAt = &now
+		user.UpdatedAt = now
+		if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+			s.logger.Error("Failed to deactivate user", "userID", userID, "error", err)
+			return nil, fmt.Errorf("failed to deactivate account: %w", err)
+		}
+		s.publishAccountEvent(userID, constants.TopicUserDeactivated, constants.EventTypeUserDeactivated)
+	}
+
+	if _, err := s.sessionService.RevokeAllSessions(ctx, userID); err != nil {
+		s.logger.Error("Failed to revoke sessions", "userID", userID, "error", err)
+	}
+	if err := s.tokenRepo.RevokeUserTokens(ctx, userID, indianstandardtime.Now(), s.refreshTokenTTL); err != nil {
+		s.logger.Error("Failed to revoke user tokens", "userID", userID, "error", err)
+	}
+
+	s.logger.Info("User account scheduled for deletion", "userID", userID, "purgeAfter", deletion.PurgeAfter)
+	return deletion, nil
+}
+
+// RestoreAccount cancels a scheduled deletion during the grace period and logs the user in
+func (s *AuthService) RestoreAccount(ctx context.Context, email, password, clientIP, userAgent string) (tokens *TokenPair, err error) {
+	var user *models.User
+	defer func() {
+		s.authEvents.RecordUserEvent(ctx, models.AuthEventAccountRestore, user, email, clientIP, userAgent, err)
+	}()
+
+	if err := s.loginProtection.CheckLocked(ctx, LoginScopeUser, email, clientIP); err != nil {
+		return nil, err
+	}
+
+	user, err = s.userRepo.GetUser(ctx, "email", email)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "email", email, "error", err)
+		return nil, fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil {
+		s.logger.Debug("User not found", "email", email)
+		return nil, s.handleFailedLogin(ctx, LoginScopeUser, email, clientIP, "")
+	}
+
+	if !encryption.VerifyPassword(user.PasswordHash, password) {
+		s.logger.Debug("Invalid password for account restore", "email", email)
+		return nil, s.handleFailedLogin(ctx, LoginScopeUser, email, clientIP, user.Email)
+	}
+
+	s.loginProtection.RecordSuccess(ctx, LoginScopeUser, email)
+
+	if err := CheckAccountStanding(user); err != nil {
+		s.logger.Debug("Restore blocked by moderation", "email", email, "error", err)
+		return nil, err
+	}
+
+	if err := s.accountDeletion.CancelDeletion(ctx, user.ID.String()); err != nil {
+		return nil, err
+	}
+
+	tokens, err = s.completeLogin(ctx, user, clientIP, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("User account restored", "userId", user.ID)
+	return tokens, nil
+}
+
+// DeactivateAccount hides the user's account until they log in again or reactivate it.
+// Unlike Delete, all data is kept and the user stays signed in.
+func (s *AuthService) DeactivateAccount(ctx context.Context, userID string, password string) error {
 	user, err := s.userRepo.GetUser(ctx, "id", userID)
 	if err != nil {
 		s.logger.Error("Failed to retrieve user", "userID", userID, "error", err)
@@ -257,38 +617,77 @@ func (s *AuthService) Delete(ctx context.Context, userID string, password string
 	}
 
 	if !encryption.VerifyPassword(user.PasswordHash, password) {
-		s.logger.Debug("Invalid password for account deletion", "userID", userID)
+		s.logger.Debug("Invalid password for account deactivation", "userID", userID)
 		return autherrors.ErrInvalidCredentials
 	}
 
-	err = s.userRepo.SoftDeleteUser(ctx, userID)
+	if user.IsDeactivated() {
+		return autherrors.ErrAlreadyDeactivated
+	}
+
+	now := indianstandardtime.Now()
+	user.DeactivatedAt = &now
+	user.UpdatedAt = now
+	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+		s.logger.Error("Failed to deactivate user", "userID", userID, "error", err)
+		return fmt.Errorf("failed to deactivate account: %w", err)
+	}
+
+	s.publishAccountEvent(userID, constants.TopicUserDeactivated, constants.EventTypeUserDeactivated)
+
+	s.logger.Info("User account deactivated", "userID", userID)
+	return nil
+}
+
+// ReactivateAccount makes a deactivated account visible again
+func (s *AuthService) ReactivateAccount(ctx context.Context, userID string) error {
+	user, err := s.userRepo.GetUser(ctx, "id", userID)
 	if err != nil {
-		s.logger.Error("Failed to soft delete user", "userID", userID, "error", err)
-		return fmt.Errorf("failed to delete user account: %w", err)
+		s.logger.Error("Failed to retrieve user", "userID", userID, "error", err)
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil {
+		s.logger.Debug("User not found", "userID", userID)
+		return autherrors.ErrUserNotFound
 	}
 
-	if err := s.tokenRepo.DeleteRefreshToken(ctx, userID); err != nil {
-		s.logger.Error("Failed to delete refresh token", "userID", userID, "error", err)
+	if !user.IsDeactivated() {
+		return autherrors.ErrNotDeactivated
 	}
 
-	// Publish an account deletion event to the message broker
-	if s.messageBroker != nil {
-		deleteEvent := map[string]interface{}{
-			"user_id":    userID,
-			"event_type": constants.EventTypeUserDeleted,
-			"timestamp":  indianstandardtime.Now(),
-		}
-		if err := s.messageBroker.Publish(constants.TopicUserDeleted, deleteEvent); err != nil {
-			s.logger.Error("Failed to publish account deletion event", "userID", userID, "error", err)
-		} else {
-			s.logger.Info("Account deletion event published", "userID", userID)
-		}
+	return s.reactivate(ctx, user)
+}
+
+// reactivate clears the deactivation and tells the other services to show the user again
+func (s *AuthService) reactivate(ctx context.Context, user *models.User) error {
+	user.DeactivatedAt = nil
+	user.UpdatedAt = indianstandardtime.Now()
+	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+		s.logger.Error("Failed to reactivate user", "userID", user.ID, "error", err)
+		return fmt.Errorf("failed to reactivate account: %w", err)
 	}
 
-	s.logger.Info("User account soft deleted successfully", "userID", userID)
+	s.publishAccountEvent(user.ID.String(), constants.TopicUserReactivated, constants.EventTypeUserReactivated)
+
+	s.logger.Info("User account reactivated", "userID", user.ID)
 	return nil
 }
 
+func (s *AuthService) publishAccountEvent(userID, topic, eventType string) {
+	if s.messageBroker == nil {
+		return
+	}
+
+	event := map[string]interface{}{
+		"user_id":    userID,
+		"event_type": eventType,
+		"timestamp":  indianstandardtime.Now(),
+	}
+	if err := s.messageBroker.Publish(topic, event); err != nil {
+		s.logger.Error("Failed to publish account event", "userID", userID, "eventType", eventType, "error", err)
+	}
+}
+
 func (s *AuthService) UpdateUserPremiumStatus(ctx context.Context, userID string, premiumUntil time.Time) error {
 	s.logger.Info("Updating user premium status", "userID", userID, "premiumUntil", premiumUntil)
 	err := s.userRepo.UpdatePremiumUntil(ctx, userID, premiumUntil)
@@ -300,58 +699,163 @@ func (s *AuthService) UpdateUserPremiumStatus(ctx context.Context, userID string
 	return nil
 }
 
-func (s *AuthService) AdminLogin(ctx context.Context, email, password string) (*TokenPair, error) {
-	admin, err := s.adminRepo.GetAdminByEmail(ctx, email)
+// AdminLogin checks the admin's password. When two-factor authentication is
+// required, no tokens are issued; a challenge is returned instead and the
+// tokens come from AdminVerify2FA.
+func (s *AuthService) AdminLogin(ctx context.Context, email, password, clientIP, userAgent string) (tokens *TokenPair, challenge *TwoFactorChallenge, err error) {
+	var admin *models.Admin
+	defer func() {
+		if challenge != nil {
+			s.authEvents.RecordAdminChallenge(ctx, admin, clientIP, userAgent)
+			return
+		}
+		s.authEvents.RecordAdminEvent(ctx, models.AuthEventAdminLogin, admin, email, clientIP, userAgent, err)
+	}()
+
+	if err := s.loginProtection.CheckLocked(ctx, LoginScopeAdmin, email, clientIP); err != nil {
+		return nil, nil, err
+	}
+
+	admin, err = s.adminRepo.GetAdminByEmail(ctx, email)
 	if err != nil {
 		s.logger.Error("Failed to retrieve admin", "email", email, "error", err)
-		return nil, fmt.Errorf("error retrieving admin: %w", err)
+		return nil, nil, fmt.Errorf("error retrieving admin: %w", err)
 	}
 	if admin == nil {
 		s.logger.Debug("Admin not found", "email", email)
-		return nil, autherrors.ErrAdminNotFound
+		if err := s.handleFailedLogin(ctx, LoginScopeAdmin, email, clientIP, ""); err == autherrors.ErrAccountLocked {
+			return nil, nil, err
+		}
+		return nil, nil, autherrors.ErrAdminNotFound
 	}
 
 	if !admin.IsActive {
 		s.logger.Debug("Admin account is disabled", "email", email)
-		return nil, autherrors.ErrAdminAccountDisabled
+		return nil, nil, autherrors.ErrAdminAccountDisabled
 	}
 
 	if !encryption.VerifyPassword(admin.PasswordHash, password) {
 		s.logger.Debug("Invalid admin password", "email", email)
-		return nil, autherrors.ErrInvalidCredentials
+		return nil, nil, s.handleFailedLogin(ctx, LoginScopeAdmin, email, clientIP, admin.Email)
+	}
+
+	s.loginProtection.RecordSuccess(ctx, LoginScopeAdmin, email)
+
+	if s.twoFactorService.IsRequired(admin) {
+		challenge, err := s.twoFactorService.CreateChallenge(ctx, admin)
+		if err != nil {
+			s.logger.Error("Failed to create two-factor challenge", "adminId", admin.ID, "error", err)
+			return nil, nil, err
+		}
+
+		s.logger.Info("Admin password accepted, two-factor code required", "email", email, "adminId", admin.ID)
+		return nil, challenge, nil
+	}
+
+	tokens, err = s.completeAdminLogin(ctx, admin, clientIP, userAgent)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tokens, nil, nil
+}
+
+// AdminEnroll2FA sets up a new authenticator for the admin of the challenge
+func (s *AuthService) AdminEnroll2FA(ctx context.Context, challengeToken string) (*TOTPEnrollment, error) {
+	return s.twoFactorService.Enroll(ctx, challengeToken)
+}
+
+// AdminVerify2FA completes an admin login with a TOTP or recovery code and issues the ADMIN tokens
+func (s *AuthService) AdminVerify2FA(ctx context.Context, challengeToken, code, clientIP, userAgent string) (tokens *TokenPair, err error) {
+	var admin *models.Admin
+	defer func() {
+		identifier := ""
+		if admin != nil {
+			identifier = admin.Email
+		}
+		s.authEvents.RecordAdminEvent(ctx, models.AuthEventAdmin2FA, admin, identifier, clientIP, userAgent, err)
+	}()
+
+	admin, err = s.twoFactorService.GetChallengeAdmin(ctx, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.loginProtection.CheckLocked(ctx, LoginScopeAdminTwoFactor, admin.Email, clientIP); err != nil {
+		return nil, err
+	}
+
+	valid, err := s.twoFactorService.VerifyCode(ctx, admin, code)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		s.logger.Debug("Invalid two-factor code", "adminId", admin.ID)
+		if err := s.handleFailedLogin(ctx, LoginScopeAdminTwoFactor, admin.Email, clientIP, admin.Email); err == autherrors.ErrAccountLocked {
+			return nil, err
+		}
+		return nil, autherrors.ErrInvalidTwoFactorCode
+	}
+
+	s.loginProtection.RecordSuccess(ctx, LoginScopeAdminTwoFactor, admin.Email)
+
+	if err := s.twoFactorService.CompleteChallenge(ctx, challengeToken, admin); err != nil {
+		s.logger.Error("Failed to complete two-factor challenge", "adminId", admin.ID, "error", err)
+		return nil, err
 	}
 
-	tokens, err := s.generateAdminTokens(admin.ID)
+	return s.completeAdminLogin(ctx, admin, clientIP, userAgent)
+}
+
+// completeAdminLogin creates the admin session and issues its tokens
+func (s *AuthService) completeAdminLogin(ctx context.Context, admin *models.Admin, clientIP, userAgent string) (*TokenPair, error) {
+	session, err := s.sessionService.CreateSession(ctx, admin.ID.String(), userAgent, clientIP)
+	if err != nil {
+		s.logger.Error("Failed to create admin session", "adminId", admin.ID, "error", err)
+		return nil, err
+	}
+
+	tokens, err := s.generateAdminTokens(ctx, admin, session.ID)
 	if err != nil {
 		s.logger.Error("Failed to generate admin tokens", "adminId", admin.ID, "error", err)
 		return nil, fmt.Errorf("failed to generate admin tokens: %w", err)
 	}
 
-	s.logger.Info("Admin logged in successfully", "email", email, "adminId", admin.ID)
+	s.logger.Info("Admin logged in successfully", "email", admin.Email, "adminId", admin.ID)
 	return tokens, nil
 }
 
-func (s *AuthService) generateAdminTokens(adminID uuid.UUID) (*TokenPair, error) {
-	adminIDStr := adminID.String()
+// generateAdminTokens issues ADMIN tokens carrying the permissions of the admin's role
+func (s *AuthService) generateAdminTokens(ctx context.Context, admin *models.Admin, sessionID string) (*TokenPair, error) {
+	adminIDStr := admin.ID.String()
 
-	accessToken, err := s.jwtManager.GenerateAccessToken(
+	rolePermissions, err := s.adminRepo.GetRolePermissions(ctx, admin.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load admin permissions: %w", err)
+	}
+
+	permissions := make([]jwt.Permission, 0, len(rolePermissions))
+	for _, permission := range rolePermissions {
+		permissions = append(permissions, jwt.Permission(permission))
+	}
+
+	accessToken, err := s.jwtManager.GenerateAdminAccessToken(
 		adminIDStr,
-		jwt.RoleAdmin,
-		true,
-		nil,
+		jwt.AdminRole(admin.Role),
+		permissions,
+		sessionID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate admin access token: %w", err)
 	}
 
-	refreshToken, err := s.jwtManager.GenerateRefreshToken(adminIDStr)
+	refreshToken, err := s.jwtManager.GenerateRefreshToken(adminIDStr, sessionID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate admin refresh token: %w", err)
 	}
 
 	err = s.tokenRepo.StoreRefreshToken(
 		context.Background(),
-		adminIDStr,
+		sessionID,
 		refreshToken,
 		s.refreshTokenTTL,
 	)
@@ -366,6 +870,11 @@ func (s *AuthService) generateAdminTokens(adminID uuid.UUID) (*TokenPair, error)
 	}, nil
 }
 
+// GetJWKS returns the public keys other services use to verify tokens issued by this service
+func (s *AuthService) GetJWKS() jwt.JWKS {
+	return s.jwtManager.JWKS()
+}
+
 // GetUsersList retrieves users list for admin (reuse existing patterns)
 func (s *AuthService) GetUsersList(ctx context.Context, params repositories.GetUsersParams) ([]*models.User, int64, error) {
 	s.logger.Info("Getting users list", "limit", params.Limit, "offset", params.Offset)
@@ -0,0 +1,301 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/messaging/rabbitmq"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/email"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/sms"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/encryption"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/otp"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+)
+
+// ContactChangeService lets users replace the email address or phone number
+// they registered with. The change is only applied once the OTP sent to the
+// new address or number is entered, and the previous one is notified.
+type ContactChangeService struct {
+	userRepo          repositories.UserRepository
+	contactChangeRepo repositories.ContactChangeRepository
+	otpLimitRepo      repositories.OTPLimitRepository
+	otpGenerator      *otp.Generator
+	otpExpiryTime     time.Duration
+	sendLimits        OTPSendLimits
+	maxVerifyAttempts int
+	emailClient       *email.Client
+	smsSender         sms.SMSSender
+	messageBroker     *rabbitmq.Client
+	authEvents        *AuthEventService
+	logger            logging.Logger
+}
+
+func NewContactChangeService(
+	userRepo repositories.UserRepository,
+	contactChangeRepo repositories.ContactChangeRepository,
+	otpLimitRepo repositories.OTPLimitRepository,
+	otpGenerator *otp.Generator,
+	otpExpiryTime time.Duration,
+	sendLimits OTPSendLimits,
+	maxVerifyAttempts int,
+	emailClient *email.Client,
+	smsSender sms.SMSSender,
+	messageBroker *rabbitmq.Client,
+	authEvents *AuthEventService,
+	logger logging.Logger,
+) *ContactChangeService {
+	return &ContactChangeService{
+		userRepo:          userRepo,
+		contactChangeRepo: contactChangeRepo,
+		otpLimitRepo:      otpLimitRepo,
+		otpGenerator:      otpGenerator,
+		otpExpiryTime:     otpExpiryTime,
+		sendLimits:        sendLimits,
+		maxVerifyAttempts: maxVerifyAttempts,
+		emailClient:       emailClient,
+		smsSender:         smsSender,
+		messageBroker:     messageBroker,
+		authEvents:        authEvents,
+		logger:            logger,
+	}
+}
+
+// RequestEmailChange checks the user's password and sends an OTP to the new email address
+func (s *ContactChangeService) RequestEmailChange(ctx context.Context, userID, newEmail, password string) error {
+	if !validation.ValidateEmail(newEmail) {
+		return autherrors.ErrInvalidInput
+	}
+	return s.requestChange(ctx, userID, models.ContactFieldEmail, newEmail, password)
+}
+
+// RequestPhoneChange checks the user's password and sends an OTP by SMS to the new phone number
+func (s *ContactChangeService) RequestPhoneChange(ctx context.Context, userID, newPhone, password string) error {
+	phone, err := validation.NormalizePhone(newPhone)
+	if err != nil {
+		return autherrors.ErrInvalidInput
+	}
+	return s.requestChange(ctx, userID, models.ContactFieldPhone, phone, password)
+}
+
+// ConfirmEmailChange checks the OTP sent to the new email address and applies the change
+func (s *ContactChangeService) ConfirmEmailChange(ctx context.Context, userID, inputOTP, clientIP, userAgent string) error {
+	return s.confirmChange(ctx, userID, models.ContactFieldEmail, inputOTP, clientIP, userAgent)
+}
+
+// ConfirmPhoneChange checks the OTP sent to the new phone number and applies the change
+func (s *ContactChangeService) ConfirmPhoneChange(ctx context.Context, userID, inputOTP, clientIP, userAgent string) error {
+	return s.confirmChange(ctx, userID, models.ContactFieldPhone, inputOTP, clientIP, userAgent)
+}
+
+func (s *ContactChangeService) requestChange(ctx context.Context, userID, field, newValue, password string) error {
+	user, err := s.userRepo.GetUser(ctx, "id", userID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "userID", userID, "error", err)
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil {
+		return autherrors.ErrUserNotFound
+	}
+
+	if !encryption.VerifyPassword(user.PasswordHash, password) {
+		s.logger.Debug("Invalid password for contact change", "userID", userID, "field", field)
+		return autherrors.ErrInvalidCredentials
+	}
+
+	if currentContact(user, field) == newValue {
+		return autherrors.ErrContactUnchanged
+	}
+
+	taken, err := s.userRepo.IsRegistered(ctx, field, newValue)
+	if err != nil {
+		return fmt.Errorf("failed to check %s: %w", field, err)
+	}
+	if taken {
+		return contactTakenError(field)
+	}
+
+	if field == models.ContactFieldPhone {
+		if err := reserveOTPSend(ctx, s.otpLimitRepo, s.sendLimits, smsLimitIdentifier(newValue), s.logger); err != nil {
+			return err
+		}
+	}
+
+	code, err := s.otpGenerator.Generate()
+	if err != nil {
+		return fmt.Errorf("%w: %v", autherrors.ErrOTPGenerationFailed, err)
+	}
+
+	change := &models.ContactChange{
+		UserID:      userID,
+		Field:       field,
+		NewValue:    newValue,
+		OTP:         code,
+		RequestedAt: indianstandardtime.Now(),
+	}
+	if err := s.contactChangeRepo.StoreContactChange(ctx, change, s.otpExpiryTime); err != nil {
+		return fmt.Errorf("failed to store contact change: %w", err)
+	}
+
+	if err := s.otpLimitRepo.ResetVerifyAttempts(ctx, attemptsKey(userID, field)); err != nil {
+		s.logger.Error("Failed to reset OTP attempts", "userID", userID, "field", field, "error", err)
+	}
+
+	if field == models.ContactFieldEmail {
+		err = s.emailClient.SendOTPEmail(newValue, code)
+	} else {
+		err = sms.SendOTP(s.smsSender, newValue, code)
+	}
+	if err != nil {
+		s.logger.Error("Failed to send contact change OTP", "userID", userID, "field", field, "error", err)
+		return fmt.Errorf("failed to send contact change OTP: %w", err)
+	}
+
+	s.logger.Info("Contact change OTP sent", "userID", userID, "field", field)
+	return nil
+}
+
+func (s *ContactChangeService) confirmChange(ctx context.Context, userID, field, inputOTP, clientIP, userAgent string) (err error) {
+	if inputOTP == "" {
+		return autherrors.ErrInvalidInput
+	}
+
+	user, err := s.userRepo.GetUser(ctx, "id", userID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "userID", userID, "error", err)
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil {
+		return autherrors.ErrUserNotFound
+	}
+
+	oldValue := currentContact(user, field)
+	eventType := models.AuthEventEmailChange
+	if field == models.ContactFieldPhone {
+		eventType = models.AuthEventPhoneChange
+	}
+	defer func() {
+		s.authEvents.RecordUserEvent(ctx, eventType, user, oldValue, clientIP, userAgent, err)
+	}()
+
+	// Every check counts, so the code cannot be guessed. Once the limit is
+	// reached the change is discarded and a new one must be requested.
+	attempts, err := s.otpLimitRepo.IncrementVerifyAttempts(ctx, attemptsKey(userID, field), s.otpExpiryTime)
+	if err != nil {
+		return fmt.Errorf("failed to count OTP attempts: %w", err)
+	}
+	if int(attempts) > s.maxVerifyAttempts {
+		s.logger.Warn("Too many contact change OTP attempts", "userID", userID, "field", field)
+		if err := s.contactChangeRepo.DeleteContactChange(ctx, userID, field); err != nil {
+			s.logger.Error("Failed to delete contact change", "userID", userID, "field", field, "error", err)
+		}
+		return autherrors.ErrTooManyOTPAttempts
+	}
+
+	change, err := s.contactChangeRepo.GetContactChange(ctx, userID, field)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve contact change: %w", err)
+	}
+	if change == nil || subtle.ConstantTimeCompare([]byte(change.OTP), []byte(inputOTP)) != 1 {
+		s.logger.Debug("Invalid contact change OTP provided", "userID", userID, "field", field)
+		return autherrors.ErrInvalidOTP
+	}
+
+	var changed bool
+	if field == models.ContactFieldEmail {
+		changed, err = s.userRepo.ChangeEmail(ctx, userID, change.NewValue)
+	} else {
+		changed, err = s.userRepo.ChangePhone(ctx, userID, change.NewValue)
+	}
+	if err != nil {
+		s.logger.Error("Failed to change contact", "userID", userID, "field", field, "error", err)
+		return fmt.Errorf("failed to change %s: %w", field, err)
+	}
+	if !changed {
+		return contactTakenError(field)
+	}
+
+	if err := s.contactChangeRepo.DeleteContactChange(ctx, userID, field); err != nil {
+		s.logger.Error("Failed to delete contact change", "userID", userID, "field", field, "error", err)
+	}
+	if err := s.otpLimitRepo.ResetVerifyAttempts(ctx, attemptsKey(userID, field)); err != nil {
+		s.logger.Error("Failed to reset OTP attempts", "userID", userID, "field", field, "error", err)
+	}
+
+	if field == models.ContactFieldEmail {
+		user.Email = change.NewValue
+	} else {
+		user.Phone = change.NewValue
+	}
+
+	s.notifyPreviousContact(user, field, oldValue)
+	s.publishContactChanged(user, field, oldValue)
+
+	s.logger.Info("Contact changed", "userID", userID, "field", field)
+	return nil
+}
+
+// notifyPreviousContact warns the address or number that was replaced, so an
+// account takeover does not go unnoticed. Phone changes are also reported to
+// the account's email address.
+func (s *ContactChangeService) notifyPreviousContact(user *models.User, field, oldValue string) {
+	now := indianstandardtime.Now()
+	if field == models.ContactFieldEmail {
+		if err := s.emailClient.SendContactChangedEmail(oldValue, "email address", now); err != nil {
+			s.logger.Error("Failed to notify previous email address", "userID", user.ID, "error", err)
+		}
+		return
+	}
+
+	if err := sms.SendPhoneChanged(s.smsSender, oldValue); err != nil {
+		s.logger.Error("Failed to notify previous phone number", "userID", user.ID, "error", err)
+	}
+	if err := s.emailClient.SendContactChangedEmail(user.Email, "phone number", now); err != nil {
+		s.logger.Error("Failed to send phone change email", "userID", user.ID, "error", err)
+	}
+}
+
+func (s *ContactChangeService) publishContactChanged(user *models.User, field, oldValue string) {
+	if s.messageBroker == nil {
+		return
+	}
+
+	event := map[string]interface{}{
+		"user_id":    user.ID.String(),
+		"email":      user.Email,
+		"phone":      user.Phone,
+		"field":      field,
+		"old_value":  oldValue,
+		"event_type": constants.EventTypeUserContactChanged,
+		"timestamp":  indianstandardtime.Now(),
+	}
+	if err := s.messageBroker.Publish(constants.TopicUserContactChanged, event); err != nil {
+		s.logger.Error("Failed to publish contact changed event", "userID", user.ID, "error", err)
+	}
+}
+
+func currentContact(user *models.User, field string) string {
+	if field == models.ContactFieldEmail {
+		return user.Email
+	}
+	return user.Phone
+}
+
+// attemptsKey identifies the OTP attempts of a pending change
+func attemptsKey(userID, field string) string {
+	return constants.ContactChangePrefix + userID + ":" + field
+}
+
+func contactTakenError(field string) error {
+	if field == models.ContactFieldEmail {
+		return autherrors.ErrEmailAlreadyExists
+	}
+	return autherrors.ErrPhoneAlreadyExists
+}
@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/email"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/useragent"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+)
+
+// Prefix lengths logins are grouped by, so a new address from the same network is not reported
+const (
+	ipv4RangeBits = 24
+	ipv6RangeBits = 48
+)
+
+// unknownIPRange groups logins whose client IP was missing or could not be parsed
+const unknownIPRange = "unknown"
+
+// loginCheckTimeout bounds the background device check and alert email of a login
+const loginCheckTimeout = 30 * time.Second
+
+// LoginAlertService emails users when their account is logged into from a
+// device or IP range it was not used from before. The email carries a
+// "this wasn't me" link that signs the account out everywhere and requires
+// a password reset before the next login.
+type LoginAlertService struct {
+	knownDeviceRepo repositories.KnownDeviceRepository
+	loginAlertRepo  repositories.LoginAlertRepository
+	userRepo        repositories.UserRepository
+	tokenRepo       repositories.TokenRepository
+	sessionService  *SessionService
+	passwordService *PasswordService
+	authEvents      *AuthEventService
+	emailClient     *email.Client
+	reportURL       string
+	reportExpiry    time.Duration
+	refreshTokenTTL time.Duration
+	logger          logging.Logger
+}
+
+func NewLoginAlertService(
+	knownDeviceRepo repositories.KnownDeviceRepository,
+	loginAlertRepo repositories.LoginAlertRepository,
+	userRepo repositories.UserRepository,
+	tokenRepo repositories.TokenRepository,
+	sessionService *SessionService,
+	passwordService *PasswordService,
+	authEvents *AuthEventService,
+	emailClient *email.Client,
+	reportURL string,
+	reportExpiry time.Duration,
+	refreshTokenTTL time.Duration,
+	logger logging.Logger,
+) *LoginAlertService {
+	return &LoginAlertService{
+		knownDeviceRepo: knownDeviceRepo,
+		loginAlertRepo:  loginAlertRepo,
+		userRepo:        userRepo,
+		tokenRepo:       tokenRepo,
+		sessionService:  sessionService,
+		passwordService: passwordService,
+		authEvents:      authEvents,
+		emailClient:     emailClient,
+		reportURL:       reportURL,
+		reportExpiry:    reportExpiry,
+		refreshTokenTTL: refreshTokenTTL,
+		logger:          logger,
+	}
+}
+
+// CheckLogin remembers the device and network of a successful login and sends
+// an alert when either was not seen before. The first login of an account is
+// never reported. The check runs in the background so a slow mail server never
+// delays the login, and failures are only logged.
+func (s *LoginAlertService) CheckLogin(ctx context.Context, user *models.User, clientIP, userAgent string) {
+	loggedIn := *user
+	checkCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loginCheckTimeout)
+
+	go func() {
+		defer cancel()
+		s.checkLogin(checkCtx, &loggedIn, clientIP, userAgent)
+	}()
+}
+
+func (s *LoginAlertService) checkLogin(ctx context.Context, user *models.User, clientIP, userAgent string) {
+	userID := user.ID.String()
+	now := indianstandardtime.Now()
+	device := useragent.Describe(userAgent)
+	ipRange := ipRangeOf(clientIP)
+
+	knownDevices, err := s.knownDeviceRepo.GetKnownDevices(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get known devices", "userId", userID, "error", err)
+		return
+	}
+
+	err = s.knownDeviceRepo.SaveKnownDevice(ctx, &models.KnownDevice{
+		UserID:      user.ID,
+		Device:      device,
+		IPRange:     ipRange,
+		FirstSeenAt: now,
+		LastSeenAt:  now,
+	})
+	if err != nil {
+		s.logger.Error("Failed to save known device", "userId", userID, "error", err)
+	}
+
+	if len(knownDevices) == 0 {
+		return
+	}
+
+	deviceSeen, ipRangeSeen := false, false
+	for _, known := range knownDevices {
+		deviceSeen = deviceSeen || known.Device == device
+		ipRangeSeen = ipRangeSeen || known.IPRange == ipRange
+	}
+	if deviceSeen && ipRangeSeen {
+		return
+	}
+
+	if err := s.sendAlert(ctx, user, now, device, clientIP); err != nil {
+		s.logger.Error("Failed to send new device login alert", "userId", userID, "error", err)
+		return
+	}
+	s.logger.Info("New device login alert sent", "userId", userID, "device", device, "ipRange", ipRange)
+}
+
+// ReportLogin handles the "this wasn't me" link: every session is signed out,
+// outstanding tokens are revoked and logins stay blocked until the password is
+// reset with the code emailed to the user.
+func (s *LoginAlertService) ReportLogin(ctx context.Context, token, clientIP, userAgent string) error {
+	if token == "" {
+		return autherrors.ErrInvalidLoginReport
+	}
+
+	userID, err := s.loginAlertRepo.GetReportToken(ctx, token)
+	if err != nil {
+		return fmt.Errorf("failed to get login report token: %w", err)
+	}
+	if userID == "" {
+		return autherrors.ErrInvalidLoginReport
+	}
+
+	user, err := s.userRepo.GetUser(ctx, "id", userID)
+	if err != nil {
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil {
+		return autherrors.ErrInvalidLoginReport
+	}
+
+	now := indianstandardtime.Now()
+	user.PasswordResetRequired = true
+	user.UpdatedAt = now
+	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+		s.logger.Error("Failed to require password reset", "userId", userID, "error", err)
+		return fmt.Errorf("failed to require password reset: %w", err)
+	}
+
+	if err := s.loginAlertRepo.DeleteReportToken(ctx, token); err != nil {
+		s.logger.Error("Failed to delete login report token", "userId", userID, "error", err)
+	}
+
+	if _, err := s.sessionService.RevokeAllSessions(ctx, userID); err != nil {
+		s.logger.Error("Failed to revoke user sessions", "userId", userID, "error", err)
+	}
+	if err := s.tokenRepo.RevokeUserTokens(ctx, userID, now, s.refreshTokenTTL); err != nil {
+		s.logger.Error("Failed to revoke user tokens", "userId", userID, "error", err)
+		return fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+
+	if err := s.passwordService.ForgotPassword(ctx, user.Email); err != nil {
+		s.logger.Error("Failed to send password reset code", "userId", userID, "error", err)
+	}
+
+	s.authEvents.RecordUserEvent(ctx, models.AuthEventLoginReported, user, user.Email, clientIP, userAgent, nil)
+
+	s.logger.Info("Login reported as unrecognised, account secured", "userId", userID)
+	return nil
+}
+
+func (s *LoginAlertService) sendAlert(ctx context.Context, user *models.User, loginAt time.Time, device, clientIP string) error {
+	token, err := randomHex(32)
+	if err != nil {
+		return fmt.Errorf("failed to generate login report token: %w", err)
+	}
+
+	if err := s.loginAlertRepo.StoreReportToken(ctx, token, user.ID.String(), s.reportExpiry); err != nil {
+		return fmt.Errorf("failed to store login report token: %w", err)
+	}
+
+	if clientIP == "" {
+		clientIP = "Unknown"
+	}
+	return s.emailClient.SendNewDeviceLoginEmail(user.Email, loginAt, device, clientIP, s.reportLink(token))
+}
+
+func (s *LoginAlertService) reportLink(token string) string {
+	separator := "?"
+	if strings.Contains(s.reportURL, "?") {
+		separator = "&"
+	}
+	return s.reportURL + separator + "token=" + url.QueryEscape(token)
+}
+
+// ipRangeOf returns the network prefix of the IP address, such as "203.0.113.0/24"
+func ipRangeOf(clientIP string) string {
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return unknownIPRange
+	}
+
+	bits, size := ipv6RangeBits, 128
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits, size = ip4, ipv4RangeBits, 32
+	}
+
+	network := &net.IPNet{IP: ip.Mask(net.CIDRMask(bits, size)), Mask: net.CIDRMask(bits, size)}
+	return network.String()
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/email"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+)
+
+// Login scopes keep user, admin, phone OTP and two-factor attempt counters apart
+const (
+	LoginScopeUser           = "user"
+	LoginScopeAdmin          = "admin"
+	LoginScopePhoneOTP       = "phone_otp" // Identified by phone number instead of email
+	LoginScopeAdminTwoFactor = "admin_2fa"
+)
+
+// LoginProtectionConfig controls when and for how long logins are locked
+type LoginProtectionConfig struct {
+	MaxAttemptsPerEmail  int
+	MaxAttemptsPerIP     int
+	AttemptWindow        time.Duration // Window in which failed attempts are counted
+	BaseLockout          time.Duration // Duration of the first lockout
+	MaxLockout           time.Duration // Upper bound for the exponential backoff
+	LockoutHistoryWindow time.Duration // How long previous lockouts count towards the backoff
+}
+
+// LoginProtectionService tracks failed logins per email and per client IP
+// and locks further attempts once the configured limits are reached.
+// Every lockout within the history window doubles the next lockout duration.
+type LoginProtectionService struct {
+	attemptRepo repositories.LoginAttemptRepository
+	emailClient *email.Client
+	config      LoginProtectionConfig
+	logger      logging.Logger
+}
+
+func NewLoginProtectionService(
+	attemptRepo repositories.LoginAttemptRepository,
+	emailClient *email.Client,
+	config LoginProtectionConfig,
+	logger logging.Logger,
+) *LoginProtectionService {
+	return &LoginProtectionService{
+		attemptRepo: attemptRepo,
+		emailClient: emailClient,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// Example: emailIdentifier("user", "User@Example.com") returns "user:email:user@example.com"
+func emailIdentifier(scope, email string) string {
+	return fmt.Sprintf("%s:email:%s", scope, strings.ToLower(strings.TrimSpace(email)))
+}
+
+// Example: ipIdentifier("admin", "10.0.0.1") returns "admin:ip:10.0.0.1"
+func ipIdentifier(scope, clientIP string) string {
+	return fmt.Sprintf("%s:ip:%s", scope, clientIP)
+}
+
+// CheckLocked returns ErrAccountLocked if either the email or the client IP is currently locked
+func (s *LoginProtectionService) CheckLocked(ctx context.Context, scope, email, clientIP string) error {
+	identifiers := []string{emailIdentifier(scope, email)}
+	if clientIP != "" {
+		identifiers = append(identifiers, ipIdentifier(scope, clientIP))
+	}
+
+	for _, identifier := range identifiers {
+		remaining, err := s.attemptRepo.GetLockoutRemaining(ctx, identifier)
+		if err != nil {
+			return fmt.Errorf("failed to check login lockout: %w", err)
+		}
+		if remaining > 0 {
+			s.logger.Debug("Login attempt while locked", "identifier", identifier, "remaining", remaining)
+			return autherrors.ErrAccountLocked
+		}
+	}
+
+	return nil
+}
+
+// RecordFailure counts a failed login for the email and client IP.
+// It returns true when this failure caused the email or IP to be locked.
+// ownerEmail is notified about an email lockout; pass an empty string when
+// the email does not belong to an account.
+func (s *LoginProtectionService) RecordFailure(ctx context.Context, scope, email, clientIP, ownerEmail string) (bool, error) {
+	locked, lockedFor, err := s.recordFailure(ctx, emailIdentifier(scope, email), s.config.MaxAttemptsPerEmail)
+	if err != nil {
+		return false, err
+	}
+
+	if locked && ownerEmail != "" {
+		if err := s.emailClient.SendAccountLockedEmail(ownerEmail, lockedFor); err != nil {
+			s.logger.Error("Failed to send account locked email", "email", ownerEmail, "error", err)
+		}
+	}
+
+	if clientIP != "" {
+		ipLocked, _, err := s.recordFailure(ctx, ipIdentifier(scope, clientIP), s.config.MaxAttemptsPerIP)
+		if err != nil {
+			return false, err
+		}
+		locked = locked || ipLocked
+	}
+
+	return locked, nil
+}
+
+// RecordSuccess clears the failed attempt counter for the email.
+// The IP counter is left to expire so a valid login cannot be used to
+// reset the counter between guesses against other accounts.
+func (s *LoginProtectionService) RecordSuccess(ctx context.Context, scope, email string) {
+	if err := s.attemptRepo.ResetFailedAttempts(ctx, emailIdentifier(scope, email)); err != nil {
+		s.logger.Error("Failed to reset failed login attempts", "email", email, "error", err)
+	}
+}
+
+func (s *LoginProtectionService) recordFailure(ctx context.Context, identifier string, maxAttempts int) (bool, time.Duration, error) {
+	attempts, err := s.attemptRepo.IncrementFailedAttempts(ctx, identifier, s.config.AttemptWindow)
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to record failed login: %w", err)
+	}
+
+	if attempts < int64(maxAttempts) {
+		return false, 0, nil
+	}
+
+	lockouts, err := s.attemptRepo.IncrementLockoutCount(ctx, identifier, s.config.LockoutHistoryWindow)
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to record lockout: %w", err)
+	}
+
+	lockedFor := s.lockoutDuration(lockouts)
+	if err := s.attemptRepo.Lock(ctx, identifier, lockedFor); err != nil {
+		return false, 0, fmt.Errorf("failed to lock login: %w", err)
+	}
+
+	// Start counting again once the lockout expires
+	if err := s.attemptRepo.ResetFailedAttempts(ctx, identifier); err != nil {
+		s.logger.Error("Failed to reset failed login attempts", "identifier", identifier, "error", err)
+	}
+
+	s.logger.Info("Login locked after repeated failures",
+		"identifier", identifier,
+		"attempts", attempts,
+		"lockouts", lockouts,
+		"lockedFor", lockedFor)
+	return true, lockedFor, nil
+}
+
+// lockoutDuration doubles the base lockout for every previous lockout, up to MaxLockout.
+// Example: with a 5 minute base, lockouts last 5, 10, 20, 40... minutes
+func (s *LoginProtectionService) lockoutDuration(lockouts int64) time.Duration {
+	duration := s.config.BaseLockout
+	for i := int64(1); i < lockouts; i++ {
+		duration *= 2
+		if duration >= s.config.MaxLockout {
+			return s.config.MaxLockout
+		}
+	}
+	if duration > s.config.MaxLockout {
+		return s.config.MaxLockout
+	}
+	return duration
+}
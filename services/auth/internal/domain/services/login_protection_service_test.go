@@ -0,0 +1,43 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockoutDuration(t *testing.T) {
+	s := &LoginProtectionService{config: LoginProtectionConfig{
+		BaseLockout: 5 * time.Minute,
+		MaxLockout:  time.Hour,
+	}}
+
+	tests := []struct {
+		lockouts int64
+		want     time.Duration
+	}{
+		{lockouts: 0, want: 5 * time.Minute},
+		{lockouts: 1, want: 5 * time.Minute},
+		{lockouts: 2, want: 10 * time.Minute},
+		{lockouts: 3, want: 20 * time.Minute},
+		{lockouts: 4, want: 40 * time.Minute},
+		{lockouts: 5, want: time.Hour},
+		{lockouts: 100, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := s.lockoutDuration(tt.lockouts); got != tt.want {
+			t.Errorf("lockoutDuration(%d) = %v, want %v", tt.lockouts, got, tt.want)
+		}
+	}
+}
+
+func TestLockoutDurationBaseAboveMax(t *testing.T) {
+	s := &LoginProtectionService{config: LoginProtectionConfig{
+		BaseLockout: 2 * time.Hour,
+		MaxLockout:  time.Hour,
+	}}
+
+	if got := s.lockoutDuration(1); got != time.Hour {
+		t.Errorf("lockoutDuration(1) = %v, want %v", got, time.Hour)
+	}
+}
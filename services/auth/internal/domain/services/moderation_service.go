@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/messaging/rabbitmq"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+)
+
+// ModerationService lets administrators with the users:manage permission
+// suspend, ban and reinstate user accounts. Every action signs the user out
+// everywhere and is announced to the other services. Administrators with the
+// premium:grant permission can also give users premium membership.
+type ModerationService struct {
+	userRepo        repositories.UserRepository
+	tokenRepo       repositories.TokenRepository
+	adminService    *AdminService
+	sessionService  *SessionService
+	messageBroker   *rabbitmq.Client
+	refreshTokenTTL time.Duration
+	logger          logging.Logger
+}
+
+func NewModerationService(
+	userRepo repositories.UserRepository,
+	tokenRepo repositories.TokenRepository,
+	adminService *AdminService,
+	sessionService *SessionService,
+	messageBroker *rabbitmq.Client,
+	refreshTokenTTL time.Duration,
+	logger logging.Logger,
+) *ModerationService {
+	return &ModerationService{
+		userRepo:        userRepo,
+		tokenRepo:       tokenRepo,
+		adminService:    adminService,
+		sessionService:  sessionService,
+		messageBroker:   messageBroker,
+		refreshTokenTTL: refreshTokenTTL,
+		logger:          logger,
+	}
+}
+
+// SuspendUser blocks the user from logging in until the given time, or until
+// the suspension is lifted when no end time is given.
+func (s *ModerationService) SuspendUser(ctx context.Context, actorID, userID, reason string, until *time.Time) (*models.User, error) {
+	actor, err := s.adminService.Authorize(ctx, actorID, jwt.PermissionUsersManage)
+	if err != nil {
+		return nil, err
+	}
+
+	now := indianstandardtime.Now()
+	reason = strings.TrimSpace(reason)
+	if reason == "" || (until != nil && !until.After(now)) {
+		return nil, autherrors.ErrInvalidSuspension
+	}
+
+	user, err := s.getTargetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsBanned() {
+		return nil, autherrors.ErrUserAlreadyBanned
+	}
+
+	user.SuspendedAt = &now
+	user.SuspendedUntil = until
+	user.SuspensionReason = reason
+	user.UpdatedAt = now
+	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+		s.logger.Error("Failed to suspend user", "userId", userID, "error", err)
+		return nil, fmt.Errorf("failed to suspend user: %w", err)
+	}
+
+	s.signOutEverywhere(ctx, user)
+	s.publishSuspension(user, actor, false)
+
+	s.logger.Info("User suspended", "userId", userID, "suspendedBy", actor.ID, "until", until)
+	return user, nil
+}
+
+// BanUser permanently blocks the user from logging in
+func (s *ModerationService) BanUser(ctx context.Context, actorID, userID, reason string) (*models.User, error) {
+	actor, err := s.adminService.Authorize(ctx, actorID, jwt.PermissionUsersManage)
+	if err != nil {
+		return nil, err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, autherrors.ErrInvalidSuspension
+	}
+
+	user, err := s.getTargetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsBanned() {
+		return nil, autherrors.ErrUserAlreadyBanned
+	}
+
+	now := indianstandardtime.Now()
+	user.BannedAt = &now
+	user.SuspendedAt = nil
+	user.SuspendedUntil = nil
+	user.SuspensionReason = reason
+	user.UpdatedAt = now
+	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+		s.logger.Error("Failed to ban user", "userId", userID, "error", err)
+		return nil, fmt.Errorf("failed to ban user: %w", err)
+	}
+
+	s.signOutEverywhere(ctx, user)
+	s.publishSuspension(user, actor, true)
+
+	s.logger.Info("User banned", "userId", userID, "bannedBy", actor.ID)
+	return user, nil
+}
+
+// ReinstateUser lifts a suspension or ban
+func (s *ModerationService) ReinstateUser(ctx context.Context, actorID, userID string) (*models.User, error) {
+	actor, err := s.adminService.Authorize(ctx, actorID, jwt.PermissionUsersManage)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.getTargetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.SuspendedAt = nil
+	user.SuspendedUntil = nil
+	user.SuspensionReason = ""
+	user.BannedAt = nil
+	user.UpdatedAt = indianstandardtime.Now()
+	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+		s.logger.Error("Failed to reinstate user", "userId", userID, "error", err)
+		return nil, fmt.Errorf("failed to reinstate user: %w", err)
+	}
+
+	s.publish(constants.TopicUserReinstated, map[string]interface{}{
+		"user_id":    user.ID.String(),
+		"admin_id":   actor.ID.String(),
+		"event_type": constants.EventTypeUserReinstated,
+		"timestamp":  indianstandardtime.Now(),
+	})
+
+	s.logger.Info("User reinstated", "userId", userID, "reinstatedBy", actor.ID)
+	return user, nil
+}
+
+// GetUserStatus returns the user so callers can check whether the account may still be used
+// GrantPremium gives the user premium membership until the given time. It
+// only extends membership, so until must be later than the current end.
+func (s *ModerationService) GrantPremium(ctx context.Context, actorID, userID string, until time.Time) (*models.User, error) {
+	actor, err := s.adminService.Authorize(ctx, actorID, jwt.PermissionPremiumGrant)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.getTargetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !until.After(indianstandardtime.Now()) || (user.PremiumUntil != nil && !until.After(*user.PremiumUntil)) {
+		return nil, autherrors.ErrPremiumNotExtended
+	}
+
+	if err := s.userRepo.UpdatePremiumUntil(ctx, userID, until); err != nil {
+		s.logger.Error("Failed to grant premium", "userId", userID, "error", err)
+		return nil, fmt.Errorf("failed to grant premium: %w", err)
+	}
+	user.PremiumUntil = &until
+
+	s.logger.Info("Premium granted", "userId", userID, "premiumUntil", until, "grantedBy", actor.ID)
+	return user, nil
+}
+
+func (s *ModerationService) GetUserStatus(ctx context.Context, userID string) (*models.User, error) {
+	return s.getTargetUser(ctx, userID)
+}
+
+// CheckAccountStanding returns the error to report when a banned or
+// currently suspended user tries to use their account
+func CheckAccountStanding(user *models.User) error {
+	if user.IsBanned() {
+		return autherrors.ErrAccountBanned
+	}
+	if user.IsSuspended(indianstandardtime.Now()) {
+		return autherrors.ErrAccountSuspended
+	}
+	return nil
+}
+
+func (s *ModerationService) getTargetUser(ctx context.Context, userID string) (*models.User, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, autherrors.ErrInvalidInput
+	}
+
+	user, err := s.userRepo.GetUser(ctx, "id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil {
+		return nil, autherrors.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// signOutEverywhere revokes the user's sessions and every access token issued so far
+func (s *ModerationService) signOutEverywhere(ctx context.Context, user *models.User) {
+	userID := user.ID.String()
+	if _, err := s.sessionService.RevokeAllSessions(ctx, userID); err != nil {
+		s.logger.Error("Failed to revoke user sessions", "userId", userID, "error", err)
+	}
+	if err := s.tokenRepo.RevokeUserTokens(ctx, userID, indianstandardtime.Now(), s.refreshTokenTTL); err != nil {
+		s.logger.Error("Failed to revoke user tokens", "userId", userID, "error", err)
+	}
+}
+
+// publishSuspension announces a suspension or ban so other services can hide the user
+func (s *ModerationService) publishSuspension(user *models.User, actor *models.Admin, banned bool) {
+	event := map[string]interface{}{
+		"user_id":    user.ID.String(),
+		"admin_id":   actor.ID.String(),
+		"banned":     banned,
+		"reason":     user.SuspensionReason,
+		"event_type": constants.EventTypeUserSuspended,
+		"timestamp":  indianstandardtime.Now(),
+	}
+	if user.SuspendedUntil != nil {
+		event["suspended_until"] = *user.SuspendedUntil
+	}
+
+	s.publish(constants.TopicUserSuspended, event)
+}
+
+func (s *ModerationService) publish(topic string, event map[string]interface{}) {
+	if s.messageBroker == nil {
+		return
+	}
+	if err := s.messageBroker.Publish(topic, event); err != nil {
+		s.logger.Error("Failed to publish moderation event", "topic", topic, "userId", event["user_id"], "error", err)
+	}
+}
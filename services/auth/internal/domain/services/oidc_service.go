@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/oidc"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/encryption"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+)
+
+// OIDCService signs users in through external OpenID Connect providers.
+//
+// A provider account is matched on its subject identifier once it has been
+// linked. The first sign-in registers a new account when no account uses the
+// verified email address. An existing account is never linked just because the
+// email matches: its owner has to log in and link the provider with StartLink.
+type OIDCService struct {
+	providers    map[string]*oidc.Provider
+	stateRepo    repositories.OIDCStateRepository
+	identityRepo repositories.UserIdentityRepository
+	userRepo     repositories.UserRepository
+	authService  *AuthService
+	authEvents   *AuthEventService
+	stateExpiry  time.Duration
+	logger       logging.Logger
+}
+
+func NewOIDCService(
+	providers []*oidc.Provider,
+	stateRepo repositories.OIDCStateRepository,
+	identityRepo repositories.UserIdentityRepository,
+	userRepo repositories.UserRepository,
+	authService *AuthService,
+	authEvents *AuthEventService,
+	stateExpiry time.Duration,
+	logger logging.Logger,
+) *OIDCService {
+	byName := make(map[string]*oidc.Provider, len(providers))
+	for _, provider := range providers {
+		byName[provider.Name()] = provider
+	}
+
+	return &OIDCService{
+		providers:    byName,
+		stateRepo:    stateRepo,
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		authService:  authService,
+		authEvents:   authEvents,
+		stateExpiry:  stateExpiry,
+		logger:       logger,
+	}
+}
+
+// StartLogin remembers a new sign-in attempt and returns the provider URL the
+// user has to be redirected to
+func (s *OIDCService) StartLogin(ctx context.Context, providerName string) (string, error) {
+	return s.start(ctx, providerName, "")
+}
+
+// StartLink is StartLogin for a logged-in user: when the provider redirects
+// back, the provider account is linked to the user instead of being looked up
+func (s *OIDCService) StartLink(ctx context.Context, providerName, userID string) (string, error) {
+	if userID == "" {
+		return "", autherrors.ErrInvalidInput
+	}
+	return s.start(ctx, providerName, userID)
+}
+
+func (s *OIDCService) start(ctx context.Context, providerName, linkUserID string) (string, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return "", autherrors.ErrOIDCProviderNotFound
+	}
+
+	state, err := randomHex(32)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate state: %w", err)
+	}
+	nonce, err := randomHex(16)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	verifier, err := oidc.NewCodeVerifier()
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, oidc.CodeChallenge(verifier))
+	if err != nil {
+		s.logger.Error("Failed to build authorization URL", "provider", providerName, "error", err)
+		return "", fmt.Errorf("failed to build authorization URL: %w", err)
+	}
+
+	pending := &models.OIDCState{
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		LinkUserID:   linkUserID,
+		CreatedAt:    indianstandardtime.Now(),
+	}
+	if err := s.stateRepo.StoreOIDCState(ctx, state, pending, s.stateExpiry); err != nil {
+		return "", fmt.Errorf("failed to store OIDC state: %w", err)
+	}
+
+	s.logger.Info("OIDC login started", "provider", providerName, "link", linkUserID != "")
+	return authURL, nil
+}
+
+// CompleteLogin handles the provider's redirect back: the authorization code
+// is exchanged, the ID token validated and the matching user logged in.
+// newUser reports whether the sign-in registered a new account.
+func (s *OIDCService) CompleteLogin(ctx context.Context, providerName, state, code, clientIP, userAgent string) (tokens *TokenPair, newUser bool, err error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, false, autherrors.ErrOIDCProviderNotFound
+	}
+
+	var user *models.User
+	identifier := providerName
+	defer func() {
+		s.authEvents.RecordUserEvent(ctx, models.AuthEventOIDCLogin, user, identifier, clientIP, userAgent, err)
+	}()
+
+	if state == "" || code == "" {
+		return nil, false, autherrors.ErrInvalidInput
+	}
+
+	pending, err := s.stateRepo.GetOIDCState(ctx, state)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to retrieve OIDC state: %w", err)
+	}
+	if pending == nil || pending.Provider != providerName {
+		s.logger.Debug("Unknown or expired OIDC state", "provider", providerName)
+		return nil, false, autherrors.ErrInvalidOIDCState
+	}
+
+	// A state can only be used once, even if the rest of the login fails
+	if err := s.stateRepo.DeleteOIDCState(ctx, state); err != nil {
+		s.logger.Error("Failed to delete OIDC state", "provider", providerName, "error", err)
+	}
+
+	providerTokens, err := provider.Exchange(ctx, code, pending.CodeVerifier)
+	if err != nil {
+		s.logger.Error("OIDC code exchange failed", "provider", providerName, "error", err)
+		return nil, false, autherrors.ErrOIDCAuthenticationFailed
+	}
+
+	claims, err := provider.VerifyIDToken(ctx, providerTokens.IDToken, pending.Nonce)
+	if err != nil {
+		s.logger.Error("OIDC ID token rejected", "provider", providerName, "error", err)
+		return nil, false, autherrors.ErrOIDCAuthenticationFailed
+	}
+
+	identifier = providerName + ":" + claims.Subject
+	if pending.LinkUserID != "" {
+		user, err = s.linkUser(ctx, providerName, claims, pending.LinkUserID)
+	} else {
+		user, newUser, err = s.resolveUser(ctx, providerName, claims)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := CheckAccountStanding(user); err != nil {
+		s.logger.Debug("Login blocked by moderation", "userId", user.ID, "error", err)
+		return nil, false, err
+	}
+
+	tokens, err = s.authService.completeLogin(ctx, user, clientIP, userAgent)
+	if err != nil {
+		return nil, false, err
+	}
+
+	s.logger.Info("User logged in with OIDC", "provider", providerName, "userId", user.ID, "newUser", newUser)
+	return tokens, newUser, nil
+}
+
+// resolveUser returns the user the provider account is linked to, registering
+// an account on the first sign-in
+func (s *OIDCService) resolveUser(ctx context.Context, providerName string, claims *oidc.IDTokenClaims) (*models.User, bool, error) {
+	now := indianstandardtime.Now()
+
+	identity, err := s.identityRepo.GetIdentity(ctx, providerName, claims.Subject)
+	if err != nil {
+		s.logger.Error("Failed to retrieve identity", "provider", providerName, "error", err)
+		return nil, false, fmt.Errorf("error retrieving identity: %w", err)
+	}
+
+	if identity != nil {
+		user, err := s.userRepo.GetUser(ctx, "id", identity.UserID.String())
+		if err != nil {
+			s.logger.Error("Failed to retrieve user", "userId", identity.UserID, "error", err)
+			return nil, false, fmt.Errorf("error retrieving user: %w", err)
+		}
+		if user == nil {
+			s.logger.Debug("Linked account is disabled", "userId", identity.UserID)
+			return nil, false, autherrors.ErrAccountDisabled
+		}
+
+		identity.Email = claims.Email
+		identity.LastLoginAt = now
+		if err := s.identityRepo.UpdateIdentityLogin(ctx, identity); err != nil {
+			s.logger.Error("Failed to update identity", "userId", user.ID, "error", err)
+		}
+		return user, false, nil
+	}
+
+	// Linking by email is only safe when the provider vouches for the address
+	if claims.Email == "" || !claims.EmailVerified {
+		s.logger.Debug("OIDC account has no verified email", "provider", providerName)
+		return nil, false, autherrors.ErrOIDCEmailNotVerified
+	}
+
+	user, err := s.userRepo.GetUser(ctx, "email", claims.Email)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "email", claims.Email, "error", err)
+		return nil, false, fmt.Errorf("error retrieving user: %w", err)
+	}
+
+	// Whoever controls the provider account does not necessarily own the
+	// account with the same email, so only its logged-in owner may link it
+	if user != nil {
+		s.logger.Debug("OIDC account matches an existing account", "provider", providerName, "userId", user.ID)
+		return nil, false, autherrors.ErrOIDCLinkRequired
+	}
+
+	user, err = s.registerUser(ctx, claims.Email, now)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := s.createIdentity(ctx, providerName, claims, user, now); err != nil {
+		return nil, false, err
+	}
+	return user, true, nil
+}
+
+// linkUser links the provider account to the logged-in user that started the sign-in
+func (s *OIDCService) linkUser(ctx context.Context, providerName string, claims *oidc.IDTokenClaims, userID string) (*models.User, error) {
+	now := indianstandardtime.Now()
+
+	user, err := s.userRepo.GetUser(ctx, "id", userID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "userId", userID, "error", err)
+		return nil, fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil {
+		return nil, autherrors.ErrUserNotFound
+	}
+
+	identity, err := s.identityRepo.GetIdentity(ctx, providerName, claims.Subject)
+	if err != nil {
+		s.logger.Error("Failed to retrieve identity", "provider", providerName, "error", err)
+		return nil, fmt.Errorf("error retrieving identity: %w", err)
+	}
+	if identity != nil {
+		if identity.UserID != user.ID {
+			return nil, autherrors.ErrOIDCIdentityInUse
+		}
+
+		identity.Email = claims.Email
+		identity.LastLoginAt = now
+		if err := s.identityRepo.UpdateIdentityLogin(ctx, identity); err != nil {
+			s.logger.Error("Failed to update identity", "userId", user.ID, "error", err)
+		}
+		return user, nil
+	}
+
+	if err := s.createIdentity(ctx, providerName, claims, user, now); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *OIDCService) createIdentity(ctx context.Context, providerName string, claims *oidc.IDTokenClaims, user *models.User, now time.Time) error {
+	err := s.identityRepo.CreateIdentity(ctx, &models.UserIdentity{
+		UserID:      user.ID,
+		Provider:    providerName,
+		Subject:     claims.Subject,
+		Email:       claims.Email,
+		CreatedAt:   now,
+		LastLoginAt: now,
+	})
+	if err != nil {
+		s.logger.Error("Failed to link identity", "provider", providerName, "userId", user.ID, "error", err)
+		return fmt.Errorf("failed to link identity: %w", err)
+	}
+
+	s.logger.Info("OIDC identity linked", "provider", providerName, "userId", user.ID)
+	return nil
+}
+
+// registerUser creates an account for a provider account with a new email
+// address. It gets an unusable random password; the user can set one with
+// the forgot password flow.
+func (s *OIDCService) registerUser(ctx context.Context, email string, now time.Time) (*models.User, error) {
+	password, err := randomHex(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate password: %w", err)
+	}
+	passwordHash, err := encryption.HashPassword(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user := &models.User{
+		Email:        email,
+		PasswordHash: passwordHash,
+		Verified:     true,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := s.userRepo.CreateUser(ctx, user); err != nil {
+		s.logger.Error("Failed to create user", "email", email, "error", err)
+		return nil, fmt.Errorf("%w: %v", autherrors.ErrRegistrationFailed, err)
+	}
+
+	s.logger.Info("User registered with OIDC", "email", email, "id", user.ID)
+	return user, nil
+}
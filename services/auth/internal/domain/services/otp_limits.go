@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+)
+
+// OTPSendLimits controls how often codes can be sent to the same identifier
+type OTPSendLimits struct {
+	ResendCooldown time.Duration // Minimum time between two codes sent to the same identifier
+	MaxSendsPerDay int           // Codes an identifier can be sent in 24 hours
+}
+
+// reserveOTPSend checks the resend cooldown and daily cap of the identifier
+// and counts the code about to be sent
+func reserveOTPSend(
+	ctx context.Context,
+	otpLimitRepo repositories.OTPLimitRepository,
+	limits OTPSendLimits,
+	identifier string,
+	logger logging.Logger,
+) error {
+	started, err := otpLimitRepo.StartResendCooldown(ctx, identifier, limits.ResendCooldown)
+	if err != nil {
+		return fmt.Errorf("failed to check OTP cooldown: %w", err)
+	}
+	if !started {
+		logger.Debug("OTP requested during cooldown", "identifier", identifier)
+		return autherrors.ErrOTPResendTooSoon
+	}
+
+	sent, err := otpLimitRepo.IncrementSendCount(ctx, identifier, constants.OTPSendCountWindow*time.Hour)
+	if err != nil {
+		return fmt.Errorf("failed to count OTP sends: %w", err)
+	}
+	if int(sent) > limits.MaxSendsPerDay {
+		logger.Warn("Daily OTP limit reached", "identifier", identifier, "sent", sent)
+		return autherrors.ErrOTPSendLimitReached
+	}
+	return nil
+}
+
+// smsLimitIdentifier is the identifier the send limits of a phone number are
+// kept under. Every flow that texts a code shares it, so switching between
+// them or registering with new email addresses does not lift the limits.
+func smsLimitIdentifier(phone string) string {
+	return constants.SMSLimitPrefix + phone
+}
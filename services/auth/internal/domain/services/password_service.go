@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/email"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/encryption"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/otp"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+type PasswordService struct {
+	userRepo          repositories.UserRepository
+	tokenRepo         repositories.TokenRepository
+	sessionService    *SessionService
+	otpRepo           repositories.OTPRepository
+	otpLimitRepo      repositories.OTPLimitRepository
+	otpGenerator      *otp.Generator
+	otpExpiryTime     time.Duration
+	sendLimits        OTPSendLimits
+	maxVerifyAttempts int
+	refreshTokenTTL   time.Duration
+	emailClient       *email.Client
+	authEvents        *AuthEventService
+	logger            logging.Logger
+}
+
+func NewPasswordService(
+	userRepo repositories.UserRepository,
+	tokenRepo repositories.TokenRepository,
+	sessionService *SessionService,
+	otpRepo repositories.OTPRepository,
+	otpLimitRepo repositories.OTPLimitRepository,
+	otpGenerator *otp.Generator,
+	otpExpiryTime time.Duration,
+	sendLimits OTPSendLimits,
+	maxVerifyAttempts int,
+	refreshTokenTTL time.Duration,
+	emailClient *email.Client,
+	authEvents *AuthEventService,
+	logger logging.Logger,
+) *PasswordService {
+	return &PasswordService{
+		userRepo:          userRepo,
+		tokenRepo:         tokenRepo,
+		sessionService:    sessionService,
+		otpRepo:           otpRepo,
+		otpLimitRepo:      otpLimitRepo,
+		otpGenerator:      otpGenerator,
+		otpExpiryTime:     otpExpiryTime,
+		sendLimits:        sendLimits,
+		maxVerifyAttempts: maxVerifyAttempts,
+		refreshTokenTTL:   refreshTokenTTL,
+		emailClient:       emailClient,
+		authEvents:        authEvents,
+		logger:            logger,
+	}
+}
+
+// Example: if email is "user@example.com", this returns "password_reset_otp:user@example.com"
+func (s *PasswordService) getResetOTPKey(email string) string {
+	return constants.PasswordResetOTPPrefix + email
+}
+
+// discardOTP deletes the reset OTP of the email so it can no longer be used
+func (s *PasswordService) discardOTP(ctx context.Context, email string) {
+	key := s.getResetOTPKey(email)
+	if err := s.otpRepo.DeleteOTP(ctx, key); err != nil {
+		s.logger.Error("Failed to delete password reset OTP", "key", key, "error", err)
+	}
+}
+
+// ForgotPassword sends a password reset OTP to the given email.
+// It does not report whether the email belongs to an account, so callers
+// cannot use it to discover registered users. Requests are limited by a
+// cooldown and a daily cap per email whether or not it has an account.
+// A new code does not reset the count of wrong codes entered, so the limit
+// cannot be lifted by requesting codes.
+func (s *PasswordService) ForgotPassword(ctx context.Context, email string) error {
+	if !validation.ValidateEmail(email) {
+		return autherrors.ErrInvalidInput
+	}
+
+	key := s.getResetOTPKey(email)
+	if err := reserveOTPSend(ctx, s.otpLimitRepo, s.sendLimits, key, s.logger); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetUser(ctx, "email", email)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "email", email, "error", err)
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil || !user.Verified {
+		s.logger.Debug("Password reset requested for unknown account", "email", email)
+		return nil
+	}
+
+	resetOTP, err := s.otpGenerator.Generate()
+	if err != nil {
+		return fmt.Errorf("%w: %v", autherrors.ErrOTPGenerationFailed, err)
+	}
+
+	if err := s.otpRepo.StoreOTP(ctx, key, resetOTP, s.otpExpiryTime); err != nil {
+		return fmt.Errorf("failed to store password reset OTP: %w", err)
+	}
+
+	if err := s.emailClient.SendOTPEmail(email, resetOTP); err != nil {
+		return fmt.Errorf("failed to send password reset email: %w", err)
+	}
+
+	s.logger.Info("Password reset OTP sent", "userID", user.ID)
+	return nil
+}
+
+// ResetPassword verifies the reset OTP and replaces the user's password.
+// All sessions are signed out and every access token issued before the
+// reset is revoked, so existing sessions have to log in again.
+func (s *PasswordService) ResetPassword(ctx context.Context, email, inputOTP, newPassword, clientIP, userAgent string) (err error) {
+	var user *models.User
+	defer func() {
+		s.authEvents.RecordUserEvent(ctx, models.AuthEventPasswordReset, user, email, clientIP, userAgent, err)
+	}()
+
+	if !validation.ValidateEmail(email) || inputOTP == "" {
+		return autherrors.ErrInvalidInput
+	}
+
+	if !validation.ValidatePassword(newPassword, validation.DefaultPasswordPolicy()) {
+		return autherrors.ErrWeakPassword
+	}
+
+	// Every check counts, so the code cannot be guessed. Once the limit is
+	// reached the code is discarded and a new one must be requested.
+	key := s.getResetOTPKey(email)
+	attempts, err := s.otpLimitRepo.IncrementVerifyAttempts(ctx, key, s.otpExpiryTime)
+	if err != nil {
+		return fmt.Errorf("failed to count OTP attempts: %w", err)
+	}
+	if int(attempts) > s.maxVerifyAttempts {
+		s.logger.Warn("Too many password reset OTP attempts", "email", email)
+		s.discardOTP(ctx, email)
+		return autherrors.ErrTooManyOTPAttempts
+	}
+
+	storedOTP, err := s.otpRepo.GetOTP(ctx, key)
+	if err != nil {
+		if err == redis.Nil {
+			s.logger.Debug("No password reset OTP found", "email", email)
+			return autherrors.ErrInvalidOTP
+		}
+		return fmt.Errorf("failed to retrieve password reset OTP: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(storedOTP), []byte(inputOTP)) != 1 {
+		s.logger.Debug("Invalid password reset OTP provided", "email", email)
+		return autherrors.ErrInvalidOTP
+	}
+
+	user, err = s.userRepo.GetUser(ctx, "email", email)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "email", email, "error", err)
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil {
+		return autherrors.ErrUserNotFound
+	}
+
+	hashedPassword, err := encryption.HashPassword(newPassword)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	now := indianstandardtime.Now()
+	user.PasswordHash = hashedPassword
+	user.PasswordResetRequired = false
+	user.UpdatedAt = now
+
+	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+		s.logger.Error("Failed to update password", "userID", user.ID, "error", err)
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	s.discardOTP(ctx, email)
+	if err := s.otpLimitRepo.ResetVerifyAttempts(ctx, key); err != nil {
+		s.logger.Error("Failed to reset OTP attempts", "email", email, "error", err)
+	}
+
+	userID := user.ID.String()
+	if _, err := s.sessionService.RevokeAllSessions(ctx, userID); err != nil {
+		s.logger.Error("Failed to revoke sessions", "userID", userID, "error", err)
+	}
+
+	if err := s.tokenRepo.RevokeUserTokens(ctx, userID, now, s.refreshTokenTTL); err != nil {
+		s.logger.Error("Failed to revoke outstanding tokens", "userID", userID, "error", err)
+		return fmt.Errorf("failed to revoke outstanding tokens: %w", err)
+	}
+
+	s.logger.Info("Password reset successfully", "userID", userID)
+	return nil
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/sms"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/otp"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// PhoneService verifies phone numbers and handles OTP codes delivered over SMS
+type PhoneService struct {
+	userRepo          repositories.UserRepository
+	otpRepo           repositories.OTPRepository
+	otpLimitRepo      repositories.OTPLimitRepository
+	otpGenerator      *otp.Generator
+	otpExpiryTime     time.Duration
+	sendLimits        OTPSendLimits
+	maxVerifyAttempts int
+	smsSender         sms.SMSSender
+	logger            logging.Logger
+}
+
+func NewPhoneService(
+	userRepo repositories.UserRepository,
+	otpRepo repositories.OTPRepository,
+	otpLimitRepo repositories.OTPLimitRepository,
+	otpGenerator *otp.Generator,
+	otpExpiryTime time.Duration,
+	sendLimits OTPSendLimits,
+	maxVerifyAttempts int,
+	smsSender sms.SMSSender,
+	logger logging.Logger,
+) *PhoneService {
+	return &PhoneService{
+		userRepo:          userRepo,
+		otpRepo:           otpRepo,
+		otpLimitRepo:      otpLimitRepo,
+		otpGenerator:      otpGenerator,
+		otpExpiryTime:     otpExpiryTime,
+		sendLimits:        sendLimits,
+		maxVerifyAttempts: maxVerifyAttempts,
+		smsSender:         smsSender,
+		logger:            logger,
+	}
+}
+
+// SendVerificationOTP sends an OTP to the phone number of a registered user
+func (s *PhoneService) SendVerificationOTP(ctx context.Context, userID string) error {
+	user, err := s.userRepo.GetUser(ctx, "id", userID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "userID", userID, "error", err)
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil {
+		return autherrors.ErrUserNotFound
+	}
+	if user.PhoneVerified {
+		return autherrors.ErrPhoneAlreadyVerified
+	}
+	if user.Phone == "" {
+		return autherrors.ErrPhoneNotSet
+	}
+
+	if err := reserveOTPSend(ctx, s.otpLimitRepo, s.sendLimits, smsLimitIdentifier(user.Phone), s.logger); err != nil {
+		return err
+	}
+
+	if err := s.sendOTP(ctx, constants.PhoneVerifyOTPPrefix+userID, user.Phone); err != nil {
+		return err
+	}
+
+	s.logger.Info("Phone verification OTP sent", "userID", userID)
+	return nil
+}
+
+// VerifyPhone checks the verification OTP and marks the user's phone number as verified
+func (s *PhoneService) VerifyPhone(ctx context.Context, userID, inputOTP string) error {
+	if inputOTP == "" {
+		return autherrors.ErrInvalidInput
+	}
+
+	user, err := s.userRepo.GetUser(ctx, "id", userID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "userID", userID, "error", err)
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil {
+		return autherrors.ErrUserNotFound
+	}
+	if user.PhoneVerified {
+		return autherrors.ErrPhoneAlreadyVerified
+	}
+
+	valid, err := s.checkOTP(ctx, constants.PhoneVerifyOTPPrefix+userID, inputOTP)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		s.logger.Debug("Invalid phone verification OTP provided", "userID", userID)
+		return autherrors.ErrInvalidOTP
+	}
+
+	user.PhoneVerified = true
+	user.UpdatedAt = indianstandardtime.Now()
+	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+		s.logger.Error("Failed to mark phone as verified", "userID", userID, "error", err)
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	s.logger.Info("Phone verified successfully", "userID", userID)
+	return nil
+}
+
+// SendLoginOTP sends a login OTP to the phone number.
+// Like ForgotPassword it does not reveal whether the number belongs to an account;
+// only active accounts with a verified phone number receive a code. The send
+// limits of the number apply whether or not it belongs to an account.
+func (s *PhoneService) SendLoginOTP(ctx context.Context, phone string) error {
+	phone, err := validation.NormalizePhone(phone)
+	if err != nil {
+		return autherrors.ErrInvalidInput
+	}
+
+	if err := reserveOTPSend(ctx, s.otpLimitRepo, s.sendLimits, smsLimitIdentifier(phone), s.logger); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetUser(ctx, "phone", phone)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "phone", phone, "error", err)
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil || !user.PhoneVerified || !user.IsActive {
+		s.logger.Debug("Login OTP requested for unknown or unverified phone", "phone", phone)
+		return nil
+	}
+
+	if err := s.sendOTP(ctx, constants.LoginOTPPrefix+phone, phone); err != nil {
+		return err
+	}
+
+	s.logger.Info("Login OTP sent", "userID", user.ID)
+	return nil
+}
+
+// VerifyLoginOTP checks a login OTP and returns the user it was sent to.
+// It returns ErrInvalidOTP when the code is wrong or expired.
+func (s *PhoneService) VerifyLoginOTP(ctx context.Context, phone, inputOTP string) (*models.User, error) {
+	phone, err := validation.NormalizePhone(phone)
+	if err != nil || inputOTP == "" {
+		return nil, autherrors.ErrInvalidInput
+	}
+
+	valid, err := s.checkOTP(ctx, constants.LoginOTPPrefix+phone, inputOTP)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, autherrors.ErrInvalidOTP
+	}
+
+	user, err := s.userRepo.GetUser(ctx, "phone", phone)
+	if err != nil {
+		s.logger.Error("Failed to retrieve user", "phone", phone, "error", err)
+		return nil, fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil || !user.PhoneVerified {
+		return nil, autherrors.ErrInvalidOTP
+	}
+
+	return user, nil
+}
+
+// sendOTP generates an OTP, stores it under key and sends it to phone by SMS.
+// Callers reserve the send with the phone's limits first.
+func (s *PhoneService) sendOTP(ctx context.Context, key, phone string) error {
+	code, err := s.otpGenerator.Generate()
+	if err != nil {
+		return fmt.Errorf("%w: %v", autherrors.ErrOTPGenerationFailed, err)
+	}
+
+	if err := s.otpRepo.StoreOTP(ctx, key, code, s.otpExpiryTime); err != nil {
+		return fmt.Errorf("failed to store OTP: %w", err)
+	}
+
+	if err := sms.SendOTP(s.smsSender, phone, code); err != nil {
+		return fmt.Errorf("failed to send OTP SMS: %w", err)
+	}
+	return nil
+}
+
+// checkOTP compares inputOTP with the OTP stored under key and deletes it on success.
+// Every check counts, so the code cannot be guessed. Once MaxVerifyAttempts is
+// reached the code is discarded and a new one must be requested.
+func (s *PhoneService) checkOTP(ctx context.Context, key, inputOTP string) (bool, error) {
+	attempts, err := s.otpLimitRepo.IncrementVerifyAttempts(ctx, key, s.otpExpiryTime)
+	if err != nil {
+		return false, fmt.Errorf("failed to count OTP attempts: %w", err)
+	}
+	if int(attempts) > s.maxVerifyAttempts {
+		s.logger.Warn("Too many phone OTP attempts", "key", key)
+		if err := s.otpRepo.DeleteOTP(ctx, key); err != nil {
+			s.logger.Error("Failed to delete OTP", "key", key, "error", err)
+		}
+		return false, autherrors.ErrTooManyOTPAttempts
+	}
+
+	storedOTP, err := s.otpRepo.GetOTP(ctx, key)
+	if err != nil {
+		if err == redis.Nil {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to retrieve OTP: %w", err)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(storedOTP), []byte(inputOTP)) != 1 {
+		return false, nil
+	}
+
+	if err := s.otpRepo.DeleteOTP(ctx, key); err != nil {
+		s.logger.Error("Failed to delete OTP after validation", "key", key, "error", err)
+	}
+	if err := s.otpLimitRepo.ResetVerifyAttempts(ctx, key); err != nil {
+		s.logger.Error("Failed to reset OTP attempts", "key", key, "error", err)
+	}
+	return true, nil
+}
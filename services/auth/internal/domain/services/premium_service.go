@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/email"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+)
+
+// PremiumService reacts to subscription expiry events from the payment service.
+// Expired users are downgraded and their premium tokens revoked; users whose
+// subscription is about to end are reminded by email.
+type PremiumService struct {
+	userRepo        repositories.UserRepository
+	tokenRepo       repositories.TokenRepository
+	emailClient     *email.Client
+	refreshTokenTTL time.Duration
+	logger          logging.Logger
+}
+
+func NewPremiumService(
+	userRepo repositories.UserRepository,
+	tokenRepo repositories.TokenRepository,
+	emailClient *email.Client,
+	refreshTokenTTL time.Duration,
+	logger logging.Logger,
+) *PremiumService {
+	return &PremiumService{
+		userRepo:        userRepo,
+		tokenRepo:       tokenRepo,
+		emailClient:     emailClient,
+		refreshTokenTTL: refreshTokenTTL,
+		logger:          logger,
+	}
+}
+
+// HandlePremiumExpired downgrades the user once their subscription ended.
+// Tokens issued so far carry the premium role, so they are revoked and the
+// next login issues a regular user token.
+func (s *PremiumService) HandlePremiumExpired(ctx context.Context, userID string, endDate time.Time) error {
+	// A renewal after the expired subscription moved premium_until past its end date
+	cleared, err := s.userRepo.ClearPremiumUntil(ctx, userID, endDate)
+	if err != nil {
+		return fmt.Errorf("failed to clear premium status: %w", err)
+	}
+	if !cleared {
+		s.logger.Info("Premium already renewed, skipping downgrade", "userID", userID)
+		return nil
+	}
+
+	if err := s.tokenRepo.RevokeUserTokens(ctx, userID, indianstandardtime.Now(), s.refreshTokenTTL); err != nil {
+		return fmt.Errorf("failed to revoke premium tokens: %w", err)
+	}
+
+	s.logger.Info("Premium expired, user downgraded", "userID", userID)
+	return nil
+}
+
+// SendExpiryReminder emails the user that their premium membership ends soon
+func (s *PremiumService) SendExpiryReminder(ctx context.Context, userID string, endDate time.Time, daysLeft int) error {
+	user, err := s.userRepo.GetUser(ctx, "id", userID)
+	if err != nil {
+		return fmt.Errorf("error retrieving user: %w", err)
+	}
+	if user == nil || user.Email == "" {
+		s.logger.Warn("Cannot send expiry reminder, user not found", "userID", userID)
+		return nil
+	}
+
+	if err := s.emailClient.SendPremiumExpiryReminderEmail(user.Email, endDate, daysLeft); err != nil {
+		return fmt.Errorf("failed to send expiry reminder: %w", err)
+	}
+
+	s.logger.Info("Premium expiry reminder sent", "userID", userID, "daysLeft", daysLeft)
+	return nil
+}
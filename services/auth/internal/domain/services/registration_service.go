@@ -2,11 +2,13 @@ package services
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"time"
 
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/email"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/sms"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/encryption"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/otp"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
@@ -18,13 +20,25 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// RegistrationConfig controls how long registrations wait for verification
+// and how often their codes can be sent and checked
+type RegistrationConfig struct {
+	PendingExpiry     time.Duration // How long a registration can be verified
+	ResendCooldown    time.Duration // Minimum time between two codes sent to the same email
+	MaxSendsPerDay    int           // Codes an email can be sent in 24 hours, including the first one
+	MaxVerifyAttempts int           // Wrong codes accepted before a new code must be requested
+}
+
 type RegistrationService struct {
 	registrationRepo repositories.RegistrationRepository
 	userRepo         repositories.UserRepository
 	otpRepo          repositories.OTPRepository
+	otpLimitRepo     repositories.OTPLimitRepository
 	otpGenerator     *otp.Generator
 	otpExpiryTime    time.Duration
 	emailClient      *email.Client
+	smsSender        sms.SMSSender
+	config           RegistrationConfig
 	logger           logging.Logger
 }
 
@@ -32,18 +46,24 @@ func NewRegistrationService(
 	registrationRepo repositories.RegistrationRepository,
 	userRepo repositories.UserRepository,
 	otpRepo repositories.OTPRepository,
+	otpLimitRepo repositories.OTPLimitRepository,
 	otpGenerator *otp.Generator,
 	otpExpiryTime time.Duration,
 	emailClient *email.Client,
+	smsSender sms.SMSSender,
+	config RegistrationConfig,
 	logger logging.Logger,
 ) *RegistrationService {
 	return &RegistrationService{
 		registrationRepo: registrationRepo,
 		userRepo:         userRepo,
 		otpRepo:          otpRepo,
+		otpLimitRepo:     otpLimitRepo,
 		otpGenerator:     otpGenerator,
 		otpExpiryTime:    otpExpiryTime,
 		emailClient:      emailClient,
+		smsSender:        smsSender,
+		config:           config,
 		logger:           logger,
 	}
 }
@@ -68,7 +88,8 @@ func (s *RegistrationService) storeOTP(ctx context.Context, identifier string) (
 	return otp, nil
 }
 
-// validateOTP checks if an OTP is valid and deletes it if it is
+// validateOTP checks if an OTP is valid and deletes it if it is.
+// Attempts are counted by VerifyRegistration.
 func (s *RegistrationService) validateOTP(ctx context.Context, identifier, inputOTP string) (bool, error) {
 	key := s.getOTPKey(identifier)
 
@@ -80,7 +101,7 @@ func (s *RegistrationService) validateOTP(ctx context.Context, identifier, input
 		return false, fmt.Errorf("failed to retrieve OTP: %w", err)
 	}
 
-	if storedOTP != inputOTP {
+	if subtle.ConstantTimeCompare([]byte(storedOTP), []byte(inputOTP)) != 1 {
 		return false, nil
 	}
 
@@ -92,14 +113,24 @@ func (s *RegistrationService) validateOTP(ctx context.Context, identifier, input
 	return true, nil
 }
 
+// discardOTP deletes the OTP of the identifier so it can no longer be used
+func (s *RegistrationService) discardOTP(ctx context.Context, identifier string) {
+	key := s.getOTPKey(identifier)
+	if err := s.otpRepo.DeleteOTP(ctx, key); err != nil {
+		s.logger.Error("Failed to delete OTP", "key", key, "error", err)
+	}
+}
+
 func (s *RegistrationService) RegisterUser(ctx context.Context, reg *models.Registration) error {
 	if !validation.ValidateEmail(reg.Email) {
 		return fmt.Errorf("%w: invalid email format", autherrors.ErrInvalidInput)
 	}
 
-	if !validation.ValidatePhone(reg.Phone) {
+	phone, err := validation.NormalizePhone(reg.Phone)
+	if err != nil {
 		return fmt.Errorf("%w: invalid phone format", autherrors.ErrInvalidInput)
 	}
+	reg.Phone = phone
 
 	if !validation.ValidatePassword(reg.Password, validation.DefaultPasswordPolicy()) {
 		return fmt.Errorf("%w: password does not meet requirements", autherrors.ErrInvalidInput)
@@ -121,6 +152,10 @@ func (s *RegistrationService) RegisterUser(ctx context.Context, reg *models.Regi
 		return autherrors.ErrPhoneAlreadyExists
 	}
 
+	if err := s.reserveOTPSend(ctx, reg.Email); err != nil {
+		return err
+	}
+
 	// Clean up old pending registrations using same email
 	pendingEmail, err := s.registrationRepo.GetPendingRegistration(ctx, "email", reg.Email)
 	if err != nil {
@@ -158,39 +193,173 @@ func (s *RegistrationService) RegisterUser(ctx context.Context, reg *models.Regi
 		Phone:        reg.Phone,
 		PasswordHash: hashedPassword,
 		CreatedAt:    now,
-		ExpiresAt:    now.Add(1 * time.Hour),
+		ExpiresAt:    now.Add(s.config.PendingExpiry),
 	}
 
 	if err := s.registrationRepo.CreatePendingRegistration(ctx, pendingReg); err != nil {
 		return fmt.Errorf("%w: %v", autherrors.ErrRegistrationFailed, err)
 	}
 
-	otp, err := s.storeOTP(ctx, reg.Email)
+	return s.sendOTPs(ctx, pendingReg)
+}
+
+// ResendOTP sends new codes for a pending registration. Requests are limited
+// by a cooldown and a daily cap per email, and a new code gets a fresh set of
+// verification attempts.
+func (s *RegistrationService) ResendOTP(ctx context.Context, email string) error {
+	if !validation.ValidateEmail(email) {
+		return autherrors.ErrInvalidInput
+	}
+
+	pendingReg, err := s.getActivePendingRegistration(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	if err := s.reserveOTPSend(ctx, email); err != nil {
+		return err
+	}
+
+	if err := s.sendOTPs(ctx, pendingReg); err != nil {
+		return err
+	}
+
+	s.logger.Info("Registration OTP resent", "email", email)
+	return nil
+}
+
+// sendOTPs sends the email code of a pending registration and a code for its phone number
+func (s *RegistrationService) sendOTPs(ctx context.Context, pendingReg *models.PendingRegistration) error {
+	otp, err := s.storeOTP(ctx, pendingReg.Email)
 	if err != nil {
 		return err
 	}
 
-	if err := s.emailClient.SendOTPEmail(reg.Email, otp); err != nil {
+	if err := s.otpLimitRepo.ResetVerifyAttempts(ctx, pendingReg.Email); err != nil {
+		s.logger.Error("Failed to reset OTP attempts", "email", pendingReg.Email, "error", err)
+	}
+
+	if err := s.emailClient.SendOTPEmail(pendingReg.Email, otp); err != nil {
 		return fmt.Errorf("failed to send OTP email: %w", err)
 	}
 
+	// The phone number can be verified together with the email. An SMS failure
+	// does not block registration since the phone can be verified later.
+	if err := reserveOTPSend(ctx, s.otpLimitRepo, s.sendLimits(), smsLimitIdentifier(pendingReg.Phone), s.logger); err != nil {
+		s.logger.Warn("Skipping registration OTP SMS", "phone", pendingReg.Phone, "error", err)
+		return nil
+	}
+	phoneOTP, err := s.storeOTP(ctx, pendingReg.Phone)
+	if err != nil {
+		s.logger.Error("Failed to create phone OTP", "phone", pendingReg.Phone, "error", err)
+		return nil
+	}
+	if err := sms.SendOTP(s.smsSender, pendingReg.Phone, phoneOTP); err != nil {
+		s.logger.Error("Failed to send OTP SMS", "phone", pendingReg.Phone, "error", err)
+	}
+
 	return nil
 }
 
-func (s *RegistrationService) VerifyRegistration(ctx context.Context, email, otp string) error {
-	if !validation.ValidateEmail(email) {
-		s.logger.Debug("Invalid email format", "email", email)
-		return fmt.Errorf("%w: invalid email format", autherrors.ErrInvalidInput)
+// reserveOTPSend checks the resend cooldown and daily cap of the email and
+// counts the code about to be sent
+func (s *RegistrationService) reserveOTPSend(ctx context.Context, email string) error {
+	return reserveOTPSend(ctx, s.otpLimitRepo, s.sendLimits(), email, s.logger)
+}
+
+func (s *RegistrationService) sendLimits() OTPSendLimits {
+	return OTPSendLimits{
+		ResendCooldown: s.config.ResendCooldown,
+		MaxSendsPerDay: s.config.MaxSendsPerDay,
 	}
+}
 
+// getActivePendingRegistration returns the pending registration of the email
+// unless it does not exist or has expired
+func (s *RegistrationService) getActivePendingRegistration(ctx context.Context, email string) (*models.PendingRegistration, error) {
 	pendingReg, err := s.registrationRepo.GetPendingRegistration(ctx, "email", email)
 	if err != nil {
 		s.logger.Error("Failed to get pending registration", "email", email, "error", err)
-		return fmt.Errorf("failed to get pending registration: %w", err)
+		return nil, fmt.Errorf("failed to get pending registration: %w", err)
 	}
-	if pendingReg == nil {
+	if pendingReg == nil || !pendingReg.ExpiresAt.After(indianstandardtime.Now()) {
 		s.logger.Debug("No pending registration found", "email", email)
-		return fmt.Errorf("no pending registration found for email: %s", email)
+		return nil, autherrors.ErrPendingRegistrationNotFound
+	}
+	return pendingReg, nil
+}
+
+// CleanupExpiredRegistrations removes the pending registrations that were not
+// verified in time and returns how many were removed
+func (s *RegistrationService) CleanupExpiredRegistrations(ctx context.Context) (int64, error) {
+	removed, err := s.registrationRepo.DeleteExpiredPendingRegistrations(ctx, indianstandardtime.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired pending registrations: %w", err)
+	}
+	return removed, nil
+}
+
+// RunCleanupWorker removes expired pending registrations at the given interval until the context is cancelled
+func (s *RegistrationService) RunCleanupWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			removed, err := s.CleanupExpiredRegistrations(ctx)
+			if err != nil {
+				s.logger.Error("Pending registration cleanup failed", "error", err)
+				continue
+			}
+			if removed > 0 {
+				s.logger.Info("Removed expired pending registrations", "count", removed)
+			}
+		}
+	}
+}
+
+// VerifyRegistration creates the user once the email OTP is verified.
+// phoneOTP is optional; when given it is checked first and the new user's
+// phone number is marked as verified.
+func (s *RegistrationService) VerifyRegistration(ctx context.Context, email, otp, phoneOTP string) error {
+	if !validation.ValidateEmail(email) {
+		s.logger.Debug("Invalid email format", "email", email)
+		return fmt.Errorf("%w: invalid email format", autherrors.ErrInvalidInput)
+	}
+
+	pendingReg, err := s.getActivePendingRegistration(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	// Every check counts, so the code cannot be guessed. Once the limit is
+	// reached the codes are discarded and new ones must be requested.
+	attempts, err := s.otpLimitRepo.IncrementVerifyAttempts(ctx, email, s.otpExpiryTime)
+	if err != nil {
+		return fmt.Errorf("failed to count OTP attempts: %w", err)
+	}
+	if int(attempts) > s.config.MaxVerifyAttempts {
+		s.logger.Warn("Too many registration OTP attempts", "email", email)
+		s.discardOTP(ctx, email)
+		s.discardOTP(ctx, pendingReg.Phone)
+		return autherrors.ErrTooManyOTPAttempts
+	}
+
+	phoneVerified := false
+	if phoneOTP != "" {
+		valid, err := s.validateOTP(ctx, pendingReg.Phone, phoneOTP)
+		if err != nil {
+			s.logger.Error("Phone OTP validation error", "phone", pendingReg.Phone, "error", err)
+			return fmt.Errorf("OTP verification error: %w", err)
+		}
+		if !valid {
+			s.logger.Debug("Invalid phone OTP provided", "phone", pendingReg.Phone)
+			return autherrors.ErrInvalidOTP
+		}
+		phoneVerified = true
 	}
 
 	valid, err := s.validateOTP(ctx, email, otp)
@@ -205,14 +374,19 @@ func (s *RegistrationService) VerifyRegistration(ctx context.Context, email, otp
 
 	s.logger.Info("OTP verified successfully", "email", email)
 
+	if err := s.otpLimitRepo.ResetVerifyAttempts(ctx, email); err != nil {
+		s.logger.Error("Failed to reset OTP attempts", "email", email, "error", err)
+	}
+
 	now := indianstandardtime.Now()
 	user := &models.User{
-		Email:        pendingReg.Email,
-		Phone:        pendingReg.Phone,
-		PasswordHash: pendingReg.PasswordHash,
-		Verified:     true,
-		CreatedAt:    now,
-		UpdatedAt:    now,
+		Email:         pendingReg.Email,
+		Phone:         pendingReg.Phone,
+		PasswordHash:  pendingReg.PasswordHash,
+		Verified:      true,
+		PhoneVerified: phoneVerified,
+		CreatedAt:     now,
+		UpdatedAt:     now,
 	}
 
 	if err := s.userRepo.CreateUser(ctx, user); err != nil {
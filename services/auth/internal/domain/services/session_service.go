@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+)
+
+// SessionService manages the login sessions (devices) of users and admins.
+// A session is created on every login and owns the refresh token issued for it,
+// so revoking a session signs out only that device.
+type SessionService struct {
+	sessionRepo     repositories.SessionRepository
+	tokenRepo       repositories.TokenRepository
+	refreshTokenTTL time.Duration
+	logger          logging.Logger
+}
+
+func NewSessionService(
+	sessionRepo repositories.SessionRepository,
+	tokenRepo repositories.TokenRepository,
+	refreshTokenTTL time.Duration,
+	logger logging.Logger,
+) *SessionService {
+	return &SessionService{
+		sessionRepo:     sessionRepo,
+		tokenRepo:       tokenRepo,
+		refreshTokenTTL: refreshTokenTTL,
+		logger:          logger,
+	}
+}
+
+// CreateSession starts a new session for the user on the device described by userAgent and clientIP
+func (s *SessionService) CreateSession(ctx context.Context, userID, userAgent, clientIP string) (*models.Session, error) {
+	now := indianstandardtime.Now()
+	session := &models.Session{
+		ID:         uuid.New().String(),
+		UserID:     userID,
+		UserAgent:  userAgent,
+		IPAddress:  clientIP,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+
+	if err := s.sessionRepo.SaveSession(ctx, session, s.refreshTokenTTL); err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	s.logger.Info("Session created", "userID", userID, "sessionID", session.ID)
+	return session, nil
+}
+
+// GetActiveSession returns the session if it exists and belongs to the user.
+// It returns ErrSessionNotFound for expired, revoked or foreign sessions.
+func (s *SessionService) GetActiveSession(ctx context.Context, userID, sessionID string) (*models.Session, error) {
+	session, err := s.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if session == nil || session.UserID != userID {
+		return nil, autherrors.ErrSessionNotFound
+	}
+	return session, nil
+}
+
+// TouchSession records that the session was just used from clientIP and extends its expiry
+func (s *SessionService) TouchSession(ctx context.Context, session *models.Session, clientIP string) error {
+	session.LastUsedAt = indianstandardtime.Now()
+	if clientIP != "" {
+		session.IPAddress = clientIP
+	}
+
+	if err := s.sessionRepo.SaveSession(ctx, session, s.refreshTokenTTL); err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+	return nil
+}
+
+// ListSessions returns the active sessions of the user, most recently used first
+func (s *SessionService) ListSessions(ctx context.Context, userID string) ([]*models.Session, error) {
+	sessions, err := s.sessionRepo.ListSessions(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list sessions", "userID", userID, "error", err)
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// RevokeSession signs out a single session of the user by deleting it together with its refresh token
+func (s *SessionService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	if _, err := s.GetActiveSession(ctx, userID, sessionID); err != nil {
+		return err
+	}
+
+	if err := s.revoke(ctx, userID, sessionID); err != nil {
+		return err
+	}
+
+	s.logger.Info("Session revoked", "userID", userID, "sessionID", sessionID)
+	return nil
+}
+
+// RevokeAllOtherSessions signs out every session of the user except currentSessionID.
+// It returns the number of sessions revoked.
+func (s *SessionService) RevokeAllOtherSessions(ctx context.Context, userID, currentSessionID string) (int, error) {
+	sessions, err := s.ListSessions(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, session := range sessions {
+		if session.ID == currentSessionID {
+			continue
+		}
+		if err := s.revoke(ctx, userID, session.ID); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+
+	s.logger.Info("Other sessions revoked", "userID", userID, "currentSessionID", currentSessionID, "count", revoked)
+	return revoked, nil
+}
+
+// RevokeAllSessions signs out every session of the user
+func (s *SessionService) RevokeAllSessions(ctx context.Context, userID string) (int, error) {
+	return s.RevokeAllOtherSessions(ctx, userID, "")
+}
+
+func (s *SessionService) revoke(ctx context.Context, userID, sessionID string) error {
+	if err := s.tokenRepo.DeleteRefreshToken(ctx, sessionID); err != nil {
+		s.logger.Error("Failed to delete refresh token", "sessionID", sessionID, "error", err)
+		return fmt.Errorf("failed to delete refresh token: %w", err)
+	}
+
+	if err := s.sessionRepo.DeleteSession(ctx, userID, sessionID); err != nil {
+		s.logger.Error("Failed to delete session", "sessionID", sessionID, "error", err)
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
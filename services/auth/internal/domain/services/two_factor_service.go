@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/totp"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	autherrors "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/errors"
+)
+
+// TwoFactorChallenge is returned after a correct admin password when a TOTP
+// code is still needed before tokens are issued.
+type TwoFactorChallenge struct {
+	Token              string
+	EnrollmentRequired bool  // The admin has no confirmed authenticator yet and must enroll first
+	ExpiresIn          int32 // Seconds until the challenge expires
+}
+
+// TOTPEnrollment holds what an admin needs to set up an authenticator app.
+// The recovery codes are only ever shown here; just their hashes are stored.
+type TOTPEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+	RecoveryCodes   []string
+}
+
+// TwoFactorService handles TOTP enrollment and verification for admin accounts
+type TwoFactorService struct {
+	adminRepo        repositories.AdminRepository
+	twoFactorRepo    repositories.TwoFactorRepository
+	totpConfig       totp.Config
+	issuer           string
+	challengeExpiry  time.Duration
+	requireForAdmins bool
+	logger           logging.Logger
+}
+
+func NewTwoFactorService(
+	adminRepo repositories.AdminRepository,
+	twoFactorRepo repositories.TwoFactorRepository,
+	totpConfig totp.Config,
+	issuer string,
+	challengeExpiry time.Duration,
+	requireForAdmins bool,
+	logger logging.Logger,
+) *TwoFactorService {
+	return &TwoFactorService{
+		adminRepo:        adminRepo,
+		twoFactorRepo:    twoFactorRepo,
+		totpConfig:       totpConfig,
+		issuer:           issuer,
+		challengeExpiry:  challengeExpiry,
+		requireForAdmins: requireForAdmins,
+		logger:           logger,
+	}
+}
+
+// IsRequired reports whether the admin has to pass the second step to log in.
+// Admins who enrolled always need it, others only when it is enforced.
+func (s *TwoFactorService) IsRequired(admin *models.Admin) bool {
+	return admin.TOTPEnabled || s.requireForAdmins
+}
+
+// CreateChallenge starts the second login step for an admin who passed the password check
+func (s *TwoFactorService) CreateChallenge(ctx context.Context, admin *models.Admin) (*TwoFactorChallenge, error) {
+	token, err := randomHex(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate two-factor challenge: %w", err)
+	}
+
+	if err := s.twoFactorRepo.StoreChallenge(ctx, token, admin.ID.String(), s.challengeExpiry); err != nil {
+		return nil, fmt.Errorf("failed to store two-factor challenge: %w", err)
+	}
+
+	return &TwoFactorChallenge{
+		Token:              token,
+		EnrollmentRequired: !admin.TOTPEnabled,
+		ExpiresIn:          int32(s.challengeExpiry.Seconds()),
+	}, nil
+}
+
+// GetChallengeAdmin returns the admin the challenge was created for
+func (s *TwoFactorService) GetChallengeAdmin(ctx context.Context, challengeToken string) (*models.Admin, error) {
+	if challengeToken == "" {
+		return nil, autherrors.ErrTwoFactorChallengeNotFound
+	}
+
+	adminID, err := s.twoFactorRepo.GetChallenge(ctx, challengeToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor challenge: %w", err)
+	}
+	if adminID == "" {
+		return nil, autherrors.ErrTwoFactorChallengeNotFound
+	}
+
+	admin, err := s.adminRepo.GetAdminByID(ctx, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving admin: %w", err)
+	}
+	if admin == nil {
+		return nil, autherrors.ErrTwoFactorChallengeNotFound
+	}
+	if !admin.IsActive {
+		return nil, autherrors.ErrAdminAccountDisabled
+	}
+
+	return admin, nil
+}
+
+// Enroll generates a new TOTP secret and recovery codes for the admin of the challenge.
+// Enrollment only takes effect once a code from the new secret is verified.
+func (s *TwoFactorService) Enroll(ctx context.Context, challengeToken string) (*TOTPEnrollment, error) {
+	admin, err := s.GetChallengeAdmin(ctx, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+	if admin.TOTPEnabled {
+		return nil, autherrors.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes := make([]string, 0, constants.DefaultRecoveryCodeCount)
+	hashes := make([]string, 0, constants.DefaultRecoveryCodeCount)
+	for i := 0; i < constants.DefaultRecoveryCodeCount; i++ {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate recovery codes: %w", err)
+		}
+		recoveryCodes = append(recoveryCodes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	admin.TOTPSecret = secret
+	admin.TOTPRecoveryCodes = strings.Join(hashes, ",")
+	admin.UpdatedAt = indianstandardtime.Now()
+	if err := s.adminRepo.UpdateAdmin(ctx, admin); err != nil {
+		s.logger.Error("Failed to save TOTP enrollment", "adminId", admin.ID, "error", err)
+		return nil, fmt.Errorf("failed to save TOTP enrollment: %w", err)
+	}
+
+	s.logger.Info("TOTP enrollment started", "adminId", admin.ID)
+	return &TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(s.totpConfig, s.issuer, admin.Email, secret),
+		RecoveryCodes:   recoveryCodes,
+	}, nil
+}
+
+// VerifyCode checks a TOTP code, or a recovery code once enrollment is confirmed.
+// A used TOTP code or recovery code is rejected if it is tried again.
+func (s *TwoFactorService) VerifyCode(ctx context.Context, admin *models.Admin, code string) (bool, error) {
+	if admin.TOTPSecret == "" {
+		return false, autherrors.ErrTwoFactorNotEnrolled
+	}
+
+	valid, counter, err := totp.Validate(s.totpConfig, admin.TOTPSecret, code, indianstandardtime.Now())
+	if err != nil {
+		return false, fmt.Errorf("failed to validate TOTP code: %w", err)
+	}
+	if valid {
+		// Keep the used marker around for as long as the code could still be accepted
+		expiry := time.Duration(2*s.totpConfig.Skew+1) * s.totpConfig.Period
+		first, err := s.twoFactorRepo.MarkCodeUsed(ctx, admin.ID.String(), counter, expiry)
+		if err != nil {
+			return false, fmt.Errorf("failed to record TOTP code use: %w", err)
+		}
+		if !first {
+			s.logger.Warn("Replayed TOTP code rejected", "adminId", admin.ID)
+		}
+		return first, nil
+	}
+
+	// Recovery codes are only accepted after the authenticator has been confirmed
+	if !admin.TOTPEnabled {
+		return false, nil
+	}
+	return s.useRecoveryCode(ctx, admin, code)
+}
+
+// CompleteChallenge ends the challenge after a successful second step and
+// confirms a pending enrollment.
+func (s *TwoFactorService) CompleteChallenge(ctx context.Context, challengeToken string, admin *models.Admin) error {
+	if err := s.twoFactorRepo.DeleteChallenge(ctx, challengeToken); err != nil {
+		s.logger.Error("Failed to delete two-factor challenge", "adminId", admin.ID, "error", err)
+	}
+
+	if admin.TOTPEnabled {
+		return nil
+	}
+
+	admin.TOTPEnabled = true
+	admin.UpdatedAt = indianstandardtime.Now()
+	if err := s.adminRepo.UpdateAdmin(ctx, admin); err != nil {
+		return fmt.Errorf("failed to enable two-factor authentication: %w", err)
+	}
+
+	s.logger.Info("TOTP enrollment confirmed", "adminId", admin.ID)
+	return nil
+}
+
+// useRecoveryCode consumes the recovery code if it matches one of the stored hashes
+func (s *TwoFactorService) useRecoveryCode(ctx context.Context, admin *models.Admin, code string) (bool, error) {
+	if admin.TOTPRecoveryCodes == "" {
+		return false, nil
+	}
+
+	hash := hashRecoveryCode(code)
+	hashes := strings.Split(admin.TOTPRecoveryCodes, ",")
+	for i, stored := range hashes {
+		if subtle.ConstantTimeCompare([]byte(stored), []byte(hash)) != 1 {
+			continue
+		}
+
+		remaining := append(hashes[:i:i], hashes[i+1:]...)
+		admin.TOTPRecoveryCodes = strings.Join(remaining, ",")
+		admin.UpdatedAt = indianstandardtime.Now()
+		if err := s.adminRepo.UpdateAdmin(ctx, admin); err != nil {
+			return false, fmt.Errorf("failed to consume recovery code: %w", err)
+		}
+
+		s.logger.Info("Recovery code used", "adminId", admin.ID, "remaining", len(remaining))
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// generateRecoveryCode returns a code such as "3f9a1-c07be"
+func generateRecoveryCode() (string, error) {
+	code, err := randomHex(5)
+	if err != nil {
+		return "", err
+	}
+	return code[:5] + "-" + code[5:], nil
+}
+
+// hashRecoveryCode normalizes the code so it can be typed without the dash or in upper case
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(size int) (string, error) {
+	bytes := make([]byte, size)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
@@ -8,3 +8,26 @@ var (
 	ErrAdminAccountDisabled = errors.New("admin account is disabled")
 	ErrInvalidAdminInput    = errors.New("invalid admin input parameters")
 )
+
+// Admin management errors
+var (
+	ErrAdminPermissionDenied = errors.New("admin does not have the required permission")
+	ErrAdminAlreadyExists    = errors.New("admin with this email already exists")
+	ErrInvalidAdminRole      = errors.New("invalid admin role")
+	ErrAdminInviteNotFound   = errors.New("admin invite not found or expired")
+	ErrCannotModifySelf      = errors.New("admins cannot deactivate their own account")
+)
+
+// User moderation errors
+var (
+	ErrInvalidSuspension = errors.New("invalid suspension parameters")
+	ErrUserAlreadyBanned = errors.New("user is already banned")
+)
+
+// Admin two-factor authentication errors
+var (
+	ErrTwoFactorChallengeNotFound = errors.New("two-factor challenge not found or expired")
+	ErrInvalidTwoFactorCode       = errors.New("invalid two-factor code")
+	ErrTwoFactorAlreadyEnabled    = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnrolled       = errors.New("two-factor authentication enrollment has not been started")
+)
@@ -4,24 +4,75 @@ import "errors"
 
 // User authentication errors
 var (
-	ErrUserNotFound       = errors.New("user not found")
-	ErrInvalidCredentials = errors.New("invalid email or password")
-	ErrAccountNotVerified = errors.New("account is not verified")
-	ErrAccountDisabled    = errors.New("account is disabled")
-	ErrEmailAlreadyExists = errors.New("email already registered")
-	ErrPhoneAlreadyExists = errors.New("phone number already registered")
-	ErrRegistrationFailed = errors.New("registration failed")
-	ErrVerificationFailed = errors.New("verification failed")
+	ErrUserNotFound                = errors.New("user not found")
+	ErrInvalidCredentials          = errors.New("invalid email or password")
+	ErrAccountNotVerified          = errors.New("account is not verified")
+	ErrAccountDisabled             = errors.New("account is disabled")
+	ErrEmailAlreadyExists          = errors.New("email already registered")
+	ErrPhoneAlreadyExists          = errors.New("phone number already registered")
+	ErrRegistrationFailed          = errors.New("registration failed")
+	ErrVerificationFailed          = errors.New("verification failed")
+	ErrWeakPassword                = errors.New("password does not meet requirements")
+	ErrAccountLocked               = errors.New("account temporarily locked due to too many failed login attempts")
+	ErrPhoneAlreadyVerified        = errors.New("phone number is already verified")
+	ErrPhoneNotSet                 = errors.New("account has no phone number")
+	ErrAccountSuspended            = errors.New("account is suspended")
+	ErrAccountBanned               = errors.New("account has been banned")
+	ErrAlreadyDeactivated          = errors.New("account is already deactivated")
+	ErrNotDeactivated              = errors.New("account is not deactivated")
+	ErrPasswordResetRequired       = errors.New("password must be reset before logging in")
+	ErrPendingRegistrationNotFound = errors.New("no pending registration found")
+)
+
+// Login alert errors
+var (
+	ErrInvalidLoginReport = errors.New("login report link is invalid or has expired")
+)
+
+// OpenID Connect errors
+var (
+	ErrOIDCProviderNotFound     = errors.New("sign-in provider not found")
+	ErrInvalidOIDCState         = errors.New("sign-in request is invalid or has expired")
+	ErrOIDCAuthenticationFailed = errors.New("sign-in with the external provider failed")
+	ErrOIDCEmailNotVerified     = errors.New("external account has no verified email address")
+	ErrOIDCLinkRequired         = errors.New("an account with the external account's email exists and has to link it first")
+	ErrOIDCIdentityInUse        = errors.New("external account is linked to another user")
+)
+
+// Contact change errors
+var (
+	ErrContactUnchanged = errors.New("new contact is the same as the current one")
+)
+
+// Premium errors
+var (
+	ErrPremiumNotExtended = errors.New("premium end date must be in the future and later than the current one")
+)
+
+// Account deletion errors
+var (
+	ErrDeletionAlreadyScheduled = errors.New("account is already scheduled for deletion")
+	ErrAccountPendingDeletion   = errors.New("account is scheduled for deletion")
+	ErrNoPendingDeletion        = errors.New("account is not scheduled for deletion")
 )
 
 // Token errors
 var (
 	ErrInvalidToken        = errors.New("invalid or expired token")
 	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token has already been used")
+)
+
+// Session errors
+var (
+	ErrSessionNotFound = errors.New("session not found")
 )
 
 // OTP errors
 var (
 	ErrInvalidOTP          = errors.New("invalid or expired OTP")
 	ErrOTPGenerationFailed = errors.New("failed to generate OTP")
+	ErrOTPResendTooSoon    = errors.New("a new OTP was requested too soon")
+	ErrOTPSendLimitReached = errors.New("daily OTP limit reached")
+	ErrTooManyOTPAttempts  = errors.New("too many incorrect OTP attempts")
 )
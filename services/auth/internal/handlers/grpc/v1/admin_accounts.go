@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/auth/v1"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/helpers"
+)
+
+func (h *AuthHandler) ListAdmins(ctx context.Context, req *authpb.ListAdminsRequest) (*authpb.ListAdminsResponse, error) {
+	actorID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	h.logger.Info("Received list admins request", "actorID", actorID)
+
+	admins, err := h.adminService.ListAdmins(ctx, actorID)
+	if err != nil {
+		h.logger.Error("List admins failed", "actorID", actorID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	adminDataList := make([]*authpb.AdminData, len(admins))
+	for i, admin := range admins {
+		adminDataList[i] = convertAdminToProtobuf(admin)
+	}
+
+	return &authpb.ListAdminsResponse{
+		Success: true,
+		Message: "Admins retrieved successfully",
+		Admins:  adminDataList,
+	}, nil
+}
+
+func (h *AuthHandler) CreateAdmin(ctx context.Context, req *authpb.CreateAdminRequest) (*authpb.AdminAccountResponse, error) {
+	actorID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	h.logger.Info("Received create admin request", "actorID", actorID, "email", req.Email, "role", req.Role)
+
+	if req.Email == "" || req.Password == "" || req.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "Email, password and role are required")
+	}
+
+	admin, err := h.adminService.CreateAdmin(ctx, actorID, req.Email, req.Password, req.Role)
+	if err != nil {
+		h.logger.Error("Create admin failed", "actorID", actorID, "email", req.Email, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.AdminAccountResponse{
+		Success: true,
+		Message: "Admin created successfully",
+		Admin:   convertAdminToProtobuf(admin),
+	}, nil
+}
+
+func (h *AuthHandler) InviteAdmin(ctx context.Context, req *authpb.InviteAdminRequest) (*authpb.InviteAdminResponse, error) {
+	actorID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	h.logger.Info("Received invite admin request", "actorID", actorID, "email", req.Email, "role", req.Role)
+
+	if req.Email == "" || req.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "Email and role are required")
+	}
+
+	invite, err := h.adminService.InviteAdmin(ctx, actorID, req.Email, req.Role)
+	if err != nil {
+		h.logger.Error("Invite admin failed", "actorID", actorID, "email", req.Email, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.InviteAdminResponse{
+		Success:   true,
+		Message:   "Invitation sent successfully",
+		ExpiresAt: timestamppb.New(invite.ExpiresAt),
+	}, nil
+}
+
+func (h *AuthHandler) AcceptAdminInvite(ctx context.Context, req *authpb.AcceptAdminInviteRequest) (*authpb.AdminAccountResponse, error) {
+	h.logger.Info("Received accept admin invite request")
+
+	if req.Token == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "Token and password are required")
+	}
+
+	admin, err := h.adminService.AcceptInvite(ctx, req.Token, req.Password)
+	if err != nil {
+		h.logger.Error("Accept admin invite failed", "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.AdminAccountResponse{
+		Success: true,
+		Message: "Admin account created successfully",
+		Admin:   convertAdminToProtobuf(admin),
+	}, nil
+}
+
+func (h *AuthHandler) DeactivateAdmin(ctx context.Context, req *authpb.DeactivateAdminRequest) (*authpb.AdminAccountResponse, error) {
+	actorID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	h.logger.Info("Received deactivate admin request", "actorID", actorID, "adminID", req.AdminId)
+
+	if req.AdminId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Admin ID is required")
+	}
+
+	admin, err := h.adminService.DeactivateAdmin(ctx, actorID, req.AdminId)
+	if err != nil {
+		h.logger.Error("Deactivate admin failed", "actorID", actorID, "adminID", req.AdminId, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.AdminAccountResponse{
+		Success: true,
+		Message: "Admin deactivated successfully",
+		Admin:   convertAdminToProtobuf(admin),
+	}, nil
+}
+
+func (h *AuthHandler) ResetAdminPassword(ctx context.Context, req *authpb.ResetAdminPasswordRequest) (*authpb.AdminAccountResponse, error) {
+	actorID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	h.logger.Info("Received reset admin password request", "actorID", actorID, "adminID", req.AdminId)
+
+	if req.AdminId == "" || req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "Admin ID and new password are required")
+	}
+
+	admin, err := h.adminService.ResetAdminPassword(ctx, actorID, req.AdminId, req.NewPassword, req.ResetTwoFactor)
+	if err != nil {
+		h.logger.Error("Reset admin password failed", "actorID", actorID, "adminID", req.AdminId, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.AdminAccountResponse{
+		Success: true,
+		Message: "Admin password reset successfully",
+		Admin:   convertAdminToProtobuf(admin),
+	}, nil
+}
+
+// convertAdminToProtobuf converts an admin model to protobuf, leaving out secrets
+func convertAdminToProtobuf(admin *models.Admin) *authpb.AdminData {
+	return &authpb.AdminData{
+		Id:               admin.ID.String(),
+		Email:            admin.Email,
+		Role:             admin.Role,
+		IsActive:         admin.IsActive,
+		TwoFactorEnabled: admin.TOTPEnabled,
+		CreatedAt:        timestamppb.New(admin.CreatedAt),
+		UpdatedAt:        timestamppb.New(admin.UpdatedAt),
+	}
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/auth/v1"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/helpers"
+)
+
+func (h *AuthHandler) ListAuthEvents(ctx context.Context, req *authpb.ListAuthEventsRequest) (*authpb.ListAuthEventsResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	h.logger.Info("Received list auth events request", "userID", userID)
+
+	limit, offset := authEventsPage(req.Limit, req.Offset)
+	events, total, err := h.authEventService.ListUserEvents(ctx, userID, limit, offset)
+	if err != nil {
+		h.logger.Error("List auth events failed", "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return newListAuthEventsResponse(events, total, limit, offset), nil
+}
+
+func (h *AuthHandler) GetUserAuthEvents(ctx context.Context, req *authpb.GetUserAuthEventsRequest) (*authpb.ListAuthEventsResponse, error) {
+	actorID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	h.logger.Info("Received get user auth events request", "actorID", actorID, "userID", req.UserId)
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required")
+	}
+
+	limit, offset := authEventsPage(req.Limit, req.Offset)
+	events, total, err := h.authEventService.GetUserEvents(ctx, actorID, repositories.ListAuthEventsParams{
+		AccountID: req.UserId,
+		EventType: req.EventType,
+		Outcome:   req.Outcome,
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		h.logger.Error("Get user auth events failed", "actorID", actorID, "userID", req.UserId, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return newListAuthEventsResponse(events, total, limit, offset), nil
+}
+
+// authEventsPage applies the default page size and bounds to a list request
+func authEventsPage(reqLimit, reqOffset int32) (int, int) {
+	limit := int(reqLimit)
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	offset := int(reqOffset)
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+func newListAuthEventsResponse(events []*models.AuthEvent, total int64, limit, offset int) *authpb.ListAuthEventsResponse {
+	eventDataList := make([]*authpb.AuthEventData, len(events))
+	for i, event := range events {
+		eventDataList[i] = &authpb.AuthEventData{
+			Id:         event.ID,
+			EventType:  event.EventType,
+			Identifier: event.Identifier,
+			IpAddress:  event.IPAddress,
+			UserAgent:  event.UserAgent,
+			Outcome:    event.Outcome,
+			Reason:     event.Reason,
+			CreatedAt:  timestamppb.New(event.CreatedAt),
+		}
+	}
+
+	return &authpb.ListAuthEventsResponse{
+		Success: true,
+		Message: "Auth events retrieved successfully",
+		Events:  eventDataList,
+		Pagination: &authpb.PaginationData{
+			Total:       int32(total),
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			HasMore:     int64(offset+limit) < total,
+			TotalPages:  int32((total + int64(limit) - 1) / int64(limit)),
+			CurrentPage: int32((offset / limit) + 1),
+		},
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/google/uuid"
 	authpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/auth/v1"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/models"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/repositories"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/services"
@@ -20,20 +22,47 @@ import (
 
 type AuthHandler struct {
 	authpb.UnimplementedAuthServiceServer
-	registrationService *services.RegistrationService
-	authService         *services.AuthService
-	logger              logging.Logger
+	registrationService  *services.RegistrationService
+	authService          *services.AuthService
+	passwordService      *services.PasswordService
+	sessionService       *services.SessionService
+	phoneService         *services.PhoneService
+	adminService         *services.AdminService
+	moderationService    *services.ModerationService
+	authEventService     *services.AuthEventService
+	loginAlertService    *services.LoginAlertService
+	contactChangeService *services.ContactChangeService
+	oidcService          *services.OIDCService
+	logger               logging.Logger
 }
 
 func NewAuthHandler(
 	registrationService *services.RegistrationService,
 	authService *services.AuthService,
+	passwordService *services.PasswordService,
+	sessionService *services.SessionService,
+	phoneService *services.PhoneService,
+	adminService *services.AdminService,
+	moderationService *services.ModerationService,
+	authEventService *services.AuthEventService,
+	loginAlertService *services.LoginAlertService,
+	contactChangeService *services.ContactChangeService,
+	oidcService *services.OIDCService,
 	logger logging.Logger,
 ) *AuthHandler {
 	return &AuthHandler{
-		registrationService: registrationService,
-		authService:         authService,
-		logger:              logger,
+		registrationService:  registrationService,
+		authService:          authService,
+		passwordService:      passwordService,
+		sessionService:       sessionService,
+		phoneService:         phoneService,
+		adminService:         adminService,
+		moderationService:    moderationService,
+		authEventService:     authEventService,
+		loginAlertService:    loginAlertService,
+		contactChangeService: contactChangeService,
+		oidcService:          oidcService,
+		logger:               logger,
 	}
 }
 
@@ -63,15 +92,9 @@ func (h *AuthHandler) Register(ctx context.Context, req *authpb.RegisterRequest)
 func (h *AuthHandler) Verify(ctx context.Context, req *authpb.VerifyRequest) (*authpb.VerifyResponse, error) {
 	h.logger.Info("Received verification request", "email", req.Email)
 
-	err := h.registrationService.VerifyRegistration(ctx, req.Email, req.Otp)
+	err := h.registrationService.VerifyRegistration(ctx, req.Email, req.Otp, req.PhoneOtp)
 	if err != nil {
 		h.logger.Error("Verification failed", "error", err)
-
-		// Handle specific case for pending registration not found
-		if strings.Contains(err.Error(), "no pending registration found") {
-			return nil, status.Error(codes.NotFound, "No pending registration found")
-		}
-
 		return nil, helpers.MapErrorToGRPCStatus(err)
 	}
 
@@ -83,6 +106,25 @@ func (h *AuthHandler) Verify(ctx context.Context, req *authpb.VerifyRequest) (*a
 	}, nil
 }
 
+func (h *AuthHandler) ResendOTP(ctx context.Context, req *authpb.ResendOTPRequest) (*authpb.ResendOTPResponse, error) {
+	h.logger.Info("Received resend OTP request", "email", req.Email)
+
+	if req.Email == "" {
+		h.logger.Debug("Invalid resend OTP request - missing email")
+		return nil, status.Error(codes.InvalidArgument, "Email is required")
+	}
+
+	if err := h.registrationService.ResendOTP(ctx, req.Email); err != nil {
+		h.logger.Error("Resend OTP failed", "email", req.Email, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ResendOTPResponse{
+		Success: true,
+		Message: "A new OTP has been sent to your email",
+	}, nil
+}
+
 func (h *AuthHandler) Login(ctx context.Context, req *authpb.LoginRequest) (*authpb.LoginResponse, error) {
 	h.logger.Info("Received login request", "email", req.Email)
 
@@ -92,7 +134,7 @@ func (h *AuthHandler) Login(ctx context.Context, req *authpb.LoginRequest) (*aut
 		return nil, helpers.MapErrorToGRPCStatus(err)
 	}
 
-	tokenPair, err := h.authService.Login(ctx, req.Email, req.Password)
+	tokenPair, err := h.authService.Login(ctx, req.Email, req.Password, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx))
 	if err != nil {
 		h.logger.Error("Login failed", "email", req.Email, "error", err)
 		return nil, helpers.MapErrorToGRPCStatus(err)
@@ -117,7 +159,7 @@ func (h *AuthHandler) Logout(ctx context.Context, req *authpb.LogoutRequest) (*a
 		return nil, status.Error(codes.InvalidArgument, "Access token is required")
 	}
 
-	err := h.authService.Logout(ctx, req.AccessToken)
+	err := h.authService.Logout(ctx, req.AccessToken, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx))
 	if err != nil {
 		h.logger.Error("Logout failed", "error", err)
 		return nil, helpers.MapErrorToGRPCStatus(err)
@@ -131,6 +173,20 @@ func (h *AuthHandler) Logout(ctx context.Context, req *authpb.LogoutRequest) (*a
 	}, nil
 }
 
+// CheckTokenRevocation lets services without access to the token store reject revoked access tokens
+func (h *AuthHandler) CheckTokenRevocation(ctx context.Context, req *authpb.CheckTokenRevocationRequest) (*authpb.CheckTokenRevocationResponse, error) {
+	if req.AccessToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "Access token is required")
+	}
+
+	revoked, err := h.authService.IsAccessTokenRevoked(ctx, req.AccessToken)
+	if err != nil {
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.CheckTokenRevocationResponse{Revoked: revoked}, nil
+}
+
 func (h *AuthHandler) RefreshToken(ctx context.Context, req *authpb.RefreshTokenRequest) (*authpb.RefreshTokenResponse, error) {
 	h.logger.Info("Received token refresh request")
 
@@ -154,7 +210,7 @@ func (h *AuthHandler) RefreshToken(ctx context.Context, req *authpb.RefreshToken
 
 	refreshToken := strings.TrimPrefix(authHeader, "Bearer ")
 
-	tokenPair, err := h.authService.RefreshToken(ctx, refreshToken)
+	tokenPair, err := h.authService.RefreshToken(ctx, refreshToken, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx))
 	if err != nil {
 		h.logger.Error("Refresh failed", "error", err)
 		return nil, helpers.MapErrorToGRPCStatus(err)
@@ -180,12 +236,28 @@ func (h *AuthHandler) AdminLogin(ctx context.Context, req *authpb.LoginRequest)
 		return nil, helpers.MapErrorToGRPCStatus(err)
 	}
 
-	tokenPair, err := h.authService.AdminLogin(ctx, req.Email, req.Password)
+	tokenPair, challenge, err := h.authService.AdminLogin(ctx, req.Email, req.Password, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx))
 	if err != nil {
 		h.logger.Error("Admin login failed", "email", req.Email, "error", err)
 		return nil, helpers.MapErrorToGRPCStatus(err)
 	}
 
+	if challenge != nil {
+		message := "Two-factor code required"
+		if challenge.EnrollmentRequired {
+			message = "Two-factor enrollment required"
+		}
+
+		return &authpb.LoginResponse{
+			Success:                     true,
+			ExpiresIn:                   challenge.ExpiresIn,
+			Message:                     message,
+			TwoFactorRequired:           true,
+			TwoFactorToken:              challenge.Token,
+			TwoFactorEnrollmentRequired: challenge.EnrollmentRequired,
+		}, nil
+	}
+
 	h.logger.Info("Admin login successful", "email", req.Email)
 
 	return &authpb.LoginResponse{
@@ -197,6 +269,54 @@ func (h *AuthHandler) AdminLogin(ctx context.Context, req *authpb.LoginRequest)
 	}, nil
 }
 
+func (h *AuthHandler) AdminEnroll2FA(ctx context.Context, req *authpb.AdminEnroll2FARequest) (*authpb.AdminEnroll2FAResponse, error) {
+	h.logger.Info("Received admin 2FA enrollment request")
+
+	if req.TwoFactorToken == "" {
+		h.logger.Debug("Invalid 2FA enrollment request - missing challenge token")
+		return nil, status.Error(codes.InvalidArgument, "Two-factor token is required")
+	}
+
+	enrollment, err := h.authService.AdminEnroll2FA(ctx, req.TwoFactorToken)
+	if err != nil {
+		h.logger.Error("Admin 2FA enrollment failed", "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.AdminEnroll2FAResponse{
+		Success:         true,
+		Message:         "Scan the code with your authenticator app and verify it to finish enrollment",
+		Secret:          enrollment.Secret,
+		ProvisioningUri: enrollment.ProvisioningURI,
+		RecoveryCodes:   enrollment.RecoveryCodes,
+	}, nil
+}
+
+func (h *AuthHandler) AdminVerify2FA(ctx context.Context, req *authpb.AdminVerify2FARequest) (*authpb.LoginResponse, error) {
+	h.logger.Info("Received admin 2FA verification request")
+
+	if req.TwoFactorToken == "" || req.Code == "" {
+		h.logger.Debug("Invalid 2FA verification request - missing required fields")
+		return nil, status.Error(codes.InvalidArgument, "Two-factor token and code are required")
+	}
+
+	tokenPair, err := h.authService.AdminVerify2FA(ctx, req.TwoFactorToken, req.Code, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx))
+	if err != nil {
+		h.logger.Error("Admin 2FA verification failed", "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	h.logger.Info("Admin login successful after two-factor verification")
+
+	return &authpb.LoginResponse{
+		Success:      true,
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		ExpiresIn:    tokenPair.ExpiresIn,
+		Message:      "Admin login successful",
+	}, nil
+}
+
 func (h *AuthHandler) Delete(ctx context.Context, req *authpb.DeleteRequest) (*authpb.DeleteResponse, error) {
 	h.logger.Info("Received delete account request")
 
@@ -219,17 +339,343 @@ func (h *AuthHandler) Delete(ctx context.Context, req *authpb.DeleteRequest) (*a
 		return nil, status.Error(codes.InvalidArgument, "Password is required")
 	}
 
-	err := h.authService.Delete(ctx, userID, req.Password)
+	deletion, err := h.authService.Delete(ctx, userID, req.Password)
 	if err != nil {
 		h.logger.Error("Delete failed", "userID", userID, "error", err)
 		return nil, helpers.MapErrorToGRPCStatus(err)
 	}
 
-	h.logger.Info("User account deleted successfully", "userID", userID)
+	h.logger.Info("User account scheduled for deletion", "userID", userID)
 
 	return &authpb.DeleteResponse{
+		Success:    true,
+		Message:    "Account scheduled for deletion, log in through account restore before the purge date to keep it",
+		PurgeAfter: timestamppb.New(deletion.PurgeAfter),
+	}, nil
+}
+
+func (h *AuthHandler) RestoreAccount(ctx context.Context, req *authpb.LoginRequest) (*authpb.LoginResponse, error) {
+	h.logger.Info("Received restore account request", "email", req.Email)
+
+	if err := helpers.ValidateLoginInput(req.Email, req.Password); err != nil {
+		h.logger.Debug("Invalid restore account request - missing required fields")
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	tokenPair, err := h.authService.RestoreAccount(ctx, req.Email, req.Password, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx))
+	if err != nil {
+		h.logger.Error("Restore account failed", "email", req.Email, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	h.logger.Info("Account restored", "email", req.Email)
+
+	return &authpb.LoginResponse{
+		Success:      true,
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		ExpiresIn:    tokenPair.ExpiresIn,
+		Message:      "Account restored successfully",
+	}, nil
+}
+
+func (h *AuthHandler) DeactivateAccount(ctx context.Context, req *authpb.DeactivateAccountRequest) (*authpb.DeactivateAccountResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	if req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "Password is required")
+	}
+
+	if err := h.authService.DeactivateAccount(ctx, userID, req.Password); err != nil {
+		h.logger.Error("Deactivate account failed", "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.DeactivateAccountResponse{
+		Success: true,
+		Message: "Account deactivated. Log in again or reactivate it to show your profile again",
+	}, nil
+}
+
+func (h *AuthHandler) ReactivateAccount(ctx context.Context, req *authpb.ReactivateAccountRequest) (*authpb.ReactivateAccountResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	if err := h.authService.ReactivateAccount(ctx, userID); err != nil {
+		h.logger.Error("Reactivate account failed", "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ReactivateAccountResponse{
+		Success: true,
+		Message: "Account reactivated successfully",
+	}, nil
+}
+
+func (h *AuthHandler) ForgotPassword(ctx context.Context, req *authpb.ForgotPasswordRequest) (*authpb.ForgotPasswordResponse, error) {
+	h.logger.Info("Received forgot password request", "email", req.Email)
+
+	if req.Email == "" {
+		h.logger.Debug("Invalid forgot password request - missing email")
+		return nil, status.Error(codes.InvalidArgument, "Email is required")
+	}
+
+	err := h.passwordService.ForgotPassword(ctx, req.Email)
+	if err != nil {
+		h.logger.Error("Forgot password failed", "email", req.Email, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ForgotPasswordResponse{
+		Success: true,
+		Message: "If the email is registered, a password reset code has been sent",
+	}, nil
+}
+
+func (h *AuthHandler) ResetPassword(ctx context.Context, req *authpb.ResetPasswordRequest) (*authpb.ResetPasswordResponse, error) {
+	h.logger.Info("Received reset password request", "email", req.Email)
+
+	if req.Email == "" || req.Otp == "" || req.NewPassword == "" {
+		h.logger.Debug("Invalid reset password request - missing required fields")
+		return nil, status.Error(codes.InvalidArgument, "Email, OTP and new password are required")
+	}
+
+	err := h.passwordService.ResetPassword(ctx, req.Email, req.Otp, req.NewPassword, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx))
+	if err != nil {
+		h.logger.Error("Reset password failed", "email", req.Email, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	h.logger.Info("Password reset successful", "email", req.Email)
+
+	return &authpb.ResetPasswordResponse{
+		Success: true,
+		Message: "Password reset successfully",
+	}, nil
+}
+
+func (h *AuthHandler) ReportUnrecognizedLogin(ctx context.Context, req *authpb.ReportUnrecognizedLoginRequest) (*authpb.ReportUnrecognizedLoginResponse, error) {
+	h.logger.Info("Received unrecognized login report")
+
+	if req.Token == "" {
+		h.logger.Debug("Invalid login report - missing token")
+		return nil, status.Error(codes.InvalidArgument, "Token is required")
+	}
+
+	if err := h.loginAlertService.ReportLogin(ctx, req.Token, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx)); err != nil {
+		h.logger.Error("Login report failed", "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ReportUnrecognizedLoginResponse{
+		Success: true,
+		Message: "All devices have been signed out. Use the code sent to your email to set a new password.",
+	}, nil
+}
+
+func (h *AuthHandler) ListSessions(ctx context.Context, req *authpb.ListSessionsRequest) (*authpb.ListSessionsResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	h.logger.Info("Received list sessions request", "userID", userID)
+
+	sessions, err := h.sessionService.ListSessions(ctx, userID)
+	if err != nil {
+		h.logger.Error("List sessions failed", "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	currentSessionID := helpers.GetMetadataValue(ctx, constants.SessionIDHeader)
+	sessionDataList := make([]*authpb.SessionData, len(sessions))
+	for i, session := range sessions {
+		sessionDataList[i] = &authpb.SessionData{
+			Id:         session.ID,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IPAddress,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastUsedAt: timestamppb.New(session.LastUsedAt),
+			Current:    session.ID == currentSessionID,
+		}
+	}
+
+	return &authpb.ListSessionsResponse{
+		Success:  true,
+		Message:  "Sessions retrieved successfully",
+		Sessions: sessionDataList,
+	}, nil
+}
+
+func (h *AuthHandler) RevokeSession(ctx context.Context, req *authpb.RevokeSessionRequest) (*authpb.RevokeSessionResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	h.logger.Info("Received revoke session request", "userID", userID, "sessionID", req.SessionId)
+
+	if req.SessionId == "" {
+		h.logger.Debug("Invalid revoke session request - missing session ID")
+		return nil, status.Error(codes.InvalidArgument, "Session ID is required")
+	}
+
+	if err := h.sessionService.RevokeSession(ctx, userID, req.SessionId); err != nil {
+		h.logger.Error("Revoke session failed", "userID", userID, "sessionID", req.SessionId, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.RevokeSessionResponse{
+		Success: true,
+		Message: "Session revoked successfully",
+	}, nil
+}
+
+func (h *AuthHandler) RevokeAllOtherSessions(ctx context.Context, req *authpb.RevokeAllOtherSessionsRequest) (*authpb.RevokeAllOtherSessionsResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	currentSessionID := helpers.GetMetadataValue(ctx, constants.SessionIDHeader)
+	if currentSessionID == "" {
+		h.logger.Debug("Session ID missing from metadata", "userID", userID)
+		return nil, status.Error(codes.InvalidArgument, "Current session is unknown, please log in again")
+	}
+
+	h.logger.Info("Received revoke other sessions request", "userID", userID, "sessionID", currentSessionID)
+
+	revoked, err := h.sessionService.RevokeAllOtherSessions(ctx, userID, currentSessionID)
+	if err != nil {
+		h.logger.Error("Revoke other sessions failed", "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.RevokeAllOtherSessionsResponse{
+		Success:      true,
+		Message:      "Other sessions revoked successfully",
+		RevokedCount: int32(revoked),
+	}, nil
+}
+
+func (h *AuthHandler) SendPhoneVerificationOTP(ctx context.Context, req *authpb.SendPhoneVerificationOTPRequest) (*authpb.SendPhoneVerificationOTPResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	h.logger.Info("Received phone verification OTP request", "userID", userID)
+
+	if err := h.phoneService.SendVerificationOTP(ctx, userID); err != nil {
+		h.logger.Error("Send phone verification OTP failed", "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.SendPhoneVerificationOTPResponse{
+		Success: true,
+		Message: "OTP sent to registered phone number",
+	}, nil
+}
+
+func (h *AuthHandler) VerifyPhone(ctx context.Context, req *authpb.VerifyPhoneRequest) (*authpb.VerifyPhoneResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	h.logger.Info("Received verify phone request", "userID", userID)
+
+	if req.Otp == "" {
+		h.logger.Debug("Invalid verify phone request - missing OTP")
+		return nil, status.Error(codes.InvalidArgument, "OTP is required")
+	}
+
+	if err := h.phoneService.VerifyPhone(ctx, userID, req.Otp); err != nil {
+		h.logger.Error("Verify phone failed", "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.VerifyPhoneResponse{
 		Success: true,
-		Message: "Account deleted successfully",
+		Message: "Phone number verified successfully",
+	}, nil
+}
+
+func (h *AuthHandler) RequestLoginOTP(ctx context.Context, req *authpb.RequestLoginOTPRequest) (*authpb.RequestLoginOTPResponse, error) {
+	h.logger.Info("Received login OTP request", "phone", req.Phone)
+
+	if req.Phone == "" {
+		h.logger.Debug("Invalid login OTP request - missing phone")
+		return nil, status.Error(codes.InvalidArgument, "Phone number is required")
+	}
+
+	if err := h.phoneService.SendLoginOTP(ctx, req.Phone); err != nil {
+		h.logger.Error("Send login OTP failed", "phone", req.Phone, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.RequestLoginOTPResponse{
+		Success: true,
+		Message: "If the phone number is registered and verified, a login code has been sent",
+	}, nil
+}
+
+func (h *AuthHandler) LoginWithOTP(ctx context.Context, req *authpb.LoginWithOTPRequest) (*authpb.LoginResponse, error) {
+	h.logger.Info("Received OTP login request", "phone", req.Phone)
+
+	if req.Phone == "" || req.Otp == "" {
+		h.logger.Debug("Invalid OTP login request - missing required fields")
+		return nil, status.Error(codes.InvalidArgument, "Phone number and OTP are required")
+	}
+
+	tokenPair, err := h.authService.LoginWithPhoneOTP(ctx, req.Phone, req.Otp, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx))
+	if err != nil {
+		h.logger.Error("OTP login failed", "phone", req.Phone, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	h.logger.Info("OTP login successful", "phone", req.Phone)
+
+	return &authpb.LoginResponse{
+		Success:      true,
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		ExpiresIn:    tokenPair.ExpiresIn,
+		Message:      "Login successful",
+	}, nil
+}
+
+func (h *AuthHandler) GetJWKS(ctx context.Context, req *authpb.GetJWKSRequest) (*authpb.GetJWKSResponse, error) {
+	jwks := h.authService.GetJWKS()
+
+	keys := make([]*authpb.JSONWebKey, len(jwks.Keys))
+	for i, key := range jwks.Keys {
+		keys[i] = &authpb.JSONWebKey{
+			Kty: key.KeyType,
+			Kid: key.KeyID,
+			Use: key.Use,
+			Alg: key.Algorithm,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Curve,
+			X:   key.X,
+		}
+	}
+
+	return &authpb.GetJWKSResponse{
+		Keys: keys,
 	}, nil
 }
 
@@ -377,14 +823,16 @@ func (h *AuthHandler) GetUser(ctx context.Context, req *authpb.GetUserRequest) (
 // Helper method to convert user model to protobuf (reuse existing conversion pattern)
 func (h *AuthHandler) convertUserToProtobuf(user *models.User) *authpb.UserData {
 	userData := &authpb.UserData{
-		Id:        user.ID.String(),
-		Email:     user.Email,
-		Phone:     user.Phone,
-		Verified:  user.Verified,
-		IsActive:  user.IsActive,
-		IsPremium: user.IsPremium(),
-		CreatedAt: timestamppb.New(user.CreatedAt),
-		UpdatedAt: timestamppb.New(user.UpdatedAt),
+		Id:            user.ID.String(),
+		Email:         user.Email,
+		Phone:         user.Phone,
+		Verified:      user.Verified,
+		PhoneVerified: user.PhoneVerified,
+		IsActive:      user.IsActive,
+		IsDeactivated: user.IsDeactivated(),
+		IsPremium:     user.IsPremium(),
+		CreatedAt:     timestamppb.New(user.CreatedAt),
+		UpdatedAt:     timestamppb.New(user.UpdatedAt),
 	}
 
 	if user.PremiumUntil != nil {
@@ -395,6 +843,15 @@ func (h *AuthHandler) convertUserToProtobuf(user *models.User) *authpb.UserData
 		userData.LastLoginAt = timestamppb.New(*user.LastLoginAt)
 	}
 
+	if user.IsBanned() || user.IsSuspended(indianstandardtime.Now()) {
+		userData.IsBanned = user.IsBanned()
+		userData.IsSuspended = !user.IsBanned()
+		userData.SuspensionReason = user.SuspensionReason
+		if user.SuspendedUntil != nil {
+			userData.SuspendedUntil = timestamppb.New(*user.SuspendedUntil)
+		}
+	}
+
 	return userData
 }
 
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/auth/v1"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/helpers"
+)
+
+func (h *AuthHandler) RequestEmailChange(ctx context.Context, req *authpb.RequestEmailChangeRequest) (*authpb.ContactChangeResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	h.logger.Info("Received email change request", "userID", userID)
+
+	if req.NewEmail == "" || req.Password == "" {
+		h.logger.Debug("Invalid email change request - missing required fields")
+		return nil, status.Error(codes.InvalidArgument, "New email and password are required")
+	}
+
+	if err := h.contactChangeService.RequestEmailChange(ctx, userID, req.NewEmail, req.Password); err != nil {
+		h.logger.Error("Email change request failed", "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ContactChangeResponse{
+		Success: true,
+		Message: "A verification code has been sent to the new email address",
+	}, nil
+}
+
+func (h *AuthHandler) ConfirmEmailChange(ctx context.Context, req *authpb.ConfirmContactChangeRequest) (*authpb.ContactChangeResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	h.logger.Info("Received confirm email change request", "userID", userID)
+
+	if req.Otp == "" {
+		h.logger.Debug("Invalid confirm email change request - missing OTP")
+		return nil, status.Error(codes.InvalidArgument, "OTP is required")
+	}
+
+	if err := h.contactChangeService.ConfirmEmailChange(ctx, userID, req.Otp, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx)); err != nil {
+		h.logger.Error("Confirm email change failed", "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ContactChangeResponse{
+		Success: true,
+		Message: "Email address changed successfully",
+	}, nil
+}
+
+func (h *AuthHandler) RequestPhoneChange(ctx context.Context, req *authpb.RequestPhoneChangeRequest) (*authpb.ContactChangeResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	h.logger.Info("Received phone change request", "userID", userID)
+
+	if req.NewPhone == "" || req.Password == "" {
+		h.logger.Debug("Invalid phone change request - missing required fields")
+		return nil, status.Error(codes.InvalidArgument, "New phone number and password are required")
+	}
+
+	if err := h.contactChangeService.RequestPhoneChange(ctx, userID, req.NewPhone, req.Password); err != nil {
+		h.logger.Error("Phone change request failed", "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ContactChangeResponse{
+		Success: true,
+		Message: "A verification code has been sent to the new phone number",
+	}, nil
+}
+
+func (h *AuthHandler) ConfirmPhoneChange(ctx context.Context, req *authpb.ConfirmContactChangeRequest) (*authpb.ContactChangeResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	h.logger.Info("Received confirm phone change request", "userID", userID)
+
+	if req.Otp == "" {
+		h.logger.Debug("Invalid confirm phone change request - missing OTP")
+		return nil, status.Error(codes.InvalidArgument, "OTP is required")
+	}
+
+	if err := h.contactChangeService.ConfirmPhoneChange(ctx, userID, req.Otp, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx)); err != nil {
+		h.logger.Error("Confirm phone change failed", "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ContactChangeResponse{
+		Success: true,
+		Message: "Phone number changed successfully",
+	}, nil
+}
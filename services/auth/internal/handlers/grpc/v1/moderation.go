@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/auth/v1"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/helpers"
+)
+
+// Account statuses reported by GetAccountStatus
+const (
+	accountStatusActive      = "active"
+	accountStatusDeactivated = "deactivated"
+	accountStatusSuspended   = "suspended"
+	accountStatusBanned      = "banned"
+)
+
+func (h *AuthHandler) SuspendUser(ctx context.Context, req *authpb.SuspendUserRequest) (*authpb.ModerateUserResponse, error) {
+	actorID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	h.logger.Info("Received suspend user request", "actorID", actorID, "userID", req.UserId)
+
+	if req.UserId == "" || req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID and reason are required")
+	}
+
+	var until *time.Time
+	if req.SuspendedUntil != nil {
+		t := req.SuspendedUntil.AsTime()
+		until = &t
+	}
+
+	user, err := h.moderationService.SuspendUser(ctx, actorID, req.UserId, req.Reason, until)
+	if err != nil {
+		h.logger.Error("Suspend user failed", "actorID", actorID, "userID", req.UserId, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ModerateUserResponse{
+		Success: true,
+		Message: "User suspended successfully",
+		User:    h.convertUserToProtobuf(user),
+	}, nil
+}
+
+func (h *AuthHandler) BanUser(ctx context.Context, req *authpb.BanUserRequest) (*authpb.ModerateUserResponse, error) {
+	actorID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	h.logger.Info("Received ban user request", "actorID", actorID, "userID", req.UserId)
+
+	if req.UserId == "" || req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID and reason are required")
+	}
+
+	user, err := h.moderationService.BanUser(ctx, actorID, req.UserId, req.Reason)
+	if err != nil {
+		h.logger.Error("Ban user failed", "actorID", actorID, "userID", req.UserId, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ModerateUserResponse{
+		Success: true,
+		Message: "User banned successfully",
+		User:    h.convertUserToProtobuf(user),
+	}, nil
+}
+
+func (h *AuthHandler) ReinstateUser(ctx context.Context, req *authpb.ReinstateUserRequest) (*authpb.ModerateUserResponse, error) {
+	actorID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	h.logger.Info("Received reinstate user request", "actorID", actorID, "userID", req.UserId)
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required")
+	}
+
+	user, err := h.moderationService.ReinstateUser(ctx, actorID, req.UserId)
+	if err != nil {
+		h.logger.Error("Reinstate user failed", "actorID", actorID, "userID", req.UserId, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ModerateUserResponse{
+		Success: true,
+		Message: "User reinstated successfully",
+		User:    h.convertUserToProtobuf(user),
+	}, nil
+}
+
+func (h *AuthHandler) GrantPremium(ctx context.Context, req *authpb.GrantPremiumRequest) (*authpb.ModerateUserResponse, error) {
+	actorID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	h.logger.Info("Received grant premium request", "actorID", actorID, "userID", req.UserId)
+
+	if req.UserId == "" || req.PremiumUntil == nil {
+		return nil, status.Error(codes.InvalidArgument, "User ID and premium end date are required")
+	}
+
+	user, err := h.moderationService.GrantPremium(ctx, actorID, req.UserId, req.PremiumUntil.AsTime())
+	if err != nil {
+		h.logger.Error("Grant premium failed", "actorID", actorID, "userID", req.UserId, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.ModerateUserResponse{
+		Success: true,
+		Message: "Premium granted successfully",
+		User:    h.convertUserToProtobuf(user),
+	}, nil
+}
+
+func (h *AuthHandler) GetAccountStatus(ctx context.Context, req *authpb.GetAccountStatusRequest) (*authpb.GetAccountStatusResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required")
+	}
+
+	user, err := h.moderationService.GetUserStatus(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Get account status failed", "userID", req.UserId, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	resp := &authpb.GetAccountStatusResponse{Status: accountStatusActive}
+	switch {
+	case user.IsBanned():
+		resp.Status = accountStatusBanned
+		resp.Reason = user.SuspensionReason
+	case user.IsSuspended(indianstandardtime.Now()):
+		resp.Status = accountStatusSuspended
+		resp.Reason = user.SuspensionReason
+		if user.SuspendedUntil != nil {
+			resp.SuspendedUntil = timestamppb.New(*user.SuspendedUntil)
+		}
+	case user.IsDeactivated():
+		resp.Status = accountStatusDeactivated
+	}
+
+	return resp, nil
+}
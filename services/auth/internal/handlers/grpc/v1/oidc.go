@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/auth/v1"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/helpers"
+)
+
+func (h *AuthHandler) StartOIDCLogin(ctx context.Context, req *authpb.StartOIDCLoginRequest) (*authpb.StartOIDCLoginResponse, error) {
+	h.logger.Info("Received OIDC login start request", "provider", req.Provider)
+
+	if req.Provider == "" {
+		h.logger.Debug("Invalid OIDC login start request - missing provider")
+		return nil, status.Error(codes.InvalidArgument, "Provider is required")
+	}
+
+	authURL, err := h.oidcService.StartLogin(ctx, req.Provider)
+	if err != nil {
+		h.logger.Error("OIDC login start failed", "provider", req.Provider, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.StartOIDCLoginResponse{
+		Success:          true,
+		AuthorizationUrl: authURL,
+		Message:          "Continue signing in with the provider",
+	}, nil
+}
+
+func (h *AuthHandler) StartOIDCLink(ctx context.Context, req *authpb.StartOIDCLoginRequest) (*authpb.StartOIDCLoginResponse, error) {
+	userID := helpers.GetMetadataValue(ctx, constants.UserIDHeader)
+	if userID == "" {
+		h.logger.Debug("User ID missing from metadata")
+		return nil, status.Error(codes.Unauthenticated, "Authentication required")
+	}
+
+	h.logger.Info("Received OIDC link start request", "provider", req.Provider, "userID", userID)
+
+	if req.Provider == "" {
+		h.logger.Debug("Invalid OIDC link start request - missing provider")
+		return nil, status.Error(codes.InvalidArgument, "Provider is required")
+	}
+
+	authURL, err := h.oidcService.StartLink(ctx, req.Provider, userID)
+	if err != nil {
+		h.logger.Error("OIDC link start failed", "provider", req.Provider, "userID", userID, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	return &authpb.StartOIDCLoginResponse{
+		Success:          true,
+		AuthorizationUrl: authURL,
+		Message:          "Continue with the provider to link your account",
+	}, nil
+}
+
+func (h *AuthHandler) CompleteOIDCLogin(ctx context.Context, req *authpb.CompleteOIDCLoginRequest) (*authpb.CompleteOIDCLoginResponse, error) {
+	h.logger.Info("Received OIDC login callback", "provider", req.Provider)
+
+	if req.Provider == "" || req.State == "" || req.Code == "" {
+		h.logger.Debug("Invalid OIDC login callback - missing required fields")
+		return nil, status.Error(codes.InvalidArgument, "Provider, state and code are required")
+	}
+
+	tokenPair, newUser, err := h.oidcService.CompleteLogin(ctx, req.Provider, req.State, req.Code, helpers.GetClientIP(ctx), helpers.GetUserAgent(ctx))
+	if err != nil {
+		h.logger.Error("OIDC login failed", "provider", req.Provider, "error", err)
+		return nil, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	h.logger.Info("OIDC login successful", "provider", req.Provider, "newUser", newUser)
+
+	return &authpb.CompleteOIDCLoginResponse{
+		Success:      true,
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		ExpiresIn:    tokenPair.ExpiresIn,
+		NewUser:      newUser,
+		Message:      "Login successful",
+	}, nil
+}
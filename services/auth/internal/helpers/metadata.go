@@ -0,0 +1,48 @@
+package helpers
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
+)
+
+// GetClientIP returns the end user's IP address forwarded by the gateway.
+// It falls back to the address of the gRPC peer when the header is missing.
+func GetClientIP(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(constants.ClientIPHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+
+	return ""
+}
+
+// GetUserAgent returns the end user's User-Agent forwarded by the gateway
+func GetUserAgent(ctx context.Context) string {
+	return GetMetadataValue(ctx, constants.UserAgentHeader)
+}
+
+// GetMetadataValue returns the first value of the incoming metadata key, or an empty string
+func GetMetadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -30,18 +30,82 @@ func MapErrorToGRPCStatus(err error) error {
 		return status.Error(codes.Internal, "Registration failed")
 	case autherrors.ErrVerificationFailed:
 		return status.Error(codes.Internal, "Verification failed")
+	case autherrors.ErrWeakPassword:
+		return status.Error(codes.InvalidArgument, "Password does not meet requirements")
+	case autherrors.ErrAccountLocked:
+		return status.Error(codes.ResourceExhausted, "Too many failed login attempts, please try again later")
+	case autherrors.ErrPhoneAlreadyVerified:
+		return status.Error(codes.FailedPrecondition, "Phone number is already verified")
+	case autherrors.ErrPhoneNotSet:
+		return status.Error(codes.FailedPrecondition, "Add a phone number to your account first")
+	case autherrors.ErrAccountSuspended:
+		return status.Error(codes.PermissionDenied, "Account is suspended")
+	case autherrors.ErrAccountBanned:
+		return status.Error(codes.PermissionDenied, "Account has been banned")
+	case autherrors.ErrAlreadyDeactivated:
+		return status.Error(codes.FailedPrecondition, "Account is already deactivated")
+	case autherrors.ErrNotDeactivated:
+		return status.Error(codes.FailedPrecondition, "Account is not deactivated")
+	case autherrors.ErrPasswordResetRequired:
+		return status.Error(codes.FailedPrecondition, "Password reset required, use the code sent to your email to set a new password")
+	case autherrors.ErrPendingRegistrationNotFound:
+		return status.Error(codes.NotFound, "No pending registration found")
+
+	// Login alert errors
+	case autherrors.ErrInvalidLoginReport:
+		return status.Error(codes.InvalidArgument, "This link is invalid or has expired")
+
+	// OpenID Connect errors
+	case autherrors.ErrOIDCProviderNotFound:
+		return status.Error(codes.NotFound, "Sign-in provider not found")
+	case autherrors.ErrInvalidOIDCState:
+		return status.Error(codes.InvalidArgument, "Sign-in request is invalid or has expired, please try again")
+	case autherrors.ErrOIDCAuthenticationFailed:
+		return status.Error(codes.Unauthenticated, "Sign-in with the external provider failed")
+	case autherrors.ErrOIDCEmailNotVerified:
+		return status.Error(codes.FailedPrecondition, "The external account must have a verified email address")
+	case autherrors.ErrOIDCLinkRequired:
+		return status.Error(codes.FailedPrecondition, "An account with this email address already exists. Log in with your password and link the provider from your account settings")
+	case autherrors.ErrOIDCIdentityInUse:
+		return status.Error(codes.AlreadyExists, "This external account is already linked to another user")
+
+	// Contact change errors
+	case autherrors.ErrContactUnchanged:
+		return status.Error(codes.InvalidArgument, "New contact must be different from the current one")
+
+	// Account deletion errors
+	case autherrors.ErrDeletionAlreadyScheduled:
+		return status.Error(codes.FailedPrecondition, "Account is already scheduled for deletion")
+	case autherrors.ErrAccountPendingDeletion:
+		return status.Error(codes.FailedPrecondition, "Account is scheduled for deletion, restore it to log in")
+	case autherrors.ErrNoPendingDeletion:
+		return status.Error(codes.FailedPrecondition, "Account is not scheduled for deletion")
+	case autherrors.ErrPremiumNotExtended:
+		return status.Error(codes.InvalidArgument, "Premium end date must be in the future and later than the current one")
 
 	// Token errors
 	case autherrors.ErrInvalidToken:
 		return status.Error(codes.Unauthenticated, "Invalid or expired token")
 	case autherrors.ErrInvalidRefreshToken:
 		return status.Error(codes.Unauthenticated, "Invalid or expired refresh token")
+	case autherrors.ErrRefreshTokenReused:
+		return status.Error(codes.Unauthenticated, "Refresh token has already been used, please log in again")
+
+	// Session errors
+	case autherrors.ErrSessionNotFound:
+		return status.Error(codes.NotFound, "Session not found")
 
 	// OTP errors
 	case autherrors.ErrInvalidOTP:
 		return status.Error(codes.InvalidArgument, "Invalid or expired OTP")
 	case autherrors.ErrOTPGenerationFailed:
 		return status.Error(codes.Internal, "Failed to generate verification code")
+	case autherrors.ErrOTPResendTooSoon:
+		return status.Error(codes.ResourceExhausted, "Please wait before requesting a new code")
+	case autherrors.ErrOTPSendLimitReached:
+		return status.Error(codes.ResourceExhausted, "Too many codes requested today, please try again tomorrow")
+	case autherrors.ErrTooManyOTPAttempts:
+		return status.Error(codes.ResourceExhausted, "Too many incorrect codes, please request a new one")
 
 	// Admin errors
 	case autherrors.ErrAdminNotFound:
@@ -50,6 +114,28 @@ func MapErrorToGRPCStatus(err error) error {
 		return status.Error(codes.PermissionDenied, "Admin account is disabled")
 	case autherrors.ErrInvalidAdminInput:
 		return status.Error(codes.InvalidArgument, err.Error())
+	case autherrors.ErrAdminPermissionDenied:
+		return status.Error(codes.PermissionDenied, "Insufficient permissions")
+	case autherrors.ErrAdminAlreadyExists:
+		return status.Error(codes.AlreadyExists, "Admin with this email already exists")
+	case autherrors.ErrInvalidAdminRole:
+		return status.Error(codes.InvalidArgument, "Invalid admin role")
+	case autherrors.ErrAdminInviteNotFound:
+		return status.Error(codes.NotFound, "Invite is invalid or has expired")
+	case autherrors.ErrCannotModifySelf:
+		return status.Error(codes.FailedPrecondition, "You cannot deactivate your own account")
+	case autherrors.ErrInvalidSuspension:
+		return status.Error(codes.InvalidArgument, "A reason is required and the suspension must end in the future")
+	case autherrors.ErrUserAlreadyBanned:
+		return status.Error(codes.FailedPrecondition, "User is already banned")
+	case autherrors.ErrTwoFactorChallengeNotFound:
+		return status.Error(codes.Unauthenticated, "Two-factor session expired, please log in again")
+	case autherrors.ErrInvalidTwoFactorCode:
+		return status.Error(codes.Unauthenticated, "Invalid two-factor code")
+	case autherrors.ErrTwoFactorAlreadyEnabled:
+		return status.Error(codes.FailedPrecondition, "Two-factor authentication is already enabled")
+	case autherrors.ErrTwoFactorNotEnrolled:
+		return status.Error(codes.FailedPrecondition, "Two-factor enrollment has not been started")
 
 	// Default case
 	default:
@@ -14,15 +14,19 @@ import (
 
 	authpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/auth/v1"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/oidc"
 	pgdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/postgres"
 	redisdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/redis"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/messaging/rabbitmq"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/email"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/sms"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/otp"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/security/totp"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/adapters/postgres"
 	redisAdapter "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/adapters/redis"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/config"
+	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/constants"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/domain/services"
 	v1 "github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/handlers/grpc/v1"
 	"github.com/mohamedfawas/qubool-kallyanam/services/auth/internal/handlers/health"
@@ -36,6 +40,11 @@ type Server struct {
 	redisClient  *redisdb.Client
 	rabbitClient *rabbitmq.Client
 	authService  *services.AuthService
+
+	accountDeletionService *services.AccountDeletionService
+	premiumService         *services.PremiumService
+	registrationService    *services.RegistrationService
+	stopWorkers            context.CancelFunc
 }
 
 func NewServer(cfg *config.Config, logger logging.Logger) (*Server, error) {
@@ -74,7 +83,7 @@ func NewServer(cfg *config.Config, logger logging.Logger) (*Server, error) {
 		),
 	)
 
-	authService, err := registerServices(grpcServer,
+	authService, accountDeletionService, premiumService, registrationService, err := registerServices(grpcServer,
 		pgClient.DB,
 		redisClient,
 		cfg,
@@ -92,11 +101,18 @@ func NewServer(cfg *config.Config, logger logging.Logger) (*Server, error) {
 		redisClient:  redisClient,
 		rabbitClient: rabbitClient,
 		authService:  authService,
+
+		accountDeletionService: accountDeletionService,
+		premiumService:         premiumService,
+		registrationService:    registrationService,
 	}
 
 	if err := server.subscribeToSubscriptionEvents(); err != nil {
 		return nil, fmt.Errorf("failed to subscribe to events: %w", err)
 	}
+	if err := server.subscribeToPurgeEvents(); err != nil {
+		return nil, fmt.Errorf("failed to subscribe to events: %w", err)
+	}
 
 	return server, nil
 }
@@ -108,15 +124,22 @@ func registerServices(
 	cfg *config.Config,
 	logger logging.Logger,
 	rabbitClient *rabbitmq.Client,
-) (*services.AuthService, error) {
+) (*services.AuthService, *services.AccountDeletionService, *services.PremiumService, *services.RegistrationService, error) {
 
 	health.RegisterHealthService(grpcServer, db, redisClient.GetClient())
 
+	signingKey, verificationKeys, err := loadJWTKeys(cfg.Auth.JWT)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to load JWT keys: %w", err)
+	}
+
 	jwtManager := jwt.NewManager(jwt.Config{
-		SecretKey:       cfg.Auth.JWT.SecretKey,
-		AccessTokenTTL:  time.Duration(cfg.Auth.JWT.AccessTokenMinutes) * time.Minute,
-		RefreshTokenTTL: time.Duration(cfg.Auth.JWT.RefreshTokenDays) * 24 * time.Hour,
-		Issuer:          cfg.Auth.JWT.Issuer,
+		SecretKey:        cfg.Auth.JWT.SecretKey,
+		SigningKey:       signingKey,
+		VerificationKeys: verificationKeys,
+		AccessTokenTTL:   time.Duration(cfg.Auth.JWT.AccessTokenMinutes) * time.Minute,
+		RefreshTokenTTL:  time.Duration(cfg.Auth.JWT.RefreshTokenDays) * 24 * time.Hour,
+		Issuer:           cfg.Auth.JWT.Issuer,
 	})
 
 	otpConfig := otp.DefaultConfig()
@@ -125,9 +148,22 @@ func registerServices(
 	userRepo := postgres.NewUserRepository(db)
 	registrationRepo := postgres.NewRegistrationRepository(db)
 	adminRepo := postgres.NewAdminRepository(db)
+	adminInviteRepo := postgres.NewAdminInviteRepository(db)
+	adminAuditRepo := postgres.NewAdminAuditRepository(db)
+	accountDeletionRepo := postgres.NewAccountDeletionRepository(db)
+	authEventRepo := postgres.NewAuthEventRepository(db)
+	knownDeviceRepo := postgres.NewKnownDeviceRepository(db)
+	userIdentityRepo := postgres.NewUserIdentityRepository(db)
 
 	otpRepo := redisAdapter.NewOTPRepository(redisClient)
 	tokenRepo := redisAdapter.NewTokenRepository(redisClient)
+	loginAttemptRepo := redisAdapter.NewLoginAttemptRepository(redisClient)
+	sessionRepo := redisAdapter.NewSessionRepository(redisClient)
+	twoFactorRepo := redisAdapter.NewTwoFactorRepository(redisClient)
+	loginAlertRepo := redisAdapter.NewLoginAlertRepository(redisClient)
+	contactChangeRepo := redisAdapter.NewContactChangeRepository(redisClient)
+	otpLimitRepo := redisAdapter.NewOTPLimitRepository(redisClient)
+	oidcStateRepo := redisAdapter.NewOIDCStateRepository(redisClient)
 
 	emailClient, err := email.NewClient(email.Config{
 		SMTPHost:     cfg.Email.SMTPHost,
@@ -138,20 +174,82 @@ func registerServices(
 		FromName:     cfg.Email.FromName,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create email client: %w", err)
+		return nil, nil, nil, nil, fmt.Errorf("failed to create email client: %w", err)
 	}
 
+	smsSender, err := newSMSSender(cfg.SMS, logger)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to create SMS sender: %w", err)
+	}
+
+	otpSendLimits := services.OTPSendLimits{
+		ResendCooldown: time.Duration(cfg.Registration.ResendCooldownSeconds) * time.Second,
+		MaxSendsPerDay: cfg.Registration.MaxOTPSendsPerDay,
+	}
 	registrationService := services.NewRegistrationService(
 		registrationRepo,
 		userRepo,
 		otpRepo,
+		otpLimitRepo,
 		otpGenerator,
 		otpConfig.ExpiryTime,
 		emailClient,
+		smsSender,
+		services.RegistrationConfig{
+			PendingExpiry:     time.Duration(cfg.Registration.PendingExpiryHours) * time.Hour,
+			ResendCooldown:    otpSendLimits.ResendCooldown,
+			MaxSendsPerDay:    otpSendLimits.MaxSendsPerDay,
+			MaxVerifyAttempts: cfg.Registration.MaxVerifyAttempts,
+		},
 		logger,
 	)
 
-	adminService := services.NewAdminService(adminRepo, logger)
+	phoneService := services.NewPhoneService(
+		userRepo,
+		otpRepo,
+		otpLimitRepo,
+		otpGenerator,
+		otpConfig.ExpiryTime,
+		otpSendLimits,
+		cfg.Registration.MaxVerifyAttempts,
+		smsSender,
+		logger,
+	)
+
+	loginProtectionCfg := cfg.Security.LoginProtection
+	loginProtectionService := services.NewLoginProtectionService(
+		loginAttemptRepo,
+		emailClient,
+		services.LoginProtectionConfig{
+			MaxAttemptsPerEmail:  loginProtectionCfg.MaxAttemptsPerEmail,
+			MaxAttemptsPerIP:     loginProtectionCfg.MaxAttemptsPerIP,
+			AttemptWindow:        time.Duration(loginProtectionCfg.AttemptWindowMinutes) * time.Minute,
+			BaseLockout:          time.Duration(loginProtectionCfg.BaseLockoutMinutes) * time.Minute,
+			MaxLockout:           time.Duration(loginProtectionCfg.MaxLockoutMinutes) * time.Minute,
+			LockoutHistoryWindow: time.Duration(loginProtectionCfg.LockoutHistoryWindowMinutes) * time.Minute,
+		},
+		logger,
+	)
+
+	sessionService := services.NewSessionService(
+		sessionRepo,
+		tokenRepo,
+		time.Duration(cfg.Auth.JWT.RefreshTokenDays)*24*time.Hour,
+		logger,
+	)
+
+	adminService := services.NewAdminService(
+		adminRepo,
+		adminInviteRepo,
+		adminAuditRepo,
+		tokenRepo,
+		sessionService,
+		emailClient,
+		cfg.Admin.InviteURL,
+		time.Duration(cfg.Admin.InviteExpiryHours)*time.Hour,
+		time.Duration(cfg.Auth.JWT.RefreshTokenDays)*24*time.Hour,
+		logger,
+	)
 
 	if err := adminService.InitializeDefaultAdmin(
 		context.Background(),
@@ -162,6 +260,62 @@ func registerServices(
 
 	}
 
+	authEventService := services.NewAuthEventService(
+		authEventRepo,
+		adminService,
+		logger,
+	)
+
+	twoFactorService := services.NewTwoFactorService(
+		adminRepo,
+		twoFactorRepo,
+		totp.DefaultConfig(),
+		cfg.Admin.TOTPIssuer,
+		constants.DefaultTwoFactorChallengeExpiry*time.Minute,
+		cfg.Admin.Require2FA,
+		logger,
+	)
+
+	accountDeletionService := services.NewAccountDeletionService(
+		accountDeletionRepo,
+		userRepo,
+		rabbitClient,
+		time.Duration(cfg.Auth.AccountDeletion.GracePeriodDays)*24*time.Hour,
+		time.Duration(cfg.Auth.AccountDeletion.PurgeRetryMinutes)*time.Minute,
+		logger,
+	)
+
+	passwordService := services.NewPasswordService(
+		userRepo,
+		tokenRepo,
+		sessionService,
+		otpRepo,
+		otpLimitRepo,
+		otpGenerator,
+		otpConfig.ExpiryTime,
+		otpSendLimits,
+		cfg.Registration.MaxVerifyAttempts,
+		time.Duration(cfg.Auth.JWT.RefreshTokenDays)*24*time.Hour,
+		emailClient,
+		authEventService,
+		logger,
+	)
+
+	loginAlertService := services.NewLoginAlertService(
+		knownDeviceRepo,
+		loginAlertRepo,
+		userRepo,
+		tokenRepo,
+		sessionService,
+		passwordService,
+		authEventService,
+		emailClient,
+		cfg.Auth.LoginAlert.ReportURL,
+		time.Duration(cfg.Auth.LoginAlert.ReportExpiryHours)*time.Hour,
+		time.Duration(cfg.Auth.JWT.RefreshTokenDays)*24*time.Hour,
+		logger,
+	)
+
 	authService := services.NewAuthService(
 		userRepo,
 		tokenRepo,
@@ -171,16 +325,139 @@ func registerServices(
 		time.Duration(cfg.Auth.JWT.AccessTokenMinutes)*time.Minute,
 		time.Duration(cfg.Auth.JWT.RefreshTokenDays)*24*time.Hour,
 		rabbitClient,
+		loginProtectionService,
+		sessionService,
+		phoneService,
+		twoFactorService,
+		accountDeletionService,
+		authEventService,
+		loginAlertService,
+	)
+
+	contactChangeService := services.NewContactChangeService(
+		userRepo,
+		contactChangeRepo,
+		otpLimitRepo,
+		otpGenerator,
+		otpConfig.ExpiryTime,
+		otpSendLimits,
+		cfg.Registration.MaxVerifyAttempts,
+		emailClient,
+		smsSender,
+		rabbitClient,
+		authEventService,
+		logger,
+	)
+
+	oidcProviders, err := newOIDCProviders(cfg.Auth.OIDC)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to configure OIDC providers: %w", err)
+	}
+
+	oidcService := services.NewOIDCService(
+		oidcProviders,
+		oidcStateRepo,
+		userIdentityRepo,
+		userRepo,
+		authService,
+		authEventService,
+		time.Duration(cfg.Auth.OIDC.StateExpiryMinutes)*time.Minute,
+		logger,
+	)
+
+	moderationService := services.NewModerationService(
+		userRepo,
+		tokenRepo,
+		adminService,
+		sessionService,
+		rabbitClient,
+		time.Duration(cfg.Auth.JWT.RefreshTokenDays)*24*time.Hour,
+		logger,
 	)
 
 	authHandler := v1.NewAuthHandler(
 		registrationService,
 		authService,
+		passwordService,
+		sessionService,
+		phoneService,
+		adminService,
+		moderationService,
+		authEventService,
+		loginAlertService,
+		contactChangeService,
+		oidcService,
 		logger,
 	)
 	authpb.RegisterAuthServiceServer(grpcServer, authHandler)
 
-	return authService, nil
+	premiumService := services.NewPremiumService(
+		userRepo,
+		tokenRepo,
+		emailClient,
+		time.Duration(cfg.Auth.JWT.RefreshTokenDays)*24*time.Hour,
+		logger,
+	)
+
+	return authService, accountDeletionService, premiumService, registrationService, nil
+}
+
+// newSMSSender creates the SMS sender for the configured provider.
+// Only the development file provider exists for now, so it is also the default.
+func newSMSSender(cfg config.SMSConfig, logger logging.Logger) (sms.SMSSender, error) {
+	switch cfg.Provider {
+	case "", "file":
+		return sms.NewFileSender(cfg.FilePath, logger)
+	default:
+		return nil, fmt.Errorf("unsupported SMS provider: %s", cfg.Provider)
+	}
+}
+
+// newOIDCProviders creates a client for every configured OpenID Connect provider.
+// Provider metadata is only fetched on first use, so an unreachable provider
+// does not keep the service from starting.
+func newOIDCProviders(cfg config.OIDCConfig) ([]*oidc.Provider, error) {
+	providers := make([]*oidc.Provider, 0, len(cfg.Providers))
+	for _, providerCfg := range cfg.Providers {
+		provider, err := oidc.NewProvider(oidc.Config{
+			Name:         providerCfg.Name,
+			Issuer:       providerCfg.Issuer,
+			ClientID:     providerCfg.ClientID,
+			ClientSecret: providerCfg.ClientSecret,
+			RedirectURL:  providerCfg.RedirectURL,
+			Scopes:       providerCfg.Scopes,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
+
+// loadJWTKeys loads the key new tokens are signed with and the previous keys
+// that must still verify tokens issued before the last rotation.
+// A nil signing key keeps the manager on HS256 with the shared secret.
+func loadJWTKeys(cfg config.JWTConfig) (*jwt.Key, []*jwt.Key, error) {
+	var signingKey *jwt.Key
+	if cfg.SigningKey.PrivateKeyFile != "" {
+		key, err := jwt.LoadSigningKey(cfg.SigningKey.ID, cfg.SigningKey.Algorithm, cfg.SigningKey.PrivateKeyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		signingKey = key
+	}
+
+	verificationKeys := make([]*jwt.Key, 0, len(cfg.VerificationKeys))
+	for _, keyCfg := range cfg.VerificationKeys {
+		key, err := jwt.LoadVerificationKey(keyCfg.ID, keyCfg.Algorithm, keyCfg.PublicKeyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		verificationKeys = append(verificationKeys, key)
+	}
+
+	return signingKey, verificationKeys, nil
 }
 
 func createLoggingInterceptor(logger logging.Logger) grpc.UnaryServerInterceptor {
@@ -212,6 +489,13 @@ func (s *Server) Start() error {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
 
+	workerCtx, cancel := context.WithCancel(context.Background())
+	s.stopWorkers = cancel
+	go s.accountDeletionService.RunPurgeWorker(workerCtx,
+		time.Duration(s.config.Auth.AccountDeletion.PurgeIntervalMinutes)*time.Minute)
+	go s.registrationService.RunCleanupWorker(workerCtx,
+		time.Duration(s.config.Registration.CleanupIntervalMinutes)*time.Minute)
+
 	s.logger.Info("Starting gRPC server", "port", s.config.GRPC.Port)
 	return s.grpcServer.Serve(lis)
 }
@@ -220,6 +504,10 @@ func (s *Server) Stop() {
 	s.logger.Info("Stopping gRPC server")
 	s.grpcServer.GracefulStop()
 
+	if s.stopWorkers != nil {
+		s.stopWorkers()
+	}
+
 	// Close database connections
 	if s.pgClient != nil {
 		s.pgClient.Close()
@@ -243,6 +531,16 @@ func (s *Server) subscribeToSubscriptionEvents() error {
 		return fmt.Errorf("failed to subscribe to subscription.extended: %w", err)
 	}
 
+	err = s.rabbitClient.Subscribe(constants.TopicSubscriptionExpired, s.handleSubscriptionExpiredEvent)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", constants.TopicSubscriptionExpired, err)
+	}
+
+	err = s.rabbitClient.Subscribe(constants.TopicSubscriptionExpiring, s.handleSubscriptionExpiringEvent)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", constants.TopicSubscriptionExpiring, err)
+	}
+
 	s.logger.Info("Subscribed to subscription events")
 	return nil
 }
@@ -276,3 +574,80 @@ func (s *Server) handleSubscriptionEvent(message []byte) error {
 	s.logger.Info("Successfully updated premium status", "userID", event.UserID)
 	return nil
 }
+
+// handleSubscriptionExpiredEvent downgrades a user whose premium subscription ended
+func (s *Server) handleSubscriptionExpiredEvent(message []byte) error {
+	var event struct {
+		UserID         string    `json:"user_id"`
+		SubscriptionID string    `json:"subscription_id"`
+		EndDate        time.Time `json:"end_date"`
+		Timestamp      time.Time `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal subscription expired event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received subscription expired event", "userID", event.UserID, "subscriptionID", event.SubscriptionID)
+
+	if err := s.premiumService.HandlePremiumExpired(context.Background(), event.UserID, event.EndDate); err != nil {
+		s.logger.Error("Failed to downgrade expired premium user", "userID", event.UserID, "error", err)
+		return err
+	}
+	return nil
+}
+
+// handleSubscriptionExpiringEvent emails a reminder to renew before the subscription ends
+func (s *Server) handleSubscriptionExpiringEvent(message []byte) error {
+	var event struct {
+		UserID    string    `json:"user_id"`
+		EndDate   time.Time `json:"end_date"`
+		DaysLeft  int       `json:"days_left"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal subscription expiring event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received subscription expiring event", "userID", event.UserID, "daysLeft", event.DaysLeft)
+
+	if err := s.premiumService.SendExpiryReminder(context.Background(), event.UserID, event.EndDate, event.DaysLeft); err != nil {
+		s.logger.Error("Failed to send expiry reminder", "userID", event.UserID, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (s *Server) subscribeToPurgeEvents() error {
+	if err := s.rabbitClient.Subscribe(constants.TopicUserPurgeCompleted, s.handlePurgeCompletedEvent); err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", constants.TopicUserPurgeCompleted, err)
+	}
+
+	s.logger.Info("Subscribed to purge events")
+	return nil
+}
+
+// handlePurgeCompletedEvent records a service's confirmation that it purged a deleted user's data
+func (s *Server) handlePurgeCompletedEvent(message []byte) error {
+	var event struct {
+		UserID    string    `json:"user_id"`
+		Step      string    `json:"step"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal purge completed event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received purge completed event", "userID", event.UserID, "step", event.Step)
+
+	if err := s.accountDeletionService.RecordPurgeCompleted(context.Background(), event.UserID, event.Step); err != nil {
+		s.logger.Error("Failed to record purge completion", "userID", event.UserID, "step", event.Step, "error", err)
+		return err
+	}
+	return nil
+}
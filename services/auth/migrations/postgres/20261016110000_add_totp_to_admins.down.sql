@@ -0,0 +1,4 @@
+ALTER TABLE admins
+    DROP COLUMN IF EXISTS totp_recovery_codes,
+    DROP COLUMN IF EXISTS totp_enabled,
+    DROP COLUMN IF EXISTS totp_secret;
@@ -0,0 +1,4 @@
+ALTER TABLE admins
+    ADD COLUMN totp_secret VARCHAR(64),
+    ADD COLUMN totp_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN totp_recovery_codes TEXT;
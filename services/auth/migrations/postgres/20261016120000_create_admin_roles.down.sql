@@ -0,0 +1,4 @@
+ALTER TABLE admins DROP COLUMN IF EXISTS role;
+
+DROP TABLE IF EXISTS admin_role_permissions;
+DROP TABLE IF EXISTS admin_roles;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS admin_roles (
+    name VARCHAR(50) PRIMARY KEY,
+    description VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS admin_role_permissions (
+    role VARCHAR(50) NOT NULL REFERENCES admin_roles (name) ON DELETE CASCADE,
+    permission VARCHAR(100) NOT NULL,
+    PRIMARY KEY (role, permission)
+);
+
+INSERT INTO admin_roles (name, description) VALUES
+    ('super_admin', 'Full access, including managing other admins'),
+    ('moderator', 'Reviews and moderates user accounts'),
+    ('support', 'Looks up user accounts to help members'),
+    ('finance', 'Handles payments and premium membership')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO admin_role_permissions (role, permission) VALUES
+    ('super_admin', 'users:read'),
+    ('super_admin', 'users:manage'),
+    ('super_admin', 'premium:grant'),
+    ('super_admin', 'payments:read'),
+    ('super_admin', 'admins:manage'),
+    ('moderator', 'users:read'),
+    ('moderator', 'users:manage'),
+    ('support', 'users:read'),
+    ('finance', 'users:read'),
+    ('finance', 'premium:grant'),
+    ('finance', 'payments:read')
+ON CONFLICT (role, permission) DO NOTHING;
+
+-- Existing admins keep full access
+ALTER TABLE admins
+    ADD COLUMN role VARCHAR(50) NOT NULL DEFAULT 'super_admin' REFERENCES admin_roles (name);
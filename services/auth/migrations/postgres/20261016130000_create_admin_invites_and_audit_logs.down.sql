@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS admin_audit_logs;
+DROP TABLE IF EXISTS admin_invites;
@@ -0,0 +1,7 @@
+ALTER TABLE users
+    ADD COLUMN suspended_at TIMESTAMP,
+    ADD COLUMN suspended_until TIMESTAMP,
+    ADD COLUMN suspension_reason VARCHAR(500),
+    ADD COLUMN banned_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_users_suspended_at ON users(suspended_at) WHERE suspended_at IS NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE users
+    ADD COLUMN deactivated_at TIMESTAMP;
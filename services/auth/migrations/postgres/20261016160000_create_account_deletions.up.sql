@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS account_deletions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    requested_at TIMESTAMP NOT NULL,
+    purge_after TIMESTAMP NOT NULL,
+    purge_started_at TIMESTAMP,
+    user_data_purged_at TIMESTAMP,
+    media_purged_at TIMESTAMP,
+    chat_purged_at TIMESTAMP,
+    payment_purged_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    cancelled_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_deletions_user_id ON account_deletions (user_id);
+CREATE INDEX IF NOT EXISTS idx_account_deletions_due ON account_deletions (purge_after) WHERE status = 'pending';
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS auth_events (
+    id BIGSERIAL PRIMARY KEY,
+    account_id UUID,
+    account_type VARCHAR(10) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    identifier VARCHAR(255),
+    ip_address VARCHAR(45),
+    user_agent TEXT,
+    outcome VARCHAR(10) NOT NULL,
+    reason VARCHAR(50),
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_events_account ON auth_events (account_type, account_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_auth_events_created_at ON auth_events (created_at);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS known_devices (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    device VARCHAR(100) NOT NULL,
+    ip_range VARCHAR(50) NOT NULL,
+    first_seen_at TIMESTAMP NOT NULL,
+    last_seen_at TIMESTAMP NOT NULL,
+    CONSTRAINT known_devices_unique UNIQUE (user_id, device, ip_range)
+);
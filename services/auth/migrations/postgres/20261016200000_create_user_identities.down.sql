@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS users_phone_unique_active;
+CREATE UNIQUE INDEX users_phone_unique_active ON users (phone) WHERE deleted_at IS NULL;
+
+DROP TABLE IF EXISTS user_identities;
@@ -0,0 +1 @@
+DELETE FROM admin_role_permissions WHERE permission = 'taxonomies:manage';
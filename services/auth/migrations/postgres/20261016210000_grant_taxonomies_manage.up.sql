@@ -0,0 +1,4 @@
+INSERT INTO admin_role_permissions (role, permission) VALUES
+    ('super_admin', 'taxonomies:manage'),
+    ('moderator', 'taxonomies:manage')
+ON CONFLICT (role, permission) DO NOTHING;
@@ -0,0 +1,2 @@
+-- The numbers are not stored in their original form anymore, so there is nothing to revert
+SELECT 1;
@@ -0,0 +1,33 @@
+-- Phone numbers used to be stored as entered. Bring them to the E.164 form the
+-- services now write: drop separators, turn the 00 international prefix into +,
+-- read bare 10 digit numbers as Indian and drop a 0 trunk prefix after +91.
+-- Rows whose new value would clash with another account are left for manual review.
+WITH stripped AS (
+    SELECT id, regexp_replace(phone, '[[:space:]().-]', '', 'g') AS phone
+    FROM users
+),
+normalized AS (
+    SELECT id,
+           CASE
+               WHEN phone ~ '^00[1-9]' THEN '+' || substr(phone, 3)
+               WHEN phone ~ '^0?[6-9][0-9]{9}$' THEN '+91' || right(phone, 10)
+               WHEN phone ~ '^\+910[6-9][0-9]{9}$' THEN '+91' || right(phone, 10)
+               ELSE phone
+           END AS phone
+    FROM stripped
+)
+UPDATE users u
+SET phone = n.phone,
+    updated_at = NOW()
+FROM normalized n
+WHERE u.id = n.id
+  AND u.phone <> n.phone
+  AND (SELECT COUNT(*) FROM normalized o WHERE o.phone = n.phone) = 1
+  AND NOT EXISTS (
+      SELECT 1 FROM users o
+      WHERE o.phone = n.phone AND o.id <> u.id AND o.deleted_at IS NULL
+  );
+
+-- Pending registrations expire within minutes, so unparseable ones are simply dropped
+DELETE FROM pending_registrations
+WHERE phone !~ '^\+[1-9][0-9]{3,14}$';
@@ -149,3 +149,20 @@ func (r *MessageRepo) GetLatestMessageByConversation(ctx context.Context, conver
 
 	return &message, nil
 }
+
+// DeleteMessagesByConversation permanently removes every message of the conversation
+func (r *MessageRepo) DeleteMessagesByConversation(ctx context.Context, conversationID primitive.ObjectID) error {
+	docs, err := r.collection.
+		Where("conversation_id", "==", conversationID).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to get messages: %w", err)
+	}
+
+	for _, doc := range docs {
+		if _, err := doc.Ref.Delete(ctx); err != nil {
+			return fmt.Errorf("failed to delete message: %w", err)
+		}
+	}
+	return nil
+}
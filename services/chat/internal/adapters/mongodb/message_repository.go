@@ -141,3 +141,9 @@ func (r *MessageRepo) GetLatestMessageByConversation(ctx context.Context, conver
 
 	return &message, nil
 }
+
+// DeleteMessagesByConversation permanently removes every message of the conversation
+func (r *MessageRepo) DeleteMessagesByConversation(ctx context.Context, conversationID primitive.ObjectID) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"conversation_id": conversationID})
+	return err
+}
@@ -15,6 +15,7 @@ type Config struct {
 	GRPC     GRPCConfig     `mapstructure:"grpc"`
 	Database DatabaseConfig `mapstructure:"database"`
 	Auth     AuthConfig     `mapstructure:"auth"`
+	RabbitMQ RabbitMQConfig `mapstructure:"rabbitmq"`
 }
 
 type GRPCConfig struct {
@@ -39,6 +40,11 @@ type FirestoreConfig struct {
 	EmulatorHost    string `mapstructure:"emulator_host"`
 }
 
+type RabbitMQConfig struct {
+	DSN          string `mapstructure:"dsn"`
+	ExchangeName string `mapstructure:"exchange_name"`
+}
+
 type AuthConfig struct {
 	JWT JWTConfig `mapstructure:"jwt"`
 }
@@ -100,6 +106,11 @@ func LoadConfig(path string) (*Config, error) {
 		config.Database.Firestore.EmulatorHost = emulatorHost
 	}
 
+	// RabbitMQ override, chat runs without event handling when no DSN is set
+	if rabbitDSN := os.Getenv("RABBITMQ_DSN"); rabbitDSN != "" {
+		config.RabbitMQ.DSN = rabbitDSN
+	}
+
 	// JWT override
 	if jwtSecret := os.Getenv("JWT_SECRET_KEY"); jwtSecret != "" {
 		config.Auth.JWT.SecretKey = jwtSecret
@@ -32,8 +32,14 @@ const (
 const (
 	TopicMessageSent         = "chat.message.sent"
 	TopicConversationCreated = "chat.conversation.created"
+
+	TopicUserDeleted        = "user.deleted"
+	TopicUserPurgeCompleted = "user.purge_completed"
 )
 
+// PurgeStepChat is the step the chat service confirms once a deleted user's conversations are gone
+const PurgeStepChat = "chat"
+
 // gRPC headers (for internal service communication)
 const (
 	AuthorizationHeader = "authorization"
@@ -16,4 +16,5 @@ type MessageRepository interface {
 	SoftDeleteMessage(ctx context.Context, id primitive.ObjectID) error
 	CountMessagesByConversation(ctx context.Context, conversationID primitive.ObjectID) (int, error)
 	GetLatestMessageByConversation(ctx context.Context, conversationID primitive.ObjectID) (*models.Message, error)
+	DeleteMessagesByConversation(ctx context.Context, conversationID primitive.ObjectID) error
 }
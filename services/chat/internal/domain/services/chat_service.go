@@ -125,6 +125,28 @@ func (s *ChatService) GetUserConversations(ctx context.Context, userID string, l
 	return conversations, total, nil
 }
 
+// GetConversation returns a conversation the user takes part in
+func (s *ChatService) GetConversation(ctx context.Context, userID string, conversationID primitive.ObjectID) (*models.Conversation, error) {
+	if userID == "" {
+		return nil, chaterrors.ErrInvalidInput
+	}
+
+	conversation, err := s.conversationRepo.GetConversationByID(ctx, conversationID)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, chaterrors.ErrConversationNotFound
+		}
+		s.logger.Error("Error fetching conversation", "error", err)
+		return nil, err
+	}
+
+	if !s.isUserParticipant(conversation.Participants, userID) {
+		return nil, chaterrors.ErrUserNotParticipant
+	}
+
+	return conversation, nil
+}
+
 func (s *ChatService) SendMessage(ctx context.Context, userID string, conversationID primitive.ObjectID, text string) (*models.Message, error) {
 	s.logger.Info("Sending message", "userID", userID, "conversationID", conversationID.Hex())
 
@@ -274,6 +296,38 @@ func (s *ChatService) DeleteMessage(ctx context.Context, userID string, messageI
 }
 
 // Helper method to check if user is a participant in conversation
+// DeleteUserData permanently removes every conversation of a deleted user
+// together with its messages. The other participant loses the conversation too.
+func (s *ChatService) DeleteUserData(ctx context.Context, userID string) error {
+	deleted := 0
+	for {
+		// Deleted conversations drop out of the results, so always read the first page
+		conversations, err := s.conversationRepo.GetUserConversations(ctx, userID, constants.MaxConversationLimit, 0)
+		if err != nil {
+			s.logger.Error("Failed to get user conversations", "userID", userID, "error", err)
+			return err
+		}
+		if len(conversations) == 0 {
+			break
+		}
+
+		for _, conversation := range conversations {
+			if err := s.messageRepo.DeleteMessagesByConversation(ctx, conversation.ID); err != nil {
+				s.logger.Error("Failed to delete conversation messages", "conversationID", conversation.ID.Hex(), "error", err)
+				return err
+			}
+			if err := s.conversationRepo.DeleteConversation(ctx, conversation.ID); err != nil {
+				s.logger.Error("Failed to delete conversation", "conversationID", conversation.ID.Hex(), "error", err)
+				return err
+			}
+			deleted++
+		}
+	}
+
+	s.logger.Info("Deleted user chat data", "userID", userID, "conversations", deleted)
+	return nil
+}
+
 func (s *ChatService) isUserParticipant(participants []string, userID string) bool {
 	for _, participant := range participants {
 		if participant == userID {
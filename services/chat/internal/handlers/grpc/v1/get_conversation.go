@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	chatpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/chat/v1"
+	"github.com/mohamedfawas/qubool-kallyanam/services/chat/internal/helpers"
+)
+
+func (h *ChatHandler) GetConversation(ctx context.Context, req *chatpb.GetConversationRequest) (*chatpb.GetConversationResponse, error) {
+	h.logger.Info("GetConversation gRPC request", "userID", req.UserId, "conversationID", req.ConversationId)
+
+	// Parse conversation ID using helper
+	conversationID, err := helpers.ValidateObjectID(req.ConversationId)
+	if err != nil {
+		h.logger.Debug("Invalid conversation ID format", "conversationID", req.ConversationId)
+		return &chatpb.GetConversationResponse{
+			Success: false,
+			Message: "Invalid conversation ID",
+			Error:   "Invalid conversation ID format",
+		}, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	// Call service
+	conversation, err := h.chatService.GetConversation(ctx, req.UserId, conversationID)
+	if err != nil {
+		h.logger.Error("Failed to get conversation", "error", err)
+		return &chatpb.GetConversationResponse{
+			Success: false,
+			Message: "Failed to get conversation",
+			Error:   err.Error(),
+		}, helpers.MapErrorToGRPCStatus(err)
+	}
+
+	// Build response
+	conversationData := &chatpb.ConversationData{
+		Id:           conversation.ID.Hex(),
+		Participants: conversation.Participants,
+		CreatedAt:    timestamppb.New(conversation.CreatedAt),
+		UpdatedAt:    timestamppb.New(conversation.UpdatedAt),
+	}
+
+	if conversation.LastMessageAt != nil {
+		conversationData.LastMessageAt = timestamppb.New(*conversation.LastMessageAt)
+	}
+
+	return &chatpb.GetConversationResponse{
+		Success:      true,
+		Message:      "Conversation retrieved successfully",
+		Conversation: conversationData,
+	}, nil
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"time"
@@ -14,9 +15,12 @@ import (
 	firestoreClient "github.com/mohamedfawas/qubool-kallyanam/pkg/database/firestore"
 	mongoClient "github.com/mohamedfawas/qubool-kallyanam/pkg/database/mongodb"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/messaging/rabbitmq"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
 	firestoreAdapter "github.com/mohamedfawas/qubool-kallyanam/services/chat/internal/adapters/firestore"
 	mongoAdapter "github.com/mohamedfawas/qubool-kallyanam/services/chat/internal/adapters/mongodb"
 	"github.com/mohamedfawas/qubool-kallyanam/services/chat/internal/config"
+	"github.com/mohamedfawas/qubool-kallyanam/services/chat/internal/constants"
 	repositories "github.com/mohamedfawas/qubool-kallyanam/services/chat/internal/domain/repository" // ADD THIS LINE
 	"github.com/mohamedfawas/qubool-kallyanam/services/chat/internal/domain/services"
 	v1 "github.com/mohamedfawas/qubool-kallyanam/services/chat/internal/handlers/grpc/v1"
@@ -31,6 +35,9 @@ type Server struct {
 	// Simple approach - store actual clients directly
 	mongoClient     *mongoClient.Client     // Will be nil if using Firestore
 	firestoreClient *firestoreClient.Client // Will be nil if using MongoDB
+
+	rabbitClient *rabbitmq.Client // Will be nil if no RabbitMQ DSN is configured
+	chatService  *services.ChatService
 }
 
 func NewServer(cfg *config.Config, logger logging.Logger) (*Server, error) {
@@ -57,6 +64,11 @@ func NewServer(cfg *config.Config, logger logging.Logger) (*Server, error) {
 		return nil, err
 	}
 
+	// Step 4: Subscribe to account events
+	if err := server.subscribeToEvents(); err != nil {
+		return nil, err
+	}
+
 	return server, nil
 }
 
@@ -116,6 +128,7 @@ func (s *Server) registerServices() error {
 
 	// Step 2: Create business service (same regardless of database)
 	chatService := services.NewChatService(conversationRepo, messageRepo, s.logger)
+	s.chatService = chatService
 
 	// Step 3: Create and register gRPC handler (same regardless of database)
 	chatHandler := v1.NewChatHandler(chatService, s.logger)
@@ -156,6 +169,62 @@ func (s *Server) Stop() {
 		s.logger.Info("Closing Firestore connection")
 		s.firestoreClient.Close()
 	}
+
+	if s.rabbitClient != nil {
+		s.rabbitClient.Close()
+	}
+}
+
+// subscribeToEvents connects to RabbitMQ and listens for deleted accounts
+func (s *Server) subscribeToEvents() error {
+	if s.config.RabbitMQ.DSN == "" {
+		s.logger.Warn("RabbitMQ is not configured, deleted accounts will not be purged from chat")
+		return nil
+	}
+
+	rabbitClient, err := rabbitmq.NewClient(s.config.RabbitMQ.DSN, s.config.RabbitMQ.ExchangeName)
+	if err != nil {
+		return fmt.Errorf("failed to create RabbitMQ client: %w", err)
+	}
+	s.rabbitClient = rabbitClient
+
+	if err := s.rabbitClient.Subscribe(constants.TopicUserDeleted, s.handleUserDeletion); err != nil {
+		return fmt.Errorf("failed to subscribe to %s events: %w", constants.TopicUserDeleted, err)
+	}
+
+	s.logger.Info("Subscribed to user deletion events")
+	return nil
+}
+
+// handleUserDeletion purges the conversations of a deleted user and confirms it to the auth service
+func (s *Server) handleUserDeletion(message []byte) error {
+	var event struct {
+		UserID    string    `json:"user_id"`
+		EventType string    `json:"event_type"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal user deletion event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received user deletion event", "userID", event.UserID)
+
+	if err := s.chatService.DeleteUserData(context.Background(), event.UserID); err != nil {
+		s.logger.Error("Failed to purge user chat data", "userID", event.UserID, "error", err)
+		return err
+	}
+
+	ack := map[string]interface{}{
+		"user_id":   event.UserID,
+		"step":      constants.PurgeStepChat,
+		"timestamp": indianstandardtime.Now(),
+	}
+	if err := s.rabbitClient.Publish(constants.TopicUserPurgeCompleted, ack); err != nil {
+		s.logger.Error("Failed to publish purge completed event", "userID", event.UserID, "error", err)
+	}
+	return nil
 }
 
 func createLoggingInterceptor(logger logging.Logger) grpc.UnaryServerInterceptor {
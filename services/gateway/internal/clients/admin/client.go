@@ -6,6 +6,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 
 	adminpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/admin/v1"
 )
@@ -41,6 +42,60 @@ func (c *Client) GetUser(ctx context.Context, req *adminpb.GetUserRequest) (*adm
 	return c.client.GetUser(ctx, req)
 }
 
+// SuspendUser suspends a user. actorID is the admin making the request.
+func (c *Client) SuspendUser(ctx context.Context, actorID string, req *adminpb.SuspendUserRequest) (*adminpb.ModerateUserResponse, error) {
+	return c.client.SuspendUser(withActor(ctx, actorID), req)
+}
+
+// BanUser permanently bans a user
+func (c *Client) BanUser(ctx context.Context, actorID string, req *adminpb.BanUserRequest) (*adminpb.ModerateUserResponse, error) {
+	return c.client.BanUser(withActor(ctx, actorID), req)
+}
+
+// ReinstateUser lifts a user's suspension or ban
+func (c *Client) ReinstateUser(ctx context.Context, actorID string, req *adminpb.ReinstateUserRequest) (*adminpb.ModerateUserResponse, error) {
+	return c.client.ReinstateUser(withActor(ctx, actorID), req)
+}
+
+// GrantPremium gives a user premium membership or extends it
+func (c *Client) GrantPremium(ctx context.Context, actorID string, req *adminpb.GrantPremiumRequest) (*adminpb.ModerateUserResponse, error) {
+	return c.client.GrantPremium(withActor(ctx, actorID), req)
+}
+
+// GetUserAuthEvents returns a user's authentication events
+func (c *Client) GetUserAuthEvents(ctx context.Context, actorID string, req *adminpb.GetUserAuthEventsRequest) (*adminpb.GetUserAuthEventsResponse, error) {
+	return c.client.GetUserAuthEvents(withActor(ctx, actorID), req)
+}
+
+// ListTaxonomyOptions lists the taxonomy options, deactivated ones included
+func (c *Client) ListTaxonomyOptions(ctx context.Context, actorID string, req *adminpb.ListTaxonomyOptionsRequest) (*adminpb.ListTaxonomyOptionsResponse, error) {
+	return c.client.ListTaxonomyOptions(withActor(ctx, actorID), req)
+}
+
+// CreateTaxonomyOption adds a taxonomy option
+func (c *Client) CreateTaxonomyOption(ctx context.Context, actorID string, req *adminpb.CreateTaxonomyOptionRequest) (*adminpb.TaxonomyOptionResponse, error) {
+	return c.client.CreateTaxonomyOption(withActor(ctx, actorID), req)
+}
+
+// UpdateTaxonomyOption changes a taxonomy option
+func (c *Client) UpdateTaxonomyOption(ctx context.Context, actorID string, req *adminpb.UpdateTaxonomyOptionRequest) (*adminpb.TaxonomyOptionResponse, error) {
+	return c.client.UpdateTaxonomyOption(withActor(ctx, actorID), req)
+}
+
+// DeleteTaxonomyOption deactivates a taxonomy option
+func (c *Client) DeleteTaxonomyOption(ctx context.Context, actorID string, req *adminpb.DeleteTaxonomyOptionRequest) (*adminpb.TaxonomyOptionResponse, error) {
+	return c.client.DeleteTaxonomyOption(withActor(ctx, actorID), req)
+}
+
+// withActor attaches the ID of the admin making the request and, when the
+// route forwards it, their access token for services that check permissions themselves
+func withActor(ctx context.Context, actorID string) context.Context {
+	if token, ok := ctx.Value("authorization").(string); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", token)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "user-id", actorID)
+}
+
 // Close closes the client connection
 func (c *Client) Close() error {
 	return c.conn.Close()
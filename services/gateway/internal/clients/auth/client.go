@@ -57,11 +57,25 @@ func (c *Client) Register(ctx context.Context,
 	return resp.Success, resp.Message, nil
 }
 
-// Verify sends a verification request to the auth service
-func (c *Client) Verify(ctx context.Context, email, otp string) (bool, string, error) {
+// Verify sends a verification request to the auth service.
+// phoneOTP is optional and verifies the phone number at the same time.
+func (c *Client) Verify(ctx context.Context, email, otp, phoneOTP string) (bool, string, error) {
 	resp, err := c.client.Verify(ctx, &authpb.VerifyRequest{
+		Email:    email,
+		Otp:      otp,
+		PhoneOtp: phoneOTP,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// ResendOTP asks the auth service to send new codes for a pending registration
+func (c *Client) ResendOTP(ctx context.Context, email string) (bool, string, error) {
+	resp, err := c.client.ResendOTP(ctx, &authpb.ResendOTPRequest{
 		Email: email,
-		Otp:   otp,
 	})
 	if err != nil {
 		return false, "", err
@@ -70,8 +84,12 @@ func (c *Client) Verify(ctx context.Context, email, otp string) (bool, string, e
 	return resp.Success, resp.Message, nil
 }
 
-// Login sends authentication request to the auth service
-func (c *Client) Login(ctx context.Context, email, password string) (bool, string, string, string, int32, error) {
+// Login sends authentication request to the auth service.
+// clientIP and userAgent are forwarded so the auth service can throttle failed
+// attempts per IP and record the device of the new session.
+func (c *Client) Login(ctx context.Context, email, password, clientIP, userAgent string) (bool, string, string, string, int32, error) {
+	ctx = withClientInfo(ctx, clientIP, userAgent)
+
 	resp, err := c.client.Login(ctx, &authpb.LoginRequest{
 		Email:    email,
 		Password: password,
@@ -84,7 +102,9 @@ func (c *Client) Login(ctx context.Context, email, password string) (bool, strin
 }
 
 // Logout sends a logout request to the auth service
-func (c *Client) Logout(ctx context.Context, accessToken string) (bool, string, error) {
+func (c *Client) Logout(ctx context.Context, accessToken, clientIP, userAgent string) (bool, string, error) {
+	ctx = withClientInfo(ctx, clientIP, userAgent)
+
 	resp, err := c.client.Logout(ctx, &authpb.LogoutRequest{
 		AccessToken: accessToken,
 	})
@@ -96,7 +116,7 @@ func (c *Client) Logout(ctx context.Context, accessToken string) (bool, string,
 }
 
 // RefreshToken sends a token refresh request to the auth service
-func (c *Client) RefreshToken(ctx context.Context, refreshToken string) (bool, string, string, int32, string, error) {
+func (c *Client) RefreshToken(ctx context.Context, refreshToken, clientIP, userAgent string) (bool, string, string, int32, string, error) {
 	// Create metadata with the authorization header
 	md := metadata.New(map[string]string{
 		"authorization": "Bearer " + refreshToken,
@@ -104,6 +124,7 @@ func (c *Client) RefreshToken(ctx context.Context, refreshToken string) (bool, s
 
 	// Create new context with the metadata
 	ctx = metadata.NewOutgoingContext(ctx, md)
+	ctx = withClientInfo(ctx, clientIP, userAgent)
 
 	// Call the service with empty request
 	resp, err := c.client.RefreshToken(ctx, &authpb.RefreshTokenRequest{})
@@ -114,12 +135,32 @@ func (c *Client) RefreshToken(ctx context.Context, refreshToken string) (bool, s
 	return resp.Success, resp.AccessToken, resp.RefreshToken, resp.ExpiresIn, resp.Message, nil
 }
 
-// AdminLogin sends admin authentication request to the auth service
-func (c *Client) AdminLogin(ctx context.Context, email, password string) (bool, string, string, string, int32, error) {
-	resp, err := c.client.AdminLogin(ctx, &authpb.LoginRequest{
+// AdminLogin sends admin authentication request to the auth service.
+// The response carries either tokens or a two-factor challenge.
+func (c *Client) AdminLogin(ctx context.Context, email, password, clientIP, userAgent string) (*authpb.LoginResponse, error) {
+	ctx = withClientInfo(ctx, clientIP, userAgent)
+
+	return c.client.AdminLogin(ctx, &authpb.LoginRequest{
 		Email:    email,
 		Password: password,
 	})
+}
+
+// AdminEnroll2FA starts authenticator enrollment for the admin of the two-factor challenge
+func (c *Client) AdminEnroll2FA(ctx context.Context, twoFactorToken string) (*authpb.AdminEnroll2FAResponse, error) {
+	return c.client.AdminEnroll2FA(ctx, &authpb.AdminEnroll2FARequest{
+		TwoFactorToken: twoFactorToken,
+	})
+}
+
+// AdminVerify2FA completes an admin login with a TOTP or recovery code
+func (c *Client) AdminVerify2FA(ctx context.Context, twoFactorToken, code, clientIP, userAgent string) (bool, string, string, string, int32, error) {
+	ctx = withClientInfo(ctx, clientIP, userAgent)
+
+	resp, err := c.client.AdminVerify2FA(ctx, &authpb.AdminVerify2FARequest{
+		TwoFactorToken: twoFactorToken,
+		Code:           code,
+	})
 	if err != nil {
 		return false, "", "", "", 0, err
 	}
@@ -127,7 +168,8 @@ func (c *Client) AdminLogin(ctx context.Context, email, password string) (bool,
 	return resp.Success, resp.AccessToken, resp.RefreshToken, resp.Message, resp.ExpiresIn, nil
 }
 
-func (c *Client) Delete(ctx context.Context, password string) (bool, string, error) {
+// Delete schedules the user's account for deletion
+func (c *Client) Delete(ctx context.Context, password string) (*authpb.DeleteResponse, error) {
 	// Extract the userID from the context, if it exists
 	var md metadata.MD
 	if userID, ok := ctx.Value("user-id").(string); ok {
@@ -137,15 +179,416 @@ func (c *Client) Delete(ctx context.Context, password string) (bool, string, err
 		ctx = metadata.NewOutgoingContext(ctx, md)
 	}
 
-	resp, err := c.client.Delete(ctx, &authpb.DeleteRequest{
+	return c.client.Delete(ctx, &authpb.DeleteRequest{
+		Password: password,
+	})
+}
+
+// RestoreAccount cancels a scheduled account deletion and logs the user in
+func (c *Client) RestoreAccount(ctx context.Context, email, password, clientIP, userAgent string) (bool, string, string, string, int32, error) {
+	ctx = withClientInfo(ctx, clientIP, userAgent)
+
+	resp, err := c.client.RestoreAccount(ctx, &authpb.LoginRequest{
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return false, "", "", "", 0, err
+	}
+
+	return resp.Success, resp.AccessToken, resp.RefreshToken, resp.Message, resp.ExpiresIn, nil
+}
+
+// DeactivateAccount hides the user's account until they log in again or reactivate it
+func (c *Client) DeactivateAccount(ctx context.Context, userID, password string) (bool, string, error) {
+	ctx = withSession(ctx, userID, "")
+
+	resp, err := c.client.DeactivateAccount(ctx, &authpb.DeactivateAccountRequest{
+		Password: password,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// ReactivateAccount makes the user's deactivated account visible again
+func (c *Client) ReactivateAccount(ctx context.Context, userID string) (bool, string, error) {
+	ctx = withSession(ctx, userID, "")
+
+	resp, err := c.client.ReactivateAccount(ctx, &authpb.ReactivateAccountRequest{})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// ForgotPassword requests a password reset OTP for the given email
+func (c *Client) ForgotPassword(ctx context.Context, email string) (bool, string, error) {
+	resp, err := c.client.ForgotPassword(ctx, &authpb.ForgotPasswordRequest{
+		Email: email,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// ReportUnrecognizedLogin secures the account a new-device login alert was sent for
+func (c *Client) ReportUnrecognizedLogin(ctx context.Context, token, clientIP, userAgent string) (bool, string, error) {
+	ctx = withClientInfo(ctx, clientIP, userAgent)
+
+	resp, err := c.client.ReportUnrecognizedLogin(ctx, &authpb.ReportUnrecognizedLoginRequest{
+		Token: token,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// ResetPassword sets a new password using the password reset OTP
+func (c *Client) ResetPassword(ctx context.Context, email, otp, newPassword, clientIP, userAgent string) (bool, string, error) {
+	ctx = withClientInfo(ctx, clientIP, userAgent)
+
+	resp, err := c.client.ResetPassword(ctx, &authpb.ResetPasswordRequest{
+		Email:       email,
+		Otp:         otp,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// SendPhoneVerificationOTP asks the auth service to text an OTP to the user's phone number
+func (c *Client) SendPhoneVerificationOTP(ctx context.Context, userID string) (bool, string, error) {
+	ctx = withSession(ctx, userID, "")
+
+	resp, err := c.client.SendPhoneVerificationOTP(ctx, &authpb.SendPhoneVerificationOTPRequest{})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// VerifyPhone verifies the user's phone number with the OTP sent by SMS
+func (c *Client) VerifyPhone(ctx context.Context, userID, otp string) (bool, string, error) {
+	ctx = withSession(ctx, userID, "")
+
+	resp, err := c.client.VerifyPhone(ctx, &authpb.VerifyPhoneRequest{
+		Otp: otp,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// RequestEmailChange asks the auth service to send an OTP to the user's new email address
+func (c *Client) RequestEmailChange(ctx context.Context, userID, newEmail, password string) (bool, string, error) {
+	ctx = withSession(ctx, userID, "")
+
+	resp, err := c.client.RequestEmailChange(ctx, &authpb.RequestEmailChangeRequest{
+		NewEmail: newEmail,
+		Password: password,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// ConfirmEmailChange replaces the user's email address with the one the OTP was sent to
+func (c *Client) ConfirmEmailChange(ctx context.Context, userID, otp, clientIP, userAgent string) (bool, string, error) {
+	ctx = withSession(ctx, userID, "")
+	ctx = withClientInfo(ctx, clientIP, userAgent)
+
+	resp, err := c.client.ConfirmEmailChange(ctx, &authpb.ConfirmContactChangeRequest{
+		Otp: otp,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// RequestPhoneChange asks the auth service to text an OTP to the user's new phone number
+func (c *Client) RequestPhoneChange(ctx context.Context, userID, newPhone, password string) (bool, string, error) {
+	ctx = withSession(ctx, userID, "")
+
+	resp, err := c.client.RequestPhoneChange(ctx, &authpb.RequestPhoneChangeRequest{
+		NewPhone: newPhone,
 		Password: password,
 	})
 	if err != nil {
 		return false, "", err
 	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// ConfirmPhoneChange replaces the user's phone number with the one the OTP was sent to
+func (c *Client) ConfirmPhoneChange(ctx context.Context, userID, otp, clientIP, userAgent string) (bool, string, error) {
+	ctx = withSession(ctx, userID, "")
+	ctx = withClientInfo(ctx, clientIP, userAgent)
+
+	resp, err := c.client.ConfirmPhoneChange(ctx, &authpb.ConfirmContactChangeRequest{
+		Otp: otp,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// RequestLoginOTP asks the auth service to text a login OTP to the phone number
+func (c *Client) RequestLoginOTP(ctx context.Context, phone string) (bool, string, error) {
+	resp, err := c.client.RequestLoginOTP(ctx, &authpb.RequestLoginOTPRequest{
+		Phone: phone,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
 	return resp.Success, resp.Message, nil
 }
 
+// LoginWithOTP authenticates with a phone number and the login OTP sent to it
+func (c *Client) LoginWithOTP(ctx context.Context, phone, otp, clientIP, userAgent string) (bool, string, string, string, int32, error) {
+	ctx = withClientInfo(ctx, clientIP, userAgent)
+
+	resp, err := c.client.LoginWithOTP(ctx, &authpb.LoginWithOTPRequest{
+		Phone: phone,
+		Otp:   otp,
+	})
+	if err != nil {
+		return false, "", "", "", 0, err
+	}
+
+	return resp.Success, resp.AccessToken, resp.RefreshToken, resp.Message, resp.ExpiresIn, nil
+}
+
+// StartOIDCLogin returns the provider URL the user is redirected to for an OpenID Connect sign-in
+func (c *Client) StartOIDCLogin(ctx context.Context, provider string) (string, error) {
+	resp, err := c.client.StartOIDCLogin(ctx, &authpb.StartOIDCLoginRequest{
+		Provider: provider,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.AuthorizationUrl, nil
+}
+
+// StartOIDCLink returns the provider URL the user signs in with to link the provider account to their account
+func (c *Client) StartOIDCLink(ctx context.Context, userID, provider string) (string, error) {
+	ctx = withSession(ctx, userID, "")
+
+	resp, err := c.client.StartOIDCLink(ctx, &authpb.StartOIDCLoginRequest{
+		Provider: provider,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.AuthorizationUrl, nil
+}
+
+// CompleteOIDCLogin exchanges the code the provider redirected back with for tokens
+func (c *Client) CompleteOIDCLogin(ctx context.Context, provider, state, code, clientIP, userAgent string) (*authpb.CompleteOIDCLoginResponse, error) {
+	ctx = withClientInfo(ctx, clientIP, userAgent)
+
+	return c.client.CompleteOIDCLogin(ctx, &authpb.CompleteOIDCLoginRequest{
+		Provider: provider,
+		State:    state,
+		Code:     code,
+	})
+}
+
+// ListSessions returns the active login sessions of the user.
+// sessionID identifies the caller's own session so it can be flagged as current.
+func (c *Client) ListSessions(ctx context.Context, userID, sessionID string) (bool, string, []*authpb.SessionData, error) {
+	ctx = withSession(ctx, userID, sessionID)
+
+	resp, err := c.client.ListSessions(ctx, &authpb.ListSessionsRequest{})
+	if err != nil {
+		return false, "", nil, err
+	}
+
+	return resp.Success, resp.Message, resp.Sessions, nil
+}
+
+// ListAuthEvents returns the user's recent logins, logouts and other security activity
+func (c *Client) ListAuthEvents(ctx context.Context, userID string, limit, offset int32) (*authpb.ListAuthEventsResponse, error) {
+	ctx = withSession(ctx, userID, "")
+
+	return c.client.ListAuthEvents(ctx, &authpb.ListAuthEventsRequest{
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
+// RevokeSession signs out one of the user's sessions
+func (c *Client) RevokeSession(ctx context.Context, userID, targetSessionID string) (bool, string, error) {
+	ctx = withSession(ctx, userID, "")
+
+	resp, err := c.client.RevokeSession(ctx, &authpb.RevokeSessionRequest{
+		SessionId: targetSessionID,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Success, resp.Message, nil
+}
+
+// RevokeAllOtherSessions signs out every session of the user except sessionID
+func (c *Client) RevokeAllOtherSessions(ctx context.Context, userID, sessionID string) (bool, string, int32, error) {
+	ctx = withSession(ctx, userID, sessionID)
+
+	resp, err := c.client.RevokeAllOtherSessions(ctx, &authpb.RevokeAllOtherSessionsRequest{})
+	if err != nil {
+		return false, "", 0, err
+	}
+
+	return resp.Success, resp.Message, resp.RevokedCount, nil
+}
+
+// ListAdmins returns all admin accounts. actorID is the admin making the request.
+func (c *Client) ListAdmins(ctx context.Context, actorID string) ([]*authpb.AdminData, error) {
+	ctx = withSession(ctx, actorID, "")
+
+	resp, err := c.client.ListAdmins(ctx, &authpb.ListAdminsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Admins, nil
+}
+
+// CreateAdmin creates an admin account with the given password and role
+func (c *Client) CreateAdmin(ctx context.Context, actorID, email, password, role string) (*authpb.AdminAccountResponse, error) {
+	ctx = withSession(ctx, actorID, "")
+
+	return c.client.CreateAdmin(ctx, &authpb.CreateAdminRequest{
+		Email:    email,
+		Password: password,
+		Role:     role,
+	})
+}
+
+// InviteAdmin emails an admin invite link for the given role
+func (c *Client) InviteAdmin(ctx context.Context, actorID, email, role string) (*authpb.InviteAdminResponse, error) {
+	ctx = withSession(ctx, actorID, "")
+
+	return c.client.InviteAdmin(ctx, &authpb.InviteAdminRequest{
+		Email: email,
+		Role:  role,
+	})
+}
+
+// AcceptAdminInvite creates the invited admin account with the chosen password
+func (c *Client) AcceptAdminInvite(ctx context.Context, token, password string) (*authpb.AdminAccountResponse, error) {
+	return c.client.AcceptAdminInvite(ctx, &authpb.AcceptAdminInviteRequest{
+		Token:    token,
+		Password: password,
+	})
+}
+
+// DeactivateAdmin disables an admin account
+func (c *Client) DeactivateAdmin(ctx context.Context, actorID, adminID string) (*authpb.AdminAccountResponse, error) {
+	ctx = withSession(ctx, actorID, "")
+
+	return c.client.DeactivateAdmin(ctx, &authpb.DeactivateAdminRequest{
+		AdminId: adminID,
+	})
+}
+
+// ResetAdminPassword sets a new password for an admin account
+func (c *Client) ResetAdminPassword(ctx context.Context, actorID, adminID, newPassword string, resetTwoFactor bool) (*authpb.AdminAccountResponse, error) {
+	ctx = withSession(ctx, actorID, "")
+
+	return c.client.ResetAdminPassword(ctx, &authpb.ResetAdminPasswordRequest{
+		AdminId:        adminID,
+		NewPassword:    newPassword,
+		ResetTwoFactor: resetTwoFactor,
+	})
+}
+
+// GetJWKS fetches the public keys the auth service signs tokens with
+func (c *Client) GetJWKS(ctx context.Context) ([]*authpb.JSONWebKey, error) {
+	resp, err := c.client.GetJWKS(ctx, &authpb.GetJWKSRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Keys, nil
+}
+
+// GetAccountStatus reports whether the user is active, suspended or banned
+func (c *Client) GetAccountStatus(ctx context.Context, userID string) (*authpb.GetAccountStatusResponse, error) {
+	return c.client.GetAccountStatus(ctx, &authpb.GetAccountStatusRequest{
+		UserId: userID,
+	})
+}
+
+// IsTokenRevoked reports whether the access token was revoked by logout or a "revoke all" marker
+func (c *Client) IsTokenRevoked(ctx context.Context, accessToken string) (bool, error) {
+	resp, err := c.client.CheckTokenRevocation(ctx, &authpb.CheckTokenRevocationRequest{
+		AccessToken: accessToken,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Revoked, nil
+}
+
+// GetUser returns the account data of the user with the given ID
+func (c *Client) GetUser(ctx context.Context, userID string) (*authpb.UserData, error) {
+	resp, err := c.client.GetUser(ctx, &authpb.GetUserRequest{
+		Identifier:     userID,
+		IdentifierType: "uuid",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.User, nil
+}
+
+// withClientInfo adds the end user's IP address and User-Agent to the outgoing gRPC metadata
+func withClientInfo(ctx context.Context, clientIP, userAgent string) context.Context {
+	if clientIP != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-client-ip", clientIP)
+	}
+	if userAgent != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-user-agent", userAgent)
+	}
+	return ctx
+}
+
+// withSession adds the authenticated user and their current session to the outgoing gRPC metadata
+func withSession(ctx context.Context, userID, sessionID string) context.Context {
+	ctx = metadata.AppendToOutgoingContext(ctx, "user-id", userID)
+	if sessionID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "session-id", sessionID)
+	}
+	return ctx
+}
+
 // Close closes the client connection
 func (c *Client) Close() error {
 	return c.conn.Close()
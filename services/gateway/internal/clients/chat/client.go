@@ -45,6 +45,24 @@ func (c *Client) CreateConversation(ctx context.Context, userID, participantID s
 	return resp.Conversation, nil
 }
 
+func (c *Client) GetConversation(ctx context.Context, userID, conversationID string) (*chatpb.ConversationData, error) {
+	req := &chatpb.GetConversationRequest{
+		UserId:         userID,
+		ConversationId: conversationID,
+	}
+
+	resp, err := c.client.GetConversation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("failed to get conversation: %s", resp.Error)
+	}
+
+	return resp.Conversation, nil
+}
+
 func (c *Client) SendMessage(ctx context.Context, userID, conversationID, text string) (*chatpb.MessageData, error) {
 	req := &chatpb.SendMessageRequest{
 		UserId:         userID,
@@ -91,6 +91,23 @@ func (c *Client) GetPaymentHistory(ctx context.Context, limit, offset int32) (bo
 	return resp.Success, resp.Message, resp.Payments, resp.Pagination, nil
 }
 
+// GetUserPaymentHistory gets the payment history of the given user, for admins
+func (c *Client) GetUserPaymentHistory(ctx context.Context, userID string, limit, offset int32) (bool, string, []*paymentpb.PaymentData, *paymentpb.PaginationData, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"user-id": userID,
+	}))
+
+	resp, err := c.client.GetPaymentHistory(ctx, &paymentpb.GetPaymentHistoryRequest{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return false, "", nil, nil, err
+	}
+
+	return resp.Success, resp.Message, resp.Payments, resp.Pagination, nil
+}
+
 // VerifyPayment verifies a payment callback (no auth needed - public callback)
 func (c *Client) VerifyPayment(ctx context.Context, razorpayOrderID, razorpayPaymentID, razorpaySignature string) (bool, string, *paymentpb.SubscriptionData, error) {
 	resp, err := c.client.VerifyPayment(ctx, &paymentpb.VerifyPaymentRequest{
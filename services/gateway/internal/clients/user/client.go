@@ -33,7 +33,8 @@ func (c *Client) UpdateProfile(ctx context.Context, userID string,
 	isBride bool, fullName string,
 	dateOfBirth string, heightCM int, physicallyChallenged bool,
 	community string, maritalStatus string, profession string,
-	professionType string, highestEducationLevel string, homeDistrict string) (bool, string, error) {
+	professionType string, highestEducationLevel string, homeDistrict string,
+	currentLocation string) (bool, string, error) {
 
 	// Create metadata with user ID
 	md := metadata.New(map[string]string{
@@ -52,6 +53,7 @@ func (c *Client) UpdateProfile(ctx context.Context, userID string,
 		ProfessionType:        professionType,
 		HighestEducationLevel: highestEducationLevel,
 		HomeDistrict:          homeDistrict,
+		CurrentLocation:       currentLocation,
 		HeightCm:              int32(heightCM),
 	}
 
@@ -100,12 +102,40 @@ func (c *Client) DeleteProfilePhoto(ctx context.Context, userID string) (bool, s
 	return resp.Success, resp.Message, nil
 }
 
+// Lifestyle holds the lifestyle attributes of a PatchProfile call.
+// Nil fields are left unchanged.
+type Lifestyle struct {
+	PrayerFrequency   *string
+	Hijab             *string
+	Beard             *string
+	MadrasaEducation  *string
+	Diet              *string
+	Smoking           *string
+	WillingToRelocate *string
+}
+
+// ProfileDetails holds the extended profile fields of a PatchProfile call.
+// Nil fields are left unchanged.
+type ProfileDetails struct {
+	AboutMe          *string
+	AnnualIncome     *string
+	Languages        *[]string
+	CurrentCity      *string
+	CurrentCountry   *string
+	ResidencyStatus  *string
+	FatherOccupation *string
+	MotherOccupation *string
+	BrothersCount    *int
+	SistersCount     *int
+	FamilyType       *string
+}
+
 func (c *Client) PatchProfile(ctx context.Context, userID string,
 	isBride *bool, fullName *string,
 	dateOfBirth string, heightCM *int, physicallyChallenged *bool,
 	community *string, maritalStatus *string, profession *string,
 	professionType *string, highestEducationLevel *string, homeDistrict *string,
-	clearDateOfBirth bool, clearHeightCM bool) (bool, string, error) {
+	currentLocation *string, clearDateOfBirth bool, clearHeightCM bool, details ProfileDetails, lifestyle Lifestyle) (bool, string, error) {
 
 	md := metadata.New(map[string]string{
 		"user-id": userID,
@@ -161,6 +191,39 @@ func (c *Client) PatchProfile(ctx context.Context, userID string,
 		req.HomeDistrict = &wrapperspb.StringValue{Value: *homeDistrict}
 	}
 
+	if currentLocation != nil {
+		req.CurrentLocation = &wrapperspb.StringValue{Value: *currentLocation}
+	}
+
+	req.AboutMe = stringValue(details.AboutMe)
+	req.AnnualIncome = stringValue(details.AnnualIncome)
+	req.CurrentCity = stringValue(details.CurrentCity)
+	req.CurrentCountry = stringValue(details.CurrentCountry)
+	req.ResidencyStatus = stringValue(details.ResidencyStatus)
+	req.FatherOccupation = stringValue(details.FatherOccupation)
+	req.MotherOccupation = stringValue(details.MotherOccupation)
+	req.FamilyType = stringValue(details.FamilyType)
+
+	req.PrayerFrequency = stringValue(lifestyle.PrayerFrequency)
+	req.Hijab = stringValue(lifestyle.Hijab)
+	req.Beard = stringValue(lifestyle.Beard)
+	req.MadrasaEducation = stringValue(lifestyle.MadrasaEducation)
+	req.Diet = stringValue(lifestyle.Diet)
+	req.Smoking = stringValue(lifestyle.Smoking)
+	req.WillingToRelocate = stringValue(lifestyle.WillingToRelocate)
+
+	if details.Languages != nil {
+		req.Languages = &userpb.LanguageList{Languages: *details.Languages}
+	}
+
+	if details.BrothersCount != nil {
+		req.BrothersCount = &wrapperspb.Int32Value{Value: int32(*details.BrothersCount)}
+	}
+
+	if details.SistersCount != nil {
+		req.SistersCount = &wrapperspb.Int32Value{Value: int32(*details.SistersCount)}
+	}
+
 	resp, err := c.client.PatchProfile(ctx, req)
 	if err != nil {
 		return false, "", err
@@ -199,6 +262,8 @@ func (c *Client) UpdatePartnerPreferences(
 	preferredProfessionTypes []string,
 	preferredEducationLevels []string,
 	preferredHomeDistricts []string,
+	preferredCurrentLocations []string,
+	lifestyle *userpb.LifestylePreferencesData,
 ) (bool, string, error) {
 	md := metadata.New(map[string]string{
 		"user-id": userID,
@@ -213,6 +278,8 @@ func (c *Client) UpdatePartnerPreferences(
 		PreferredProfessionTypes:   preferredProfessionTypes,
 		PreferredEducationLevels:   preferredEducationLevels,
 		PreferredHomeDistricts:     preferredHomeDistricts,
+		PreferredCurrentLocations:  preferredCurrentLocations,
+		Lifestyle:                  lifestyle,
 	}
 
 	if minAgeYears != nil {
@@ -250,12 +317,15 @@ func (c *Client) PatchPartnerPreferences(
 	preferredProfessionTypes []string,
 	preferredEducationLevels []string,
 	preferredHomeDistricts []string,
+	preferredCurrentLocations []string,
 	clearPreferredCommunities bool,
 	clearPreferredMaritalStatus bool,
 	clearPreferredProfessions bool,
 	clearPreferredProfessionTypes bool,
 	clearPreferredEducationLevels bool,
 	clearPreferredHomeDistricts bool,
+	clearPreferredCurrentLocations bool,
+	lifestyle *userpb.LifestylePreferencesData,
 ) (bool, string, error) {
 	md := metadata.New(map[string]string{
 		"user-id": userID,
@@ -263,12 +333,14 @@ func (c *Client) PatchPartnerPreferences(
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	req := &userpb.PatchPartnerPreferencesRequest{
-		ClearPreferredCommunities:     clearPreferredCommunities,
-		ClearPreferredMaritalStatus:   clearPreferredMaritalStatus,
-		ClearPreferredProfessions:     clearPreferredProfessions,
-		ClearPreferredProfessionTypes: clearPreferredProfessionTypes,
-		ClearPreferredEducationLevels: clearPreferredEducationLevels,
-		ClearPreferredHomeDistricts:   clearPreferredHomeDistricts,
+		ClearPreferredCommunities:      clearPreferredCommunities,
+		ClearPreferredMaritalStatus:    clearPreferredMaritalStatus,
+		ClearPreferredProfessions:      clearPreferredProfessions,
+		ClearPreferredProfessionTypes:  clearPreferredProfessionTypes,
+		ClearPreferredEducationLevels:  clearPreferredEducationLevels,
+		ClearPreferredHomeDistricts:    clearPreferredHomeDistricts,
+		ClearPreferredCurrentLocations: clearPreferredCurrentLocations,
+		Lifestyle:                      lifestyle,
 	}
 
 	if minAgeYears != nil {
@@ -305,6 +377,9 @@ func (c *Client) PatchPartnerPreferences(
 	if len(preferredHomeDistricts) > 0 {
 		req.PreferredHomeDistricts = preferredHomeDistricts
 	}
+	if len(preferredCurrentLocations) > 0 {
+		req.PreferredCurrentLocations = preferredCurrentLocations
+	}
 
 	resp, err := c.client.PatchPartnerPreferences(ctx, req)
 	if err != nil {
@@ -596,6 +671,25 @@ func (c *Client) GetDetailedProfile(ctx context.Context, profileID uint64) (bool
 	return resp.Success, resp.Message, resp.Profile, nil
 }
 
+// ListTaxonomyOptions gets the active options of one category, or of all categories when category is empty
+func (c *Client) ListTaxonomyOptions(ctx context.Context, category string) ([]*userpb.TaxonomyOption, error) {
+	resp, err := c.client.ListTaxonomyOptions(ctx, &userpb.ListTaxonomyOptionsRequest{
+		Category: category,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Options, nil
+}
+
 func (c *Client) Close() error {
 	return c.conn.Close()
 }
+
+func stringValue(value *string) *wrapperspb.StringValue {
+	if value == nil {
+		return nil
+	}
+	return &wrapperspb.StringValue{Value: *value}
+}
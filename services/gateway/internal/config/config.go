@@ -3,7 +3,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
 	"github.com/spf13/viper"
 )
 
@@ -14,14 +16,17 @@ type Config struct {
 	Services    ServicesConfig `mapstructure:"services"`
 	Auth        AuthConfig     `mapstructure:"auth"`
 	Tracing     TracingConfig  `mapstructure:"tracing" yaml:"tracing"`
+	DataExport  ExportConfig   `mapstructure:"data_export"`
+	RabbitMQ    RabbitMQConfig `mapstructure:"rabbitmq"`
 }
 
 // HTTPConfig represents HTTP server configuration
 type HTTPConfig struct {
-	Port             int `mapstructure:"port"`
-	ReadTimeoutSecs  int `mapstructure:"read_timeout_secs"`
-	WriteTimeoutSecs int `mapstructure:"write_timeout_secs"`
-	IdleTimeoutSecs  int `mapstructure:"idle_timeout_secs"`
+	Port             int      `mapstructure:"port"`
+	ReadTimeoutSecs  int      `mapstructure:"read_timeout_secs"`
+	WriteTimeoutSecs int      `mapstructure:"write_timeout_secs"`
+	IdleTimeoutSecs  int      `mapstructure:"idle_timeout_secs"`
+	TrustedProxies   []string `mapstructure:"trusted_proxies"` // Load balancer IPs/CIDRs allowed to set X-Forwarded-For; empty trusts none
 }
 
 // ServicesConfig holds addresses of all services
@@ -40,10 +45,20 @@ type AuthConfig struct {
 
 // JWTConfig contains JWT configuration
 type JWTConfig struct {
-	SecretKey          string `mapstructure:"secret_key"`
-	AccessTokenMinutes int    `mapstructure:"access_token_minutes"`
-	RefreshTokenDays   int    `mapstructure:"refresh_token_days"`
-	Issuer             string `mapstructure:"issuer"`
+	SecretKey          string         `mapstructure:"secret_key"` // Only needed to accept HS256 tokens
+	AccessTokenMinutes int            `mapstructure:"access_token_minutes"`
+	RefreshTokenDays   int            `mapstructure:"refresh_token_days"`
+	Issuer             string         `mapstructure:"issuer"`
+	VerificationKeys   []JWTKeyConfig `mapstructure:"verification_keys"`
+}
+
+// JWTKeyConfig points to a public key published by the auth service
+type JWTKeyConfig = jwt.VerificationKeyConfig
+
+// RabbitMQConfig holds the event bus the gateway listens to for account changes
+type RabbitMQConfig struct {
+	DSN          string `mapstructure:"dsn"`
+	ExchangeName string `mapstructure:"exchange_name"`
 }
 
 // ServiceConfig represents a service configuration
@@ -51,6 +66,25 @@ type ServiceConfig struct {
 	Address string `mapstructure:"address"`
 }
 
+// ExportConfig configures personal data exports
+type ExportConfig struct {
+	S3                S3Config `mapstructure:"s3"`
+	LinkExpiryMinutes int      `mapstructure:"link_expiry_minutes"` // How long a download link stays valid
+	JobTimeoutMinutes int      `mapstructure:"job_timeout_minutes"` // How long gathering the data may take
+	CooldownHours     int      `mapstructure:"cooldown_hours"`      // Minimum time between two exports of a user
+	RetentionHours    int      `mapstructure:"retention_hours"`     // How long finished exports are kept in the bucket
+}
+
+// S3Config points to the private bucket export archives are stored in
+type S3Config struct {
+	Endpoint        string `mapstructure:"endpoint"`
+	Region          string `mapstructure:"region"`
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+	BucketName      string `mapstructure:"bucket_name"`
+	UseSSL          bool   `mapstructure:"use_ssl"`
+}
+
 type TracingConfig struct {
 	Enabled     bool    `mapstructure:"enabled" yaml:"enabled"`
 	ServiceName string  `mapstructure:"service_name" yaml:"service_name"`
@@ -97,10 +131,53 @@ func LoadConfig(path string) (*Config, error) {
 		config.Services.Admin.Address = adminAddr
 	}
 
+	// Comma separated, e.g. "10.0.0.0/8,172.16.0.0/12"
+	if proxies := os.Getenv("TRUSTED_PROXIES"); proxies != "" {
+		config.HTTP.TrustedProxies = strings.Split(proxies, ",")
+	}
+
+	// RabbitMQ override, the gateway runs without event handling when no DSN is set
+	if rabbitDSN := os.Getenv("RABBITMQ_DSN"); rabbitDSN != "" {
+		config.RabbitMQ.DSN = rabbitDSN
+	}
+
 	// JWT environment variables
 	if secretKey := os.Getenv("JWT_SECRET_KEY"); secretKey != "" {
 		config.Auth.JWT.SecretKey = secretKey
 	}
 
+	// Data export storage environment variables
+	if endpoint := os.Getenv("S3_ENDPOINT"); endpoint != "" {
+		config.DataExport.S3.Endpoint = endpoint
+	}
+	if region := os.Getenv("S3_REGION"); region != "" {
+		config.DataExport.S3.Region = region
+	}
+	if accessKey := os.Getenv("S3_ACCESS_KEY"); accessKey != "" {
+		config.DataExport.S3.AccessKeyID = accessKey
+	}
+	if secretKey := os.Getenv("S3_SECRET_KEY"); secretKey != "" {
+		config.DataExport.S3.SecretAccessKey = secretKey
+	}
+	if bucketName := os.Getenv("DATA_EXPORT_BUCKET_NAME"); bucketName != "" {
+		config.DataExport.S3.BucketName = bucketName
+	}
+	if useSSL := os.Getenv("S3_USE_SSL"); useSSL == "true" {
+		config.DataExport.S3.UseSSL = true
+	}
+
+	if config.DataExport.LinkExpiryMinutes <= 0 {
+		config.DataExport.LinkExpiryMinutes = 60
+	}
+	if config.DataExport.JobTimeoutMinutes <= 0 {
+		config.DataExport.JobTimeoutMinutes = 10
+	}
+	if config.DataExport.CooldownHours <= 0 {
+		config.DataExport.CooldownHours = 24
+	}
+	if config.DataExport.RetentionHours <= 0 {
+		config.DataExport.RetentionHours = 7 * 24
+	}
+
 	return &config, nil
 }
@@ -0,0 +1,493 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/cdn/s3"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/auth"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/chat"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/payment"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/user"
+)
+
+var (
+	ErrExportNotFound   = errors.New("data export not found")
+	ErrExportInProgress = errors.New("a data export is already in progress")
+	ErrExportTooSoon    = errors.New("a data export was requested recently")
+)
+
+// Status of a data export
+type Status string
+
+const (
+	StatusProcessing Status = "processing"
+	StatusReady      Status = "ready"
+	StatusFailed     Status = "failed"
+)
+
+// pageSize is the largest page every downstream service accepts
+const pageSize = 50
+
+// keyPrefix is where export manifests and archives are stored in the bucket
+const keyPrefix = "data-exports"
+
+// Export describes a data export request. It is stored next to the archive so
+// any gateway instance can report the export's progress.
+type Export struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id"`
+	Status      Status     `json:"status"`
+	RequestedAt time.Time  `json:"requested_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// Exporter gathers a user's data from every service into a ZIP of JSON files,
+// stores it in a private bucket and hands it out through presigned links.
+type Exporter struct {
+	authClient    *auth.Client
+	userClient    *user.Client
+	chatClient    *chat.Client
+	paymentClient *payment.Client
+	storage       *s3.Service
+	jobTimeout    time.Duration
+	linkExpiry    time.Duration
+	cooldown      time.Duration // Minimum time between two exports of a user
+	retention     time.Duration // How long manifests and archives are kept
+	logger        logging.Logger
+}
+
+func NewExporter(
+	authClient *auth.Client,
+	userClient *user.Client,
+	chatClient *chat.Client,
+	paymentClient *payment.Client,
+	storage *s3.Service,
+	jobTimeout time.Duration,
+	linkExpiry time.Duration,
+	cooldown time.Duration,
+	retention time.Duration,
+	logger logging.Logger,
+) *Exporter {
+	return &Exporter{
+		authClient:    authClient,
+		userClient:    userClient,
+		chatClient:    chatClient,
+		paymentClient: paymentClient,
+		storage:       storage,
+		jobTimeout:    jobTimeout,
+		linkExpiry:    linkExpiry,
+		cooldown:      cooldown,
+		retention:     retention,
+		logger:        logger,
+	}
+}
+
+// LinkExpiry is how long a download link stays valid
+func (e *Exporter) LinkExpiry() time.Duration {
+	return e.linkExpiry
+}
+
+// Start records a new export for the user and builds it in the background.
+// Building an export queries every service, so a user gets one at a time and
+// at most one per cooldown; failed exports can be retried right away.
+func (e *Exporter) Start(ctx context.Context, userID string) (*Export, error) {
+	if err := e.checkRateLimit(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	export := &Export{
+		ID:          uuid.New().String(),
+		UserID:      userID,
+		Status:      StatusProcessing,
+		RequestedAt: time.Now(),
+	}
+	if err := e.saveManifest(ctx, export); err != nil {
+		return nil, err
+	}
+
+	go e.run(export)
+
+	e.logger.Info("Data export started", "userID", userID, "exportID", export.ID)
+	return export, nil
+}
+
+// Get returns the user's export with the given ID
+func (e *Exporter) Get(ctx context.Context, userID, exportID string) (*Export, error) {
+	if _, err := uuid.Parse(exportID); err != nil {
+		return nil, ErrExportNotFound
+	}
+
+	data, err := e.storage.DownloadObject(ctx, manifestKey(userID, exportID))
+	if err != nil {
+		if errors.Is(err, s3.ErrObjectNotFound) {
+			return nil, ErrExportNotFound
+		}
+		return nil, fmt.Errorf("failed to load data export: %w", err)
+	}
+
+	var export Export
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("failed to decode data export: %w", err)
+	}
+
+	// The instance building it stopped before finishing
+	if export.Status == StatusProcessing && time.Since(export.RequestedAt) > e.jobTimeout {
+		export.Status = StatusFailed
+	}
+	return &export, nil
+}
+
+// DeleteUserExports removes every export of the user, such as when the account is purged
+func (e *Exporter) DeleteUserExports(ctx context.Context, userID string) error {
+	deleted, err := e.storage.DeletePrefix(ctx, userPrefix(userID))
+	if err != nil {
+		return fmt.Errorf("failed to delete data exports: %w", err)
+	}
+	e.logger.Info("Deleted data exports of user", "userID", userID, "objects", deleted)
+	return nil
+}
+
+// RunCleanup removes manifests and archives older than the retention period
+// at the given interval until the context is cancelled
+func (e *Exporter) RunCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			removed, err := e.removeExpired(ctx)
+			if err != nil {
+				e.logger.Error("Data export cleanup failed", "error", err)
+				continue
+			}
+			if removed > 0 {
+				e.logger.Info("Removed expired data exports", "objects", removed)
+			}
+		}
+	}
+}
+
+func (e *Exporter) removeExpired(ctx context.Context) (int, error) {
+	objects, err := e.storage.ListObjects(ctx, keyPrefix+"/")
+	if err != nil {
+		return 0, err
+	}
+
+	cutoff := time.Now().Add(-e.retention)
+	removed := 0
+	for _, object := range objects {
+		if object.LastModified.After(cutoff) {
+			continue
+		}
+		if err := e.storage.DeleteObject(ctx, object.Key); err != nil {
+			e.logger.Error("Failed to remove expired data export", "key", object.Key, "error", err)
+			continue
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// checkRateLimit rejects a new export while another one is being built or
+// one was requested within the cooldown
+func (e *Exporter) checkRateLimit(ctx context.Context, userID string) error {
+	objects, err := e.storage.ListObjects(ctx, userPrefix(userID))
+	if err != nil {
+		return fmt.Errorf("failed to list data exports: %w", err)
+	}
+
+	for _, object := range objects {
+		exportID, ok := strings.CutSuffix(path.Base(object.Key), ".json")
+		if !ok {
+			continue
+		}
+
+		export, err := e.Get(ctx, userID, exportID)
+		if err != nil {
+			if errors.Is(err, ErrExportNotFound) {
+				continue
+			}
+			return err
+		}
+
+		switch {
+		case export.Status == StatusProcessing:
+			return ErrExportInProgress
+		case export.Status == StatusReady && time.Since(export.RequestedAt) < e.cooldown:
+			return ErrExportTooSoon
+		}
+	}
+	return nil
+}
+
+// DownloadURL returns a presigned link to the export's archive
+func (e *Exporter) DownloadURL(ctx context.Context, export *Export) (string, error) {
+	return e.storage.GetPresignedURL(ctx, archiveKey(export.UserID, export.ID))
+}
+
+func (e *Exporter) run(export *Export) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.jobTimeout)
+	defer cancel()
+
+	// The payment client reads the user from the context
+	ctx = context.WithValue(ctx, "user-id", export.UserID)
+
+	if err := e.build(ctx, export); err != nil {
+		e.logger.Error("Data export failed", "userID", export.UserID, "exportID", export.ID, "error", err)
+		export.Status = StatusFailed
+	} else {
+		e.logger.Info("Data export ready", "userID", export.UserID, "exportID", export.ID)
+		export.Status = StatusReady
+	}
+
+	// Saved even when the build ran out of time
+	now := time.Now()
+	export.CompletedAt = &now
+	if err := e.saveManifest(context.Background(), export); err != nil {
+		e.logger.Error("Failed to save data export", "userID", export.UserID, "exportID", export.ID, "error", err)
+	}
+}
+
+func (e *Exporter) build(ctx context.Context, export *Export) error {
+	files, err := e.collect(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, f := range files {
+		data, err := json.MarshalIndent(f.content, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", f.name, err)
+		}
+		w, err := archive.Create(f.name)
+		if err != nil {
+			return fmt.Errorf("failed to add %s: %w", f.name, err)
+		}
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+
+	return e.storage.UploadObject(ctx, archiveKey(export.UserID, export.ID), buf.Bytes(), "application/zip")
+}
+
+type file struct {
+	name    string
+	content interface{}
+}
+
+// collect gathers the user's data from every service, one JSON file per kind of data
+func (e *Exporter) collect(ctx context.Context, userID string) ([]file, error) {
+	account, err := e.authClient.GetUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	_, _, profile, err := e.userClient.GetProfile(ctx, userID)
+	if err != nil && !isNotFound(err) {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+
+	_, _, preferences, err := e.userClient.GetPartnerPreferences(ctx, userID)
+	if err != nil && !isNotFound(err) {
+		return nil, fmt.Errorf("failed to get partner preferences: %w", err)
+	}
+
+	_, _, photos, err := e.userClient.GetUserPhotos(ctx, userID)
+	if err != nil && !isNotFound(err) {
+		return nil, fmt.Errorf("failed to get photos: %w", err)
+	}
+
+	_, _, video, err := e.userClient.GetUserVideo(ctx, userID)
+	if err != nil && !isNotFound(err) {
+		return nil, fmt.Errorf("failed to get video: %w", err)
+	}
+
+	matches, err := e.collectMatches(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	conversations, err := e.collectConversations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := e.collectPayments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []file{
+		{name: "account.json", content: protoJSON(account)},
+		{name: "profile.json", content: protoJSON(profile)},
+		{name: "partner_preferences.json", content: protoJSON(preferences)},
+		{name: "photos.json", content: protoList(photos)},
+		{name: "video.json", content: protoJSON(video)},
+		{name: "matches.json", content: matches},
+		{name: "chats.json", content: conversations},
+		{name: "payments.json", content: payments},
+	}, nil
+}
+
+func (e *Exporter) collectMatches(ctx context.Context, userID string) (map[string]interface{}, error) {
+	history := []json.RawMessage{}
+	for offset := 0; ; offset += pageSize {
+		_, _, items, pagination, err := e.userClient.GetMatchHistory(ctx, userID, "", pageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get match history: %w", err)
+		}
+		history = append(history, protoList(items)...)
+		if pagination == nil || !pagination.HasMore {
+			break
+		}
+	}
+
+	mutual := []json.RawMessage{}
+	for offset := 0; ; offset += pageSize {
+		_, _, items, pagination, err := e.userClient.GetMutualMatches(ctx, userID, pageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mutual matches: %w", err)
+		}
+		mutual = append(mutual, protoList(items)...)
+		if pagination == nil || !pagination.HasMore {
+			break
+		}
+	}
+
+	return map[string]interface{}{
+		"history": history,
+		"mutual":  mutual,
+	}, nil
+}
+
+func (e *Exporter) collectConversations(ctx context.Context, userID string) ([]map[string]interface{}, error) {
+	conversations := []map[string]interface{}{}
+	for offset := int32(0); ; offset += pageSize {
+		summaries, pagination, err := e.chatClient.GetUserConversations(ctx, userID, pageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get conversations: %w", err)
+		}
+
+		for _, summary := range summaries {
+			messages := []json.RawMessage{}
+			for msgOffset := int32(0); ; msgOffset += pageSize {
+				items, msgPagination, err := e.chatClient.GetMessages(ctx, userID, summary.Id, pageSize, msgOffset)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get messages of conversation %s: %w", summary.Id, err)
+				}
+				messages = append(messages, protoList(items)...)
+				if msgPagination == nil || !msgPagination.HasMore {
+					break
+				}
+			}
+
+			conversations = append(conversations, map[string]interface{}{
+				"conversation": protoJSON(summary),
+				"messages":     messages,
+			})
+		}
+
+		if pagination == nil || !pagination.HasMore {
+			break
+		}
+	}
+
+	return conversations, nil
+}
+
+func (e *Exporter) collectPayments(ctx context.Context) (map[string]interface{}, error) {
+	_, _, subscription, err := e.paymentClient.GetSubscriptionStatus(ctx)
+	if err != nil && !isNotFound(err) {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	history := []json.RawMessage{}
+	for offset := int32(0); ; offset += pageSize {
+		_, _, items, pagination, err := e.paymentClient.GetPaymentHistory(ctx, pageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get payment history: %w", err)
+		}
+		history = append(history, protoList(items)...)
+		if pagination == nil || !pagination.HasMore {
+			break
+		}
+	}
+
+	return map[string]interface{}{
+		"subscription": protoJSON(subscription),
+		"payments":     history,
+	}, nil
+}
+
+func (e *Exporter) saveManifest(ctx context.Context, export *Export) error {
+	data, err := json.Marshal(export)
+	if err != nil {
+		return fmt.Errorf("failed to encode data export: %w", err)
+	}
+	if err := e.storage.UploadObject(ctx, manifestKey(export.UserID, export.ID), data, "application/json"); err != nil {
+		return fmt.Errorf("failed to save data export: %w", err)
+	}
+	return nil
+}
+
+func userPrefix(userID string) string {
+	return path.Join(keyPrefix, userID) + "/"
+}
+
+func manifestKey(userID, exportID string) string {
+	return path.Join(keyPrefix, userID, exportID+".json")
+}
+
+func archiveKey(userID, exportID string) string {
+	return path.Join(keyPrefix, userID, exportID+".zip")
+}
+
+func isNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+var jsonOptions = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// protoJSON encodes a proto message with its proto field names, or as null when it is missing
+func protoJSON(m proto.Message) json.RawMessage {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return json.RawMessage("null")
+	}
+	data, err := jsonOptions.Marshal(m)
+	if err != nil {
+		return json.RawMessage("null")
+	}
+	return data
+}
+
+func protoList[T proto.Message](items []T) []json.RawMessage {
+	list := make([]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		list = append(list, protoJSON(item))
+	}
+	return list
+}
@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	adminpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/admin/v1"
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// GetUserAuthEvents handles GET /api/v1/admin/users/{id}/auth-events
+func (h *Handler) GetUserAuthEvents(c *gin.Context) {
+	var req GetUserAuthEventsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Invalid get user auth events request parameters", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request parameters", err))
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	userID := c.Param("id")
+	resp, err := h.adminClient.GetUserAuthEvents(c.Request.Context(), actorID, &adminpb.GetUserAuthEventsRequest{
+		UserId:    userID,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+		EventType: req.EventType,
+		Outcome:   req.Outcome,
+	})
+	if err != nil {
+		h.logger.Error("Failed to get user auth events", "error", err, "actorID", actorID, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	events := make([]AuthEventResponse, len(resp.Events))
+	for i, event := range resp.Events {
+		events[i] = AuthEventResponse{
+			ID:         event.Id,
+			EventType:  event.EventType,
+			Identifier: event.Identifier,
+			IPAddress:  event.IpAddress,
+			UserAgent:  event.UserAgent,
+			Outcome:    event.Outcome,
+			Reason:     event.Reason,
+			CreatedAt:  event.CreatedAt.AsTime(),
+		}
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, GetUserAuthEventsResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Events:  events,
+		Pagination: PaginationResponse{
+			Total:       resp.Pagination.Total,
+			Limit:       resp.Pagination.Limit,
+			Offset:      resp.Pagination.Offset,
+			HasMore:     resp.Pagination.HasMore,
+			TotalPages:  resp.Pagination.TotalPages,
+			CurrentPage: resp.Pagination.CurrentPage,
+		},
+	})
+}
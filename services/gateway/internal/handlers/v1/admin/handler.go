@@ -11,19 +11,22 @@ import (
 	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/admin"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/payment"
 )
 
 // Handler handles admin-related HTTP requests
 type Handler struct {
-	adminClient *admin.Client
-	logger      logging.Logger
+	adminClient   *admin.Client
+	paymentClient *payment.Client
+	logger        logging.Logger
 }
 
 // NewHandler creates a new admin handler
-func NewHandler(adminClient *admin.Client, logger logging.Logger) *Handler {
+func NewHandler(adminClient *admin.Client, paymentClient *payment.Client, logger logging.Logger) *Handler {
 	return &Handler{
-		adminClient: adminClient,
-		logger:      logger,
+		adminClient:   adminClient,
+		paymentClient: paymentClient,
+		logger:        logger,
 	}
 }
 
@@ -169,18 +172,7 @@ func (h *Handler) convertGetUserResponse(resp *adminpb.GetUserResponse) GetUserR
 		Success: resp.Success,
 		Message: resp.Message,
 		User: UserDetailsResponse{
-			Auth: AuthDataResponse{
-				ID:           resp.User.Auth.Id,
-				Email:        resp.User.Auth.Email,
-				Phone:        resp.User.Auth.Phone,
-				Verified:     resp.User.Auth.Verified,
-				IsActive:     resp.User.Auth.IsActive,
-				IsPremium:    resp.User.Auth.IsPremium,
-				PremiumUntil: timeFromProto(resp.User.Auth.PremiumUntil),
-				LastLoginAt:  timeFromProto(resp.User.Auth.LastLoginAt),
-				CreatedAt:    resp.User.Auth.CreatedAt.AsTime(),
-				UpdatedAt:    resp.User.Auth.UpdatedAt.AsTime(),
-			},
+			Auth: convertAuthData(resp.User.Auth),
 		},
 	}
 
@@ -249,6 +241,25 @@ func (h *Handler) convertGetUserResponse(resp *adminpb.GetUserResponse) GetUserR
 	return response
 }
 
+func convertAuthData(auth *adminpb.AuthData) AuthDataResponse {
+	return AuthDataResponse{
+		ID:               auth.Id,
+		Email:            auth.Email,
+		Phone:            auth.Phone,
+		Verified:         auth.Verified,
+		IsActive:         auth.IsActive,
+		IsPremium:        auth.IsPremium,
+		PremiumUntil:     timeFromProto(auth.PremiumUntil),
+		LastLoginAt:      timeFromProto(auth.LastLoginAt),
+		CreatedAt:        auth.CreatedAt.AsTime(),
+		UpdatedAt:        auth.UpdatedAt.AsTime(),
+		IsSuspended:      auth.IsSuspended,
+		IsBanned:         auth.IsBanned,
+		SuspensionReason: auth.SuspensionReason,
+		SuspendedUntil:   timeFromProto(auth.SuspendedUntil),
+	}
+}
+
 // Helper function to convert protobuf timestamp to Go time
 func timeFromProto(ts *timestamppb.Timestamp) *time.Time {
 	if ts == nil {
@@ -0,0 +1,124 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	adminpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/admin/v1"
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// SuspendUser handles POST /api/v1/admin/users/{id}/suspend
+func (h *Handler) SuspendUser(c *gin.Context) {
+	var req SuspendUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid suspend user request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	pbReq := &adminpb.SuspendUserRequest{
+		UserId: c.Param("id"),
+		Reason: req.Reason,
+	}
+	if req.SuspendedUntil != nil {
+		pbReq.SuspendedUntil = timestamppb.New(*req.SuspendedUntil)
+	}
+
+	resp, err := h.adminClient.SuspendUser(c.Request.Context(), actorID, pbReq)
+	if err != nil {
+		h.logger.Error("Failed to suspend user", "error", err, "actorID", actorID, "userID", pbReq.UserId)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, convertAuthData(resp.User))
+}
+
+// BanUser handles POST /api/v1/admin/users/{id}/ban
+func (h *Handler) BanUser(c *gin.Context) {
+	var req BanUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid ban user request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	userID := c.Param("id")
+	resp, err := h.adminClient.BanUser(c.Request.Context(), actorID, &adminpb.BanUserRequest{
+		UserId: userID,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		h.logger.Error("Failed to ban user", "error", err, "actorID", actorID, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, convertAuthData(resp.User))
+}
+
+// GrantPremium handles POST /api/v1/admin/users/{id}/premium
+func (h *Handler) GrantPremium(c *gin.Context) {
+	var req GrantPremiumRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid grant premium request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	userID := c.Param("id")
+	resp, err := h.adminClient.GrantPremium(c.Request.Context(), actorID, &adminpb.GrantPremiumRequest{
+		UserId:       userID,
+		PremiumUntil: timestamppb.New(req.PremiumUntil),
+	})
+	if err != nil {
+		h.logger.Error("Failed to grant premium", "error", err, "actorID", actorID, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, convertAuthData(resp.User))
+}
+
+// ReinstateUser handles POST /api/v1/admin/users/{id}/reinstate
+func (h *Handler) ReinstateUser(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	userID := c.Param("id")
+	resp, err := h.adminClient.ReinstateUser(c.Request.Context(), actorID, &adminpb.ReinstateUserRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		h.logger.Error("Failed to reinstate user", "error", err, "actorID", actorID, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, convertAuthData(resp.User))
+}
@@ -0,0 +1,67 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// GetUserPayments handles GET /api/v1/admin/users/{id}/payments
+func (h *Handler) GetUserPayments(c *gin.Context) {
+	var req GetUserPaymentsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Invalid get user payments request parameters", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request parameters", err))
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	userID := c.Param("id")
+	success, message, payments, pagination, err := h.paymentClient.GetUserPaymentHistory(
+		c.Request.Context(),
+		userID,
+		req.Limit,
+		req.Offset,
+	)
+	if err != nil {
+		h.logger.Error("Failed to get user payments", "error", err, "actorID", actorID, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	h.logger.Info("Admin viewed user payments", "actorID", actorID, "userID", userID, "count", len(payments))
+
+	responsePayments := make([]gin.H, len(payments))
+	for i, payment := range payments {
+		responsePayments[i] = gin.H{
+			"id":                  payment.Id,
+			"razorpay_order_id":   payment.RazorpayOrderId,
+			"razorpay_payment_id": payment.RazorpayPaymentId,
+			"amount":              payment.Amount,
+			"currency":            payment.Currency,
+			"status":              payment.Status,
+			"payment_method":      payment.PaymentMethod,
+			"created_at":          payment.CreatedAt.AsTime(),
+		}
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, gin.H{
+		"success":  success,
+		"message":  message,
+		"payments": responsePayments,
+		"pagination": gin.H{
+			"limit":    pagination.Limit,
+			"offset":   pagination.Offset,
+			"total":    pagination.Total,
+			"has_more": pagination.HasMore,
+		},
+	})
+}
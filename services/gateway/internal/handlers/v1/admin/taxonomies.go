@@ -0,0 +1,187 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	adminpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/admin/v1"
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// ListTaxonomyOptions handles GET /api/v1/admin/taxonomies
+func (h *Handler) ListTaxonomyOptions(c *gin.Context) {
+	var req ListTaxonomyOptionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Invalid list taxonomy options request parameters", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request parameters", err))
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	resp, err := h.adminClient.ListTaxonomyOptions(c.Request.Context(), actorID, &adminpb.ListTaxonomyOptionsRequest{
+		Category: req.Category,
+	})
+	if err != nil {
+		h.logger.Error("Failed to list taxonomy options", "error", err, "actorID", actorID, "category", req.Category)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	options := make([]TaxonomyOptionResponse, len(resp.Options))
+	for i, option := range resp.Options {
+		options[i] = convertTaxonomyOption(option)
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, options)
+}
+
+// CreateTaxonomyOption handles POST /api/v1/admin/taxonomies
+func (h *Handler) CreateTaxonomyOption(c *gin.Context) {
+	var req CreateTaxonomyOptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid create taxonomy option request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	resp, err := h.adminClient.CreateTaxonomyOption(c.Request.Context(), actorID, &adminpb.CreateTaxonomyOptionRequest{
+		Category:    req.Category,
+		Value:       req.Value,
+		Label:       req.Label,
+		SortOrder:   req.SortOrder,
+		Parent:      req.Parent,
+		Level:       req.Level,
+		Coordinates: toLocationCoordinates(req.Coordinates),
+		Adjacent:    req.Adjacent,
+	})
+	if err != nil {
+		h.logger.Error("Failed to create taxonomy option", "error", err, "actorID", actorID, "category", req.Category, "value", req.Value)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusCreated, resp.Message, convertTaxonomyOption(resp.Option))
+}
+
+// UpdateTaxonomyOption handles PATCH /api/v1/admin/taxonomies/{id}
+func (h *Handler) UpdateTaxonomyOption(c *gin.Context) {
+	optionID, ok := parseTaxonomyOptionID(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateTaxonomyOptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid update taxonomy option request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	resp, err := h.adminClient.UpdateTaxonomyOption(c.Request.Context(), actorID, &adminpb.UpdateTaxonomyOptionRequest{
+		Id:             optionID,
+		Label:          req.Label,
+		SortOrder:      req.SortOrder,
+		IsActive:       req.IsActive,
+		Parent:         req.Parent,
+		Level:          req.Level,
+		Coordinates:    toLocationCoordinates(req.Coordinates),
+		AddAdjacent:    req.AddAdjacent,
+		RemoveAdjacent: req.RemoveAdjacent,
+	})
+	if err != nil {
+		h.logger.Error("Failed to update taxonomy option", "error", err, "actorID", actorID, "optionID", optionID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, convertTaxonomyOption(resp.Option))
+}
+
+// DeleteTaxonomyOption handles DELETE /api/v1/admin/taxonomies/{id}.
+// The option is deactivated rather than removed, since profiles may use it.
+func (h *Handler) DeleteTaxonomyOption(c *gin.Context) {
+	optionID, ok := parseTaxonomyOptionID(c)
+	if !ok {
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	resp, err := h.adminClient.DeleteTaxonomyOption(c.Request.Context(), actorID, &adminpb.DeleteTaxonomyOptionRequest{
+		Id: optionID,
+	})
+	if err != nil {
+		h.logger.Error("Failed to delete taxonomy option", "error", err, "actorID", actorID, "optionID", optionID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, convertTaxonomyOption(resp.Option))
+}
+
+// parseTaxonomyOptionID reads the option ID from the path, writing a 400 when it is invalid
+func parseTaxonomyOptionID(c *gin.Context) (uint64, bool) {
+	optionID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || optionID == 0 {
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid option ID", nil))
+		return 0, false
+	}
+	return optionID, true
+}
+
+func convertTaxonomyOption(option *adminpb.TaxonomyOption) TaxonomyOptionResponse {
+	if option == nil {
+		return TaxonomyOptionResponse{}
+	}
+	response := TaxonomyOptionResponse{
+		ID:        option.Id,
+		Category:  option.Category,
+		Value:     option.Value,
+		Label:     option.Label,
+		SortOrder: option.SortOrder,
+		IsActive:  option.IsActive,
+		Parent:    option.Parent,
+		Level:     option.Level,
+	}
+	if option.Coordinates != nil {
+		response.Coordinates = &LocationCoordinatesResponse{
+			Latitude:  option.Coordinates.Latitude,
+			Longitude: option.Coordinates.Longitude,
+		}
+	}
+	return response
+}
+
+func toLocationCoordinates(coordinates *LocationCoordinatesRequest) *adminpb.LocationCoordinates {
+	if coordinates == nil || coordinates.Latitude == nil || coordinates.Longitude == nil {
+		return nil
+	}
+	return &adminpb.LocationCoordinates{
+		Latitude:  *coordinates.Latitude,
+		Longitude: *coordinates.Longitude,
+	}
+}
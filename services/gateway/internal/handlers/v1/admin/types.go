@@ -70,6 +70,121 @@ type AuthDataResponse struct {
 	LastLoginAt  *time.Time `json:"last_login_at,omitempty"`
 	CreatedAt    time.Time  `json:"created_at"`
 	UpdatedAt    time.Time  `json:"updated_at"`
+
+	IsSuspended      bool       `json:"is_suspended"`
+	IsBanned         bool       `json:"is_banned"`
+	SuspensionReason string     `json:"suspension_reason,omitempty"`
+	SuspendedUntil   *time.Time `json:"suspended_until,omitempty"`
+}
+
+// SuspendUserRequest represents the request body for suspending a user
+type SuspendUserRequest struct {
+	Reason         string     `json:"reason" binding:"required"`
+	SuspendedUntil *time.Time `json:"suspended_until"` // Optional, the suspension lasts until lifted when omitted
+}
+
+// BanUserRequest represents the request body for banning a user
+type BanUserRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// GrantPremiumRequest represents the request body for granting premium membership
+type GrantPremiumRequest struct {
+	PremiumUntil time.Time `json:"premium_until" binding:"required"`
+}
+
+// GetUserAuthEventsRequest represents the query parameters for a user's authentication events
+type GetUserAuthEventsRequest struct {
+	Limit     int32  `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset    int32  `form:"offset,default=0" binding:"min=0"`
+	EventType string `form:"event_type"`
+	Outcome   string `form:"outcome" binding:"omitempty,oneof=success failure"`
+}
+
+// GetUserPaymentsRequest represents the query parameters for a user's payment history
+type GetUserPaymentsRequest struct {
+	Limit  int32 `form:"limit,default=10" binding:"min=1,max=50"`
+	Offset int32 `form:"offset,default=0" binding:"min=0"`
+}
+
+// GetUserAuthEventsResponse represents the API response for a user's authentication events
+type GetUserAuthEventsResponse struct {
+	Success    bool                `json:"success"`
+	Message    string              `json:"message"`
+	Events     []AuthEventResponse `json:"events"`
+	Pagination PaginationResponse  `json:"pagination"`
+}
+
+// AuthEventResponse represents one recorded authentication attempt
+type AuthEventResponse struct {
+	ID         int64     `json:"id"`
+	EventType  string    `json:"event_type"`
+	Identifier string    `json:"identifier,omitempty"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	Outcome    string    `json:"outcome"`
+	Reason     string    `json:"reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ListTaxonomyOptionsRequest represents the query parameters for listing taxonomy options
+type ListTaxonomyOptionsRequest struct {
+	Category string `form:"category" binding:"omitempty,oneof=community profession education_level location"`
+}
+
+// CreateTaxonomyOptionRequest represents the request body for adding a taxonomy option
+type CreateTaxonomyOptionRequest struct {
+	Category  string `json:"category" binding:"required,oneof=community profession education_level location"`
+	Value     string `json:"value" binding:"required,max=100"`
+	Label     string `json:"label" binding:"required,max=200"`
+	SortOrder int32  `json:"sort_order"`
+	// Locations only: the level, and below country level the value of the broader location
+	Parent string `json:"parent" binding:"omitempty,max=100"`
+	Level  string `json:"level" binding:"omitempty,oneof=country state district city"`
+	// Locations only, optional: the centroid and the values of bordering locations
+	Coordinates *LocationCoordinatesRequest `json:"coordinates"`
+	Adjacent    []string                    `json:"adjacent" binding:"omitempty,dive,required,max=100"`
+}
+
+// LocationCoordinatesRequest represents the centroid of a location
+type LocationCoordinatesRequest struct {
+	Latitude  *float64 `json:"latitude" binding:"required,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" binding:"required,min=-180,max=180"`
+}
+
+// UpdateTaxonomyOptionRequest represents the request body for changing a taxonomy option.
+// Omitted fields are left unchanged.
+type UpdateTaxonomyOptionRequest struct {
+	Label     *string `json:"label" binding:"omitempty,max=200"`
+	SortOrder *int32  `json:"sort_order"`
+	IsActive  *bool   `json:"is_active"`
+	// Locations only: an empty parent removes it
+	Parent *string `json:"parent" binding:"omitempty,max=100"`
+	Level  *string `json:"level" binding:"omitempty,oneof=country state district city"`
+	// Locations only: the centroid, and the values of locations that now border it or no longer do
+	Coordinates    *LocationCoordinatesRequest `json:"coordinates"`
+	AddAdjacent    []string                    `json:"add_adjacent" binding:"omitempty,dive,required,max=100"`
+	RemoveAdjacent []string                    `json:"remove_adjacent" binding:"omitempty,dive,required,max=100"`
+}
+
+// TaxonomyOptionResponse represents one taxonomy option
+type TaxonomyOptionResponse struct {
+	ID        uint64 `json:"id"`
+	Category  string `json:"category"`
+	Value     string `json:"value"`
+	Label     string `json:"label"`
+	SortOrder int32  `json:"sort_order"`
+	IsActive  bool   `json:"is_active"`
+	Parent    string `json:"parent,omitempty"`
+	Level     string `json:"level,omitempty"`
+
+	Coordinates *LocationCoordinatesResponse `json:"coordinates,omitempty"`
+}
+
+// LocationCoordinatesResponse represents the centroid of a location
+type LocationCoordinatesResponse struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
 }
 
 // DetailedProfileDataResponse represents user profile data
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// DeactivateAccountRequest defines the request body for deactivating an account
+type DeactivateAccountRequest struct {
+	Password string `json:"password" binding:"required"`
+}
+
+// DeactivateAccount hides the authenticated user's profile until they log in again or reactivate it
+func (h *Handler) DeactivateAccount(c *gin.Context) {
+	var req DeactivateAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid deactivate account request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	success, message, err := h.authClient.DeactivateAccount(c.Request.Context(), userID.(string), req.Password)
+	if err != nil {
+		h.logger.Error("Deactivate account failed", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, gin.H{
+		"success": success,
+	})
+}
+
+// ReactivateAccount makes the authenticated user's deactivated profile visible again
+func (h *Handler) ReactivateAccount(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	success, message, err := h.authClient.ReactivateAccount(c.Request.Context(), userID.(string))
+	if err != nil {
+		h.logger.Error("Reactivate account failed", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, gin.H{
+		"success": success,
+	})
+}
@@ -0,0 +1,212 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	authpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/auth/v1"
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// AdminResponse describes an admin account
+type AdminResponse struct {
+	ID               string    `json:"id"`
+	Email            string    `json:"email"`
+	Role             string    `json:"role"`
+	IsActive         bool      `json:"is_active"`
+	TwoFactorEnabled bool      `json:"two_factor_enabled"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// CreateAdminRequest defines the request body for creating an admin
+type CreateAdminRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+	Role     string `json:"role" binding:"required"`
+}
+
+// InviteAdminRequest defines the request body for inviting an admin
+type InviteAdminRequest struct {
+	Email string `json:"email" binding:"required"`
+	Role  string `json:"role" binding:"required"`
+}
+
+// AcceptAdminInviteRequest defines the request body for accepting an admin invite
+type AcceptAdminInviteRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// ResetAdminPasswordRequest defines the request body for resetting an admin's password
+type ResetAdminPasswordRequest struct {
+	NewPassword    string `json:"new_password" binding:"required"`
+	ResetTwoFactor bool   `json:"reset_two_factor"` // Also remove the admin's authenticator app
+}
+
+// ListAdmins returns all admin accounts
+func (h *Handler) ListAdmins(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	admins, err := h.authClient.ListAdmins(c.Request.Context(), actorID)
+	if err != nil {
+		h.logger.Error("List admins failed", "error", err, "actorID", actorID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	responseAdmins := make([]AdminResponse, len(admins))
+	for i, admin := range admins {
+		responseAdmins[i] = toAdminResponse(admin)
+	}
+
+	pkghttp.Success(c, http.StatusOK, "Admins retrieved successfully", gin.H{
+		"admins": responseAdmins,
+	})
+}
+
+// CreateAdmin creates an active admin account
+func (h *Handler) CreateAdmin(c *gin.Context) {
+	var req CreateAdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid create admin request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	if !validation.ValidateEmail(req.Email) {
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid email format", nil))
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	resp, err := h.authClient.CreateAdmin(c.Request.Context(), actorID, req.Email, req.Password, req.Role)
+	if err != nil {
+		h.logger.Error("Create admin failed", "error", err, "actorID", actorID, "email", req.Email)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusCreated, resp.Message, toAdminResponse(resp.Admin))
+}
+
+// InviteAdmin emails an invite link for a new admin account
+func (h *Handler) InviteAdmin(c *gin.Context) {
+	var req InviteAdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid invite admin request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	if !validation.ValidateEmail(req.Email) {
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid email format", nil))
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	resp, err := h.authClient.InviteAdmin(c.Request.Context(), actorID, req.Email, req.Role)
+	if err != nil {
+		h.logger.Error("Invite admin failed", "error", err, "actorID", actorID, "email", req.Email)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusCreated, resp.Message, gin.H{
+		"expires_at": resp.ExpiresAt.AsTime(),
+	})
+}
+
+// AcceptAdminInvite creates the invited admin account with the chosen password
+func (h *Handler) AcceptAdminInvite(c *gin.Context) {
+	var req AcceptAdminInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid accept admin invite request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	resp, err := h.authClient.AcceptAdminInvite(c.Request.Context(), req.Token, req.Password)
+	if err != nil {
+		h.logger.Error("Accept admin invite failed", "error", err)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusCreated, resp.Message, toAdminResponse(resp.Admin))
+}
+
+// DeactivateAdmin disables an admin account and signs it out
+func (h *Handler) DeactivateAdmin(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	adminID := c.Param("id")
+	resp, err := h.authClient.DeactivateAdmin(c.Request.Context(), actorID, adminID)
+	if err != nil {
+		h.logger.Error("Deactivate admin failed", "error", err, "actorID", actorID, "adminID", adminID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, toAdminResponse(resp.Admin))
+}
+
+// ResetAdminPassword sets a new password for an admin account and signs it out
+func (h *Handler) ResetAdminPassword(c *gin.Context) {
+	var req ResetAdminPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid reset admin password request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	adminID := c.Param("id")
+	resp, err := h.authClient.ResetAdminPassword(c.Request.Context(), actorID, adminID, req.NewPassword, req.ResetTwoFactor)
+	if err != nil {
+		h.logger.Error("Reset admin password failed", "error", err, "actorID", actorID, "adminID", adminID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, toAdminResponse(resp.Admin))
+}
+
+func toAdminResponse(admin *authpb.AdminData) AdminResponse {
+	return AdminResponse{
+		ID:               admin.Id,
+		Email:            admin.Email,
+		Role:             admin.Role,
+		IsActive:         admin.IsActive,
+		TwoFactorEnabled: admin.TwoFactorEnabled,
+		CreatedAt:        admin.CreatedAt.AsTime(),
+		UpdatedAt:        admin.UpdatedAt.AsTime(),
+	}
+}
@@ -25,7 +25,7 @@ func (h *Handler) AdminLogin(c *gin.Context) {
 	}
 
 	// Call auth service with admin login
-	success, accessToken, refreshToken, message, expiresIn, err := h.authClient.AdminLogin(c.Request.Context(), req.Email, req.Password)
+	resp, err := h.authClient.AdminLogin(c.Request.Context(), req.Email, req.Password, c.ClientIP(), c.Request.UserAgent())
 	if err != nil {
 		h.logger.Error("Admin login failed", "error", err, "email", req.Email)
 		pkghttp.Error(c, pkghttp.FromGRPCError(err))
@@ -33,13 +33,16 @@ func (h *Handler) AdminLogin(c *gin.Context) {
 	}
 
 	response := LoginResponse{
-		Success:      success,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresIn:    expiresIn,
-		Message:      message,
+		Success:                     resp.Success,
+		AccessToken:                 resp.AccessToken,
+		RefreshToken:                resp.RefreshToken,
+		ExpiresIn:                   resp.ExpiresIn,
+		Message:                     resp.Message,
+		TwoFactorRequired:           resp.TwoFactorRequired,
+		TwoFactorToken:              resp.TwoFactorToken,
+		TwoFactorEnrollmentRequired: resp.TwoFactorEnrollmentRequired,
 	}
 
 	// Return success response with 200 OK status
-	pkghttp.Success(c, http.StatusOK, message, response)
+	pkghttp.Success(c, http.StatusOK, resp.Message, response)
 }
@@ -31,7 +31,7 @@ func (h *Handler) AdminLogout(c *gin.Context) {
 
 	// Call auth service to logout - we can reuse the existing logout method
 	// as it handles token invalidation regardless of the token type/role
-	_, _, err := h.authClient.Logout(c.Request.Context(), accessToken)
+	_, _, err := h.authClient.Logout(c.Request.Context(), accessToken, c.ClientIP(), c.Request.UserAgent())
 	if err != nil {
 		h.logger.Error("Admin logout failed", "error", err)
 		pkghttp.Error(c, pkghttp.FromGRPCError(err))
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+)
+
+// AdminEnroll2FARequest defines the request body for starting authenticator enrollment
+type AdminEnroll2FARequest struct {
+	TwoFactorToken string `json:"two_factor_token" binding:"required"`
+}
+
+// AdminEnroll2FAResponse defines the response body for authenticator enrollment
+type AdminEnroll2FAResponse struct {
+	Secret          string   `json:"secret"`
+	ProvisioningURI string   `json:"provisioning_uri"` // Render as a QR code for the authenticator app
+	RecoveryCodes   []string `json:"recovery_codes"`
+}
+
+// AdminVerify2FARequest defines the request body for the second admin login step
+type AdminVerify2FARequest struct {
+	TwoFactorToken string `json:"two_factor_token" binding:"required"`
+	Code           string `json:"code" binding:"required"` // TOTP code or recovery code
+}
+
+// AdminEnroll2FA returns a new TOTP secret and recovery codes for an admin who has not enrolled yet
+func (h *Handler) AdminEnroll2FA(c *gin.Context) {
+	var req AdminEnroll2FARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid admin 2FA enrollment request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	resp, err := h.authClient.AdminEnroll2FA(c.Request.Context(), req.TwoFactorToken)
+	if err != nil {
+		h.logger.Error("Admin 2FA enrollment failed", "error", err)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, AdminEnroll2FAResponse{
+		Secret:          resp.Secret,
+		ProvisioningURI: resp.ProvisioningUri,
+		RecoveryCodes:   resp.RecoveryCodes,
+	})
+}
+
+// AdminVerify2FA completes an admin login with a TOTP or recovery code
+func (h *Handler) AdminVerify2FA(c *gin.Context) {
+	var req AdminVerify2FARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid admin 2FA verification request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	success, accessToken, refreshToken, message, expiresIn, err := h.authClient.AdminVerify2FA(c.Request.Context(), req.TwoFactorToken, req.Code, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		h.logger.Error("Admin 2FA verification failed", "error", err)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	response := LoginResponse{
+		Success:      success,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    expiresIn,
+		Message:      message,
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, response)
+}
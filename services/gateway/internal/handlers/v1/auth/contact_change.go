@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// RequestEmailChangeRequest defines the request body for changing the email address
+type RequestEmailChangeRequest struct {
+	NewEmail string `json:"new_email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// RequestPhoneChangeRequest defines the request body for changing the phone number
+type RequestPhoneChangeRequest struct {
+	NewPhone string `json:"new_phone" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// ConfirmContactChangeRequest defines the request body for confirming an email or phone change
+type ConfirmContactChangeRequest struct {
+	OTP string `json:"otp" binding:"required"`
+}
+
+// RequestEmailChange sends a verification OTP to the authenticated user's new email address
+func (h *Handler) RequestEmailChange(c *gin.Context) {
+	var req RequestEmailChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid email change request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	success, message, err := h.authClient.RequestEmailChange(c.Request.Context(), userID.(string), req.NewEmail, req.Password)
+	if err != nil {
+		h.logger.Error("Email change request failed", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusAccepted, message, gin.H{
+		"success": success,
+	})
+}
+
+// ConfirmEmailChange applies the email change once the OTP sent to the new address is entered
+func (h *Handler) ConfirmEmailChange(c *gin.Context) {
+	var req ConfirmContactChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid confirm email change request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	success, message, err := h.authClient.ConfirmEmailChange(c.Request.Context(), userID.(string), req.OTP, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		h.logger.Error("Confirm email change failed", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, gin.H{
+		"success": success,
+	})
+}
+
+// RequestPhoneChange texts a verification OTP to the authenticated user's new phone number
+func (h *Handler) RequestPhoneChange(c *gin.Context) {
+	var req RequestPhoneChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid phone change request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	success, message, err := h.authClient.RequestPhoneChange(c.Request.Context(), userID.(string), req.NewPhone, req.Password)
+	if err != nil {
+		h.logger.Error("Phone change request failed", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusAccepted, message, gin.H{
+		"success": success,
+	})
+}
+
+// ConfirmPhoneChange applies the phone change once the OTP sent to the new number is entered
+func (h *Handler) ConfirmPhoneChange(c *gin.Context) {
+	var req ConfirmContactChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid confirm phone change request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	success, message, err := h.authClient.ConfirmPhoneChange(c.Request.Context(), userID.(string), req.OTP, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		h.logger.Error("Confirm phone change failed", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, gin.H{
+		"success": success,
+	})
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
+)
+
+// ForgotPasswordRequest defines the request body for requesting a password reset
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required"`
+}
+
+// ForgotPassword sends a password reset OTP to the user's email
+func (h *Handler) ForgotPassword(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid forgot password request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	// Validate email
+	if !validation.ValidateEmail(req.Email) {
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid email format", nil))
+		return
+	}
+
+	success, message, err := h.authClient.ForgotPassword(c.Request.Context(), req.Email)
+	if err != nil {
+		h.logger.Error("Forgot password failed", "error", err)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	// Return success response with 202 Accepted status
+	pkghttp.Success(c, http.StatusAccepted, message, gin.H{
+		"success": success,
+	})
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+)
+
+// JWKS serves the auth service's public signing keys as a standard JSON Web Key Set.
+// The document is returned as is, without the usual response envelope, so
+// off-the-shelf JWT libraries can consume it.
+func (h *Handler) JWKS(c *gin.Context) {
+	keys, err := h.authClient.GetJWKS(c.Request.Context())
+	if err != nil {
+		h.logger.Error("Failed to fetch JWKS", "error", err)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	jwks := jwt.JWKS{Keys: make([]jwt.JSONWebKey, len(keys))}
+	for i, key := range keys {
+		jwks.Keys[i] = jwt.JSONWebKey{
+			KeyType:   key.Kty,
+			KeyID:     key.Kid,
+			Use:       key.Use,
+			Algorithm: key.Alg,
+			N:         key.N,
+			E:         key.E,
+			Curve:     key.Crv,
+			X:         key.X,
+		}
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, jwks)
+}
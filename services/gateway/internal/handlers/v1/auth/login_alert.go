@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// loginReportPage asks the user to confirm a "this wasn't me" report and shows its outcome.
+// Email clients and link scanners open links on their own, so the link only
+// shows this page and the account is secured by the form's POST request.
+var loginReportPage = template.Must(template.New("login_report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Secure your account - Qubool Kallyanam</title>
+    <style>
+        body { font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif; background: #f5f5f5; display: flex; align-items: center; justify-content: center; min-height: 100vh; margin: 0; padding: 20px; }
+        .container { background: white; border-radius: 20px; box-shadow: 0 20px 40px rgba(0, 0, 0, 0.1); padding: 40px; max-width: 500px; text-align: center; }
+        .title { font-size: 26px; color: #333; margin-bottom: 15px; }
+        .subtitle { font-size: 16px; color: #666; line-height: 1.5; margin-bottom: 25px; }
+        .btn { padding: 15px 25px; border: none; border-radius: 10px; background: #e74c3c; color: white; font-size: 16px; cursor: pointer; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <h1 class="title">{{.Title}}</h1>
+        <p class="subtitle">{{.Message}}</p>
+        {{if .Token}}
+        <form method="POST">
+            <input type="hidden" name="token" value="{{.Token}}">
+            <button type="submit" class="btn">Yes, secure my account</button>
+        </form>
+        {{end}}
+    </div>
+</body>
+</html>
+`))
+
+type loginReportPageData struct {
+	Title   string
+	Message string
+	Token   string
+}
+
+// ConfirmUnrecognizedLogin shows the page the "this wasn't me" link of a
+// new-device login alert opens. It does not change anything by itself.
+func (h *Handler) ConfirmUnrecognizedLogin(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		renderLoginReportPage(c, http.StatusBadRequest, loginReportPageData{
+			Title:   "Invalid link",
+			Message: "This link is incomplete. Please open the link from the email again.",
+		})
+		return
+	}
+
+	renderLoginReportPage(c, http.StatusOK, loginReportPageData{
+		Title:   "Wasn't you?",
+		Message: "We will sign your account out on every device and email you a code to set a new password. You will not be able to log in until the password is reset.",
+		Token:   token,
+	})
+}
+
+// ReportUnrecognizedLogin handles the confirmation of a "this wasn't me"
+// report. It signs the account out everywhere and requires a password reset.
+func (h *Handler) ReportUnrecognizedLogin(c *gin.Context) {
+	token := c.PostForm("token")
+	if token == "" {
+		renderLoginReportPage(c, http.StatusBadRequest, loginReportPageData{
+			Title:   "Invalid request",
+			Message: "This request is incomplete. Please open the link from the email again.",
+		})
+		return
+	}
+
+	_, message, err := h.authClient.ReportUnrecognizedLogin(c.Request.Context(), token, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		h.logger.Error("Unrecognized login report failed", "error", err)
+		renderLoginReportPage(c, http.StatusBadRequest, loginReportPageData{
+			Title:   "Link expired",
+			Message: "This link is invalid or has already been used. You can still reset your password from the login page.",
+		})
+		return
+	}
+
+	renderLoginReportPage(c, http.StatusOK, loginReportPageData{
+		Title:   "Your account is secured",
+		Message: message,
+	})
+}
+
+func renderLoginReportPage(c *gin.Context, status int, data loginReportPageData) {
+	// The token is in the URL, so keep it out of caches and Referer headers
+	c.Header("Cache-Control", "no-store")
+	c.Header("Referrer-Policy", "no-referrer")
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Status(status)
+	if err := loginReportPage.Execute(c.Writer, data); err != nil {
+		c.Error(err)
+	}
+}
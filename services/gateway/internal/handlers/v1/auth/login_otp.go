@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
+)
+
+// RequestLoginOTPRequest defines the request body for requesting a login OTP
+type RequestLoginOTPRequest struct {
+	Phone string `json:"phone" binding:"required"`
+}
+
+// LoginWithOTPRequest defines the request body for logging in with a phone OTP
+type LoginWithOTPRequest struct {
+	Phone string `json:"phone" binding:"required"`
+	OTP   string `json:"otp" binding:"required"`
+}
+
+// RequestLoginOTP sends a login OTP by SMS to a verified phone number
+func (h *Handler) RequestLoginOTP(c *gin.Context) {
+	var req RequestLoginOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid login OTP request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	if !validation.ValidatePhone(req.Phone) {
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid phone format", nil))
+		return
+	}
+
+	success, message, err := h.authClient.RequestLoginOTP(c.Request.Context(), req.Phone)
+	if err != nil {
+		h.logger.Error("Login OTP request failed", "error", err)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	// Return success response with 202 Accepted status
+	pkghttp.Success(c, http.StatusAccepted, message, gin.H{
+		"success": success,
+	})
+}
+
+// LoginWithOTP authenticates a user with their phone number and login OTP
+func (h *Handler) LoginWithOTP(c *gin.Context) {
+	var req LoginWithOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid OTP login request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	if !validation.ValidatePhone(req.Phone) {
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid phone format", nil))
+		return
+	}
+
+	success, accessToken, refreshToken, message, expiresIn, err := h.authClient.LoginWithOTP(c.Request.Context(), req.Phone, req.OTP, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		h.logger.Error("OTP login failed", "error", err)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	if success && accessToken != "" {
+		h.metrics.IncrementUserLogins()
+	}
+
+	response := LoginResponse{
+		Success:      success,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    expiresIn,
+		Message:      message,
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, response)
+}
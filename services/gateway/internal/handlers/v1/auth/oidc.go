@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// The state of a sign-in is bound to the browser that started it with a cookie
+// holding its hash, so a callback URL from someone else's sign-in is rejected
+const (
+	oidcStateCookie     = "oidc_state"
+	oidcStateCookiePath = "/api/v1/auth/oidc/"
+	oidcStateCookieTTL  = 15 * time.Minute
+)
+
+// StartOIDCLogin redirects the browser to the OpenID Connect provider's sign-in page
+func (h *Handler) StartOIDCLogin(c *gin.Context) {
+	provider := c.Param("provider")
+
+	authURL, err := h.authClient.StartOIDCLogin(c.Request.Context(), provider)
+	if err != nil {
+		h.logger.Error("OIDC login start failed", "error", err, "provider", provider)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	if err := setOIDCStateCookie(c, authURL); err != nil {
+		h.logger.Error("Invalid OIDC authorization URL", "error", err, "provider", provider)
+		pkghttp.Error(c, pkghttp.NewInternalServerError("Failed to start sign-in", err))
+		return
+	}
+
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// StartOIDCLink starts linking a provider account to the authenticated user.
+// The app is called with the user's token, so the provider URL is returned for
+// the app to open instead of a redirect; the provider then redirects back to
+// the same callback as a sign-in.
+func (h *Handler) StartOIDCLink(c *gin.Context) {
+	provider := c.Param("provider")
+
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	authURL, err := h.authClient.StartOIDCLink(c.Request.Context(), userID.(string), provider)
+	if err != nil {
+		h.logger.Error("OIDC link start failed", "error", err, "provider", provider, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	if err := setOIDCStateCookie(c, authURL); err != nil {
+		h.logger.Error("Invalid OIDC authorization URL", "error", err, "provider", provider)
+		pkghttp.Error(c, pkghttp.NewInternalServerError("Failed to start linking", err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, "Continue with the provider to link your account", gin.H{
+		"authorization_url": authURL,
+	})
+}
+
+// CompleteOIDCLogin is the callback the provider redirects back to after the
+// user signed in. It logs the user in and returns tokens like the other login routes.
+func (h *Handler) CompleteOIDCLogin(c *gin.Context) {
+	provider := c.Param("provider")
+
+	// The provider reports a cancelled or refused sign-in with an error instead of a code
+	if providerErr := c.Query("error"); providerErr != "" {
+		h.logger.Debug("OIDC provider returned an error", "provider", provider, "error", providerErr, "description", c.Query("error_description"))
+		pkghttp.Error(c, pkghttp.NewBadRequest("Sign-in with the provider was not completed", nil))
+		return
+	}
+
+	state, code := c.Query("state"), c.Query("code")
+	if state == "" || code == "" {
+		pkghttp.Error(c, pkghttp.NewBadRequest("State and code are required", nil))
+		return
+	}
+
+	stateCookie, _ := c.Cookie(oidcStateCookie)
+	clearOIDCStateCookie(c)
+	if subtle.ConstantTimeCompare([]byte(stateCookie), []byte(hashState(state))) != 1 {
+		h.logger.Debug("OIDC callback state does not match the browser", "provider", provider)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Sign-in was not started from this browser", nil))
+		return
+	}
+
+	resp, err := h.authClient.CompleteOIDCLogin(c.Request.Context(), provider, state, code, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		h.logger.Error("OIDC login failed", "error", err, "provider", provider)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	if resp.Success && resp.AccessToken != "" {
+		h.metrics.IncrementUserLogins()
+		if resp.NewUser {
+			h.metrics.IncrementUserRegistrations()
+		}
+	}
+
+	response := LoginResponse{
+		Success:      resp.Success,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		ExpiresIn:    resp.ExpiresIn,
+		Message:      resp.Message,
+		NewUser:      resp.NewUser,
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, response)
+}
+
+// setOIDCStateCookie stores the hash of the state in the authorization URL
+func setOIDCStateCookie(c *gin.Context, authURL string) error {
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		return err
+	}
+	state := parsed.Query().Get("state")
+	if state == "" {
+		return errors.New("authorization URL has no state")
+	}
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, hashState(state), int(oidcStateCookieTTL.Seconds()), oidcStateCookiePath, "", isSecureRequest(c), true)
+	return nil
+}
+
+func clearOIDCStateCookie(c *gin.Context) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, "", -1, oidcStateCookiePath, "", isSecureRequest(c), true)
+}
+
+func hashState(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(sum[:])
+}
+
+// isSecureRequest reports whether the browser reached the gateway over HTTPS,
+// directly or through a TLS terminating proxy
+func isSecureRequest(c *gin.Context) bool {
+	return c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https"
+}
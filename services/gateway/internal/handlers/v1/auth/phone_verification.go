@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// VerifyPhoneRequest defines the request body for verifying a phone number
+type VerifyPhoneRequest struct {
+	OTP string `json:"otp" binding:"required"`
+}
+
+// SendPhoneVerificationOTP texts a verification OTP to the authenticated user's phone number
+func (h *Handler) SendPhoneVerificationOTP(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	success, message, err := h.authClient.SendPhoneVerificationOTP(c.Request.Context(), userID.(string))
+	if err != nil {
+		h.logger.Error("Send phone verification OTP failed", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusAccepted, message, gin.H{
+		"success": success,
+	})
+}
+
+// VerifyPhone verifies the authenticated user's phone number
+func (h *Handler) VerifyPhone(c *gin.Context) {
+	var req VerifyPhoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid verify phone request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	success, message, err := h.authClient.VerifyPhone(c.Request.Context(), userID.(string), req.OTP)
+	if err != nil {
+		h.logger.Error("Verify phone failed", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, gin.H{
+		"success": success,
+	})
+}
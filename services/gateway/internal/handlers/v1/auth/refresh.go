@@ -40,7 +40,7 @@ func (h *Handler) RefreshToken(c *gin.Context) {
 	refreshToken := tokenParts[1]
 
 	// Call auth service
-	success, accessToken, newRefreshToken, expiresIn, message, err := h.authClient.RefreshToken(c.Request.Context(), refreshToken)
+	success, accessToken, newRefreshToken, expiresIn, message, err := h.authClient.RefreshToken(c.Request.Context(), refreshToken, c.ClientIP(), c.Request.UserAgent())
 	if err != nil {
 		h.logger.Error("Token refresh failed", "error", err)
 		pkghttp.Error(c, pkghttp.FromGRPCError(err))
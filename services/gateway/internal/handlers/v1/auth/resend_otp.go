@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
+)
+
+// ResendOTPRequest defines the request body for resending registration codes
+type ResendOTPRequest struct {
+	Email string `json:"email" binding:"required"`
+}
+
+// ResendOTP sends new verification codes for a pending registration
+func (h *Handler) ResendOTP(c *gin.Context) {
+	var req ResendOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid resend OTP request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	if !validation.ValidateEmail(req.Email) {
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid email format", nil))
+		return
+	}
+
+	success, message, err := h.authClient.ResendOTP(c.Request.Context(), req.Email)
+	if err != nil {
+		h.logger.Error("Resend OTP failed", "error", err, "email", req.Email)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusAccepted, message, gin.H{
+		"success": success,
+	})
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
+)
+
+// ResetPasswordRequest defines the request body for resetting a password
+type ResetPasswordRequest struct {
+	Email       string `json:"email" binding:"required"`
+	OTP         string `json:"otp" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// ResetPassword verifies the reset OTP and sets a new password
+func (h *Handler) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid reset password request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	// Validate email
+	if !validation.ValidateEmail(req.Email) {
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid email format", nil))
+		return
+	}
+
+	// Validate new password
+	if !validation.ValidatePassword(req.NewPassword, validation.DefaultPasswordPolicy()) {
+		pkghttp.Error(c, pkghttp.NewBadRequest("Password does not meet requirements", nil))
+		return
+	}
+
+	success, message, err := h.authClient.ResetPassword(c.Request.Context(), req.Email, req.OTP, req.NewPassword, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		h.logger.Error("Reset password failed", "error", err)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	// Return success response with 200 OK status
+	pkghttp.Success(c, http.StatusOK, message, gin.H{
+		"success": success,
+	})
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// SecurityEventResponse describes one login, logout or other authentication attempt on the account
+type SecurityEventResponse struct {
+	ID        int64     `json:"id"`
+	EventType string    `json:"event_type"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	Outcome   string    `json:"outcome"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListSecurityActivity returns the authenticated user's recent security activity, newest first
+func (h *Handler) ListSecurityActivity(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	resp, err := h.authClient.ListAuthEvents(c.Request.Context(), userID.(string), int32(limit), int32(offset))
+	if err != nil {
+		h.logger.Error("List security activity failed", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	events := make([]SecurityEventResponse, len(resp.Events))
+	for i, event := range resp.Events {
+		events[i] = SecurityEventResponse{
+			ID:        event.Id,
+			EventType: event.EventType,
+			IPAddress: event.IpAddress,
+			UserAgent: event.UserAgent,
+			Outcome:   event.Outcome,
+			Reason:    event.Reason,
+			CreatedAt: event.CreatedAt.AsTime(),
+		}
+	}
+
+	response := map[string]interface{}{
+		"success": resp.Success,
+		"message": resp.Message,
+		"events":  events,
+		"pagination": map[string]interface{}{
+			"total":    resp.Pagination.Total,
+			"limit":    resp.Pagination.Limit,
+			"offset":   resp.Pagination.Offset,
+			"has_more": resp.Pagination.HasMore,
+		},
+	}
+
+	pkghttp.Success(c, http.StatusOK, resp.Message, response)
+}
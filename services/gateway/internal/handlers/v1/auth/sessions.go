@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// SessionResponse describes one device the user is logged in on
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	Current    bool      `json:"current"`
+}
+
+// ListSessions returns the active login sessions of the authenticated user
+func (h *Handler) ListSessions(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+	sessionID := c.GetString(middleware.SessionIDKey)
+
+	success, message, sessions, err := h.authClient.ListSessions(c.Request.Context(), userID.(string), sessionID)
+	if err != nil {
+		h.logger.Error("List sessions failed", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	responseSessions := make([]SessionResponse, len(sessions))
+	for i, session := range sessions {
+		responseSessions[i] = SessionResponse{
+			ID:         session.Id,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IpAddress,
+			CreatedAt:  session.CreatedAt.AsTime(),
+			LastUsedAt: session.LastUsedAt.AsTime(),
+			Current:    session.Current,
+		}
+	}
+
+	response := map[string]interface{}{
+		"success":  success,
+		"message":  message,
+		"sessions": responseSessions,
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, response)
+}
+
+// RevokeSession signs out one of the authenticated user's sessions
+func (h *Handler) RevokeSession(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	targetSessionID := c.Param("id")
+	if targetSessionID == "" {
+		pkghttp.Error(c, pkghttp.NewBadRequest("Session ID is required", nil))
+		return
+	}
+
+	success, message, err := h.authClient.RevokeSession(c.Request.Context(), userID.(string), targetSessionID)
+	if err != nil {
+		h.logger.Error("Revoke session failed", "error", err, "userID", userID, "sessionID", targetSessionID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	response := map[string]interface{}{
+		"success": success,
+		"message": message,
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, response)
+}
+
+// RevokeAllOtherSessions signs out every session of the authenticated user except the current one
+func (h *Handler) RevokeAllOtherSessions(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		h.logger.Error("User ID not found in context")
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+	sessionID := c.GetString(middleware.SessionIDKey)
+
+	success, message, revokedCount, err := h.authClient.RevokeAllOtherSessions(c.Request.Context(), userID.(string), sessionID)
+	if err != nil {
+		h.logger.Error("Revoke other sessions failed", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	response := map[string]interface{}{
+		"success":       success,
+		"message":       message,
+		"revoked_count": revokedCount,
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, response)
+}
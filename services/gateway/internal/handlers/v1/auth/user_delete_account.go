@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
 )
 
 type DeleteAccountRequest struct {
@@ -30,7 +31,7 @@ func (h *Handler) DeleteAccount(c *gin.Context) {
 	// Create a new context with user-id value
 	ctx := context.WithValue(c.Request.Context(), "user-id", userID.(string))
 
-	success, message, err := h.authClient.Delete(ctx, req.Password)
+	resp, err := h.authClient.Delete(ctx, req.Password)
 	if err != nil {
 		h.logger.Error("Delete account failed", "error", err, "userID", userID)
 		pkghttp.Error(c, pkghttp.FromGRPCError(err))
@@ -38,9 +39,40 @@ func (h *Handler) DeleteAccount(c *gin.Context) {
 	}
 
 	response := map[string]interface{}{
-		"success": success,
-		"message": message,
+		"success":     resp.Success,
+		"message":     resp.Message,
+		"purge_after": resp.PurgeAfter.AsTime(),
 	}
 
-	pkghttp.Success(c, http.StatusOK, message, response)
+	pkghttp.Success(c, http.StatusOK, resp.Message, response)
+}
+
+// RestoreAccount cancels a scheduled account deletion during the grace period and logs the user in
+func (h *Handler) RestoreAccount(c *gin.Context) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Invalid restore account request body", "error", err)
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid request format", err))
+		return
+	}
+
+	if !validation.ValidateEmail(req.Email) {
+		pkghttp.Error(c, pkghttp.NewBadRequest("Invalid email format", nil))
+		return
+	}
+
+	success, accessToken, refreshToken, message, expiresIn, err := h.authClient.RestoreAccount(c.Request.Context(), req.Email, req.Password, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		h.logger.Error("Restore account failed", "error", err, "email", req.Email)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusOK, message, LoginResponse{
+		Success:      success,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    expiresIn,
+		Message:      message,
+	})
 }
@@ -23,6 +23,14 @@ type LoginResponse struct {
 	RefreshToken string `json:"refresh_token"`
 	ExpiresIn    int32  `json:"expires_in"`
 	Message      string `json:"message"`
+
+	// Set when an OpenID Connect sign-in registered a new account
+	NewUser bool `json:"new_user,omitempty"`
+
+	// Set instead of the tokens when an admin still has to pass two-factor authentication
+	TwoFactorRequired           bool   `json:"two_factor_required,omitempty"`
+	TwoFactorToken              string `json:"two_factor_token,omitempty"`
+	TwoFactorEnrollmentRequired bool   `json:"two_factor_enrollment_required,omitempty"`
 }
 
 // Login handles user authentication
@@ -41,7 +49,7 @@ func (h *Handler) Login(c *gin.Context) {
 	}
 
 	// Call auth service
-	success, accessToken, refreshToken, message, expiresIn, err := h.authClient.Login(c.Request.Context(), req.Email, req.Password)
+	success, accessToken, refreshToken, message, expiresIn, err := h.authClient.Login(c.Request.Context(), req.Email, req.Password, c.ClientIP(), c.Request.UserAgent())
 	if err != nil {
 		h.logger.Error("Login failed", "error", err, "email", req.Email)
 		pkghttp.Error(c, pkghttp.FromGRPCError(err))
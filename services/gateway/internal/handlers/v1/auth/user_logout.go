@@ -31,7 +31,7 @@ func (h *Handler) Logout(c *gin.Context) {
 	accessToken := tokenParts[1]
 
 	// Call auth service to logout
-	_, _, err := h.authClient.Logout(c.Request.Context(), accessToken)
+	_, _, err := h.authClient.Logout(c.Request.Context(), accessToken, c.ClientIP(), c.Request.UserAgent())
 	if err != nil {
 		h.logger.Error("Logout failed", "error", err)
 		pkghttp.Error(c, pkghttp.FromGRPCError(err))
@@ -13,8 +13,9 @@ import (
 
 // VerifyRequest defines the request body for verification
 type VerifyRequest struct {
-	Email string `json:"email" binding:"required"`
-	OTP   string `json:"otp" binding:"required"`
+	Email    string `json:"email" binding:"required"`
+	OTP      string `json:"otp" binding:"required"`
+	PhoneOTP string `json:"phone_otp"` // Optional, verifies the phone number at the same time
 }
 
 // Verify handles OTP verification
@@ -33,7 +34,7 @@ func (h *Handler) Verify(c *gin.Context) {
 	}
 
 	// Call auth service to verify OTP
-	success, message, err := h.authClient.Verify(c.Request.Context(), req.Email, req.OTP)
+	success, message, err := h.authClient.Verify(c.Request.Context(), req.Email, req.OTP, req.PhoneOTP)
 	if err != nil {
 		h.logger.Error("Verification failed", "error", err)
 		pkghttp.Error(c, pkghttp.FromGRPCError(err))
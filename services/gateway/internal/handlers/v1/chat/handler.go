@@ -1,6 +1,7 @@
 package chat
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 
@@ -10,6 +11,7 @@ import (
 	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/metrics"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/auth"
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/chat"
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/user"
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
@@ -18,12 +20,13 @@ import (
 type Handler struct {
 	chatClient *chat.Client
 	userClient *user.Client
+	authClient *auth.Client
 	logger     logging.Logger
 	metrics    *metrics.Metrics
 	hub        *Hub
 }
 
-func NewHandler(chatClient *chat.Client, userClient *user.Client, logger logging.Logger, metrics *metrics.Metrics) *Handler {
+func NewHandler(chatClient *chat.Client, userClient *user.Client, authClient *auth.Client, logger logging.Logger, metrics *metrics.Metrics) *Handler {
 	hub := NewHub(logger)
 
 	// Start the hub in a separate goroutine
@@ -34,6 +37,7 @@ func NewHandler(chatClient *chat.Client, userClient *user.Client, logger logging
 	return &Handler{
 		chatClient: chatClient,
 		userClient: userClient,
+		authClient: authClient,
 		logger:     logger,
 		metrics:    metrics,
 		hub:        hub,
@@ -99,6 +103,58 @@ type GetConversationsResponse struct {
 	Pagination    PaginationInfo     `json:"pagination"`
 }
 
+// checkAccountActive rejects users whose account was suspended, banned or deactivated.
+// Tokens are validated locally, so such changes are only known to the auth service.
+func (h *Handler) checkAccountActive(ctx context.Context, userID string) *pkghttp.AppError {
+	accountStatus, err := h.authClient.GetAccountStatus(ctx, userID)
+	if err != nil {
+		h.logger.Error("Failed to check account status", "error", err, "userID", userID)
+		return pkghttp.FromGRPCError(err)
+	}
+	if accountStatus.Status != accountStatusActive {
+		h.logger.Info("Chat action rejected", "userID", userID, "status", accountStatus.Status)
+		return pkghttp.NewForbidden("Account is "+accountStatus.Status, nil)
+	}
+	return nil
+}
+
+// checkRecipientsAvailable rejects messages to conversations whose other
+// participant was deactivated, suspended or banned
+func (h *Handler) checkRecipientsAvailable(ctx context.Context, userID, conversationID string) *pkghttp.AppError {
+	conversation, err := h.chatClient.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		h.logger.Error("Failed to get conversation", "error", err, "conversationID", conversationID)
+		return pkghttp.FromGRPCError(err)
+	}
+
+	for _, participantID := range conversation.Participants {
+		if participantID == userID {
+			continue
+		}
+		if !h.isAvailableForChat(ctx, participantID) {
+			h.logger.Debug("Recipient is not available for chat", "conversationID", conversationID, "recipientID", participantID)
+			return pkghttp.NewForbidden("This user is no longer available for chat", nil)
+		}
+	}
+	return nil
+}
+
+// isAvailableForChat reports whether the account of a chat participant is active.
+// If the status cannot be checked, the participant is treated as unavailable.
+func (h *Handler) isAvailableForChat(ctx context.Context, userID string) bool {
+	accountStatus, err := h.authClient.GetAccountStatus(ctx, userID)
+	if err != nil {
+		h.logger.Error("Failed to check participant account status", "error", err, "userID", userID)
+		return false
+	}
+	return accountStatus.Status == accountStatusActive
+}
+
+// DisconnectUser closes the user's open WebSocket connection, if any
+func (h *Handler) DisconnectUser(userID string) {
+	h.hub.DisconnectUser(userID)
+}
+
 func (h *Handler) CreateConversation(c *gin.Context) {
 	h.logger.Info("CreateConversation endpoint called")
 
@@ -140,6 +196,19 @@ func (h *Handler) CreateConversation(c *gin.Context) {
 		return
 	}
 
+	// Deactivated, suspended and banned users are hidden from chat
+	recipientStatus, err := h.authClient.GetAccountStatus(c.Request.Context(), recipientUUID)
+	if err != nil {
+		h.logger.Error("Failed to check recipient account status", "error", err, "recipientID", req.RecipientID)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+	if recipientStatus.Status != accountStatusActive {
+		h.logger.Debug("Recipient is not available for chat", "recipientID", req.RecipientID, "status", recipientStatus.Status)
+		pkghttp.Error(c, pkghttp.NewNotFound("User not found", nil))
+		return
+	}
+
 	// Create conversation
 	conversation, err := h.chatClient.CreateConversation(c.Request.Context(), currentUserID, recipientUUID)
 	if err != nil {
@@ -219,6 +288,15 @@ func (h *Handler) SendMessage(c *gin.Context) {
 	}
 
 	currentUserID := userID.(string)
+	if appErr := h.checkAccountActive(c.Request.Context(), currentUserID); appErr != nil {
+		pkghttp.Error(c, appErr)
+		return
+	}
+	if appErr := h.checkRecipientsAvailable(c.Request.Context(), currentUserID, conversationID); appErr != nil {
+		pkghttp.Error(c, appErr)
+		return
+	}
+
 	message, err := h.chatClient.SendMessage(c.Request.Context(), currentUserID, conversationID, req.Text)
 	if err != nil {
 		h.logger.Error("Failed to send message", "error", err, "conversationID", conversationID)
@@ -366,6 +444,11 @@ func (h *Handler) GetConversations(c *gin.Context) {
 
 		otherUserUUID := conversation.Participants[0] // First participant is the other user
 
+		// Deactivated, suspended and banned users are hidden from chat
+		if !h.isAvailableForChat(c.Request.Context(), otherUserUUID) {
+			continue
+		}
+
 		// Resolve participant UUID to public profile via User Service
 		participantProfile, err := h.userClient.GetBasicProfile(c.Request.Context(), otherUserUUID)
 		if err != nil {
@@ -185,6 +185,15 @@ func (c *Client) handleChatMessage(ctx context.Context, message *Message, chatHa
 
 	c.hub.logger.Info("Processing chat message", "userID", c.userID, "conversationID", message.ConversationID, "textLength", len(message.Text))
 
+	if appErr := chatHandler.checkAccountActive(ctx, c.userID); appErr != nil {
+		c.sendError(appErr.Message)
+		return
+	}
+	if appErr := chatHandler.checkRecipientsAvailable(ctx, c.userID, message.ConversationID); appErr != nil {
+		c.sendError(appErr.Message)
+		return
+	}
+
 	// Send message through the existing chat service
 	savedMessage, err := chatHandler.chatClient.SendMessage(ctx, c.userID, message.ConversationID, message.Text)
 	if err != nil {
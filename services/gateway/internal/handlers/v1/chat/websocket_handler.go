@@ -11,6 +11,9 @@ import (
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
 )
 
+// accountStatusActive is the status the auth service reports for accounts that are neither suspended nor banned
+const accountStatusActive = "active"
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -32,6 +35,13 @@ func (h *Handler) HandleWebSocket(c *gin.Context) {
 	userIDStr := userID.(string)
 	h.logger.Info("WebSocket connection request", "userID", userIDStr)
 
+	// Tokens are validated locally, so ask the auth service whether the
+	// account has been suspended or banned since the token was issued
+	if appErr := h.checkAccountActive(c.Request.Context(), userIDStr); appErr != nil {
+		pkghttp.Error(c, appErr)
+		return
+	}
+
 	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
 		h.logger.Error("Failed to upgrade WebSocket connection", "error", err, "userID", userIDStr)
@@ -391,6 +391,23 @@ func (h *Hub) UpdateClientConversation(userID, conversationID string) {
 	}
 }
 
+// DisconnectUser closes the connection of a user who may no longer chat,
+// such as after a suspension. Closing the send channel makes the write pump
+// send a close frame and tear the connection down.
+func (h *Hub) DisconnectUser(userID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	client, exists := h.clients[userID]
+	if !exists {
+		return
+	}
+
+	delete(h.clients, userID)
+	close(client.send)
+	h.logger.Info("Client disconnected", "userID", userID, "totalClients", len(h.clients))
+}
+
 // GetClientCount returns the current number of connected clients
 func (h *Hub) GetClientCount() int {
 	h.mu.RLock()
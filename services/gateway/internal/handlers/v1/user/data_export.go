@@ -0,0 +1,83 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/export"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+// RequestDataExport starts gathering a copy of the user's data
+func (h *Handler) RequestDataExport(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	dataExport, err := h.exporter.Start(c.Request.Context(), userID)
+	if err != nil {
+		if errors.Is(err, export.ErrExportInProgress) {
+			pkghttp.Error(c, pkghttp.NewTooManyRequests("A data export is already being prepared", nil))
+			return
+		}
+		if errors.Is(err, export.ErrExportTooSoon) {
+			pkghttp.Error(c, pkghttp.NewTooManyRequests("A data export was requested recently, please try again later", nil))
+			return
+		}
+		h.logger.Error("Failed to start data export", "error", err, "userID", userID)
+		pkghttp.Error(c, pkghttp.NewInternalServerError("Failed to start data export", err))
+		return
+	}
+
+	pkghttp.Success(c, http.StatusAccepted, "Data export started", gin.H{
+		"export_id":    dataExport.ID,
+		"status":       dataExport.Status,
+		"requested_at": dataExport.RequestedAt,
+	})
+}
+
+// GetDataExport reports the progress of a data export and returns
+// a download link that expires once the export is ready
+func (h *Handler) GetDataExport(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+		return
+	}
+
+	exportID := c.Param("id")
+	dataExport, err := h.exporter.Get(c.Request.Context(), userID, exportID)
+	if err != nil {
+		if errors.Is(err, export.ErrExportNotFound) {
+			pkghttp.Error(c, pkghttp.NewNotFound("Data export not found", nil))
+			return
+		}
+		h.logger.Error("Failed to get data export", "error", err, "userID", userID, "exportID", exportID)
+		pkghttp.Error(c, pkghttp.NewInternalServerError("Failed to get data export", err))
+		return
+	}
+
+	response := gin.H{
+		"export_id":    dataExport.ID,
+		"status":       dataExport.Status,
+		"requested_at": dataExport.RequestedAt,
+	}
+
+	if dataExport.Status == export.StatusReady {
+		downloadURL, err := h.exporter.DownloadURL(c.Request.Context(), dataExport)
+		if err != nil {
+			h.logger.Error("Failed to create data export link", "error", err, "userID", userID, "exportID", exportID)
+			pkghttp.Error(c, pkghttp.NewInternalServerError("Failed to create download link", err))
+			return
+		}
+		response["download_url"] = downloadURL
+		response["expires_at"] = time.Now().Add(h.exporter.LinkExpiry())
+	}
+
+	pkghttp.Success(c, http.StatusOK, "Data export retrieved successfully", response)
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	userpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/user/v1"
 	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
 )
 
@@ -60,6 +61,7 @@ func (h *Handler) GetDetailedProfile(c *gin.Context) {
 		"profession_type":         profileData.ProfessionType,
 		"highest_education_level": profileData.HighestEducationLevel,
 		"home_district":           profileData.HomeDistrict,
+		"current_location":        profileData.CurrentLocation,
 		"last_login":              profileData.LastLogin.AsTime(),
 	}
 
@@ -91,6 +93,8 @@ func (h *Handler) GetDetailedProfile(c *gin.Context) {
 			"preferred_profession_types":   prefs.PreferredProfessionTypes,
 			"preferred_education_levels":   prefs.PreferredEducationLevels,
 			"preferred_home_districts":     prefs.PreferredHomeDistricts,
+			"preferred_current_locations":  prefs.PreferredCurrentLocations,
+			"lifestyle":                    lifestylePreferencesResponse(prefs.Lifestyle),
 		}
 	}
 
@@ -119,7 +123,36 @@ func (h *Handler) GetDetailedProfile(c *gin.Context) {
 		}
 	}
 
+	response["details"] = profileDetailsResponse(profileData.Details)
+	response["lifestyle"] = lifestyleResponse(profileData.Lifestyle)
+
 	pkghttp.Success(c, http.StatusOK, message, gin.H{
 		"profile": response,
 	})
 }
+
+// profileDetailsResponse formats the extended profile details: about me,
+// income, languages, current residence and family background
+func profileDetailsResponse(details *userpb.ProfileDetailsData) gin.H {
+	languages := details.GetLanguages()
+	if languages == nil {
+		languages = []string{}
+	}
+
+	family := details.GetFamily()
+	return gin.H{
+		"about_me":         details.GetAboutMe(),
+		"annual_income":    details.GetAnnualIncome(),
+		"languages":        languages,
+		"current_city":     details.GetCurrentCity(),
+		"current_country":  details.GetCurrentCountry(),
+		"residency_status": details.GetResidencyStatus(),
+		"family": gin.H{
+			"father_occupation": family.GetFatherOccupation(),
+			"mother_occupation": family.GetMotherOccupation(),
+			"brothers_count":    family.GetBrothersCount(),
+			"sisters_count":     family.GetSistersCount(),
+			"family_type":       family.GetFamilyType(),
+		},
+	}
+}
@@ -82,6 +82,7 @@ func (h *Handler) GetRecommendedMatches(c *gin.Context) {
 			"profession_type":         profile.ProfessionType,
 			"highest_education_level": profile.HighestEducationLevel,
 			"home_district":           profile.HomeDistrict,
+			"current_location":        profile.CurrentLocation,
 			"profile_picture_url":     profile.ProfilePictureUrl,
 			"last_login":              lastLogin,
 			"match_reasons":           profile.MatchReasons,
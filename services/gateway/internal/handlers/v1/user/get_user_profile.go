@@ -47,8 +47,10 @@ func (h *Handler) GetProfile(c *gin.Context) {
 		"date_of_birth":           dateOfBirth,
 		"community":               profileData.Community,
 		"home_district":           profileData.HomeDistrict,
+		"current_location":        profileData.CurrentLocation,
 		"last_login":              profileData.LastLogin.AsTime(),
 		"phone":                   profileData.Phone,
+		"phone_country":           profileData.PhoneCountry,
 		"physically_challenged":   profileData.PhysicallyChallenged,
 		"highest_education_level": profileData.HighestEducationLevel,
 		"created_at":              profileData.CreatedAt.AsTime(),
@@ -71,12 +73,16 @@ func (h *Handler) GetProfile(c *gin.Context) {
 		DateOfBirth           string    `json:"date_of_birth,omitempty"`
 		Community             string    `json:"community"`
 		HomeDistrict          string    `json:"home_district"`
+		CurrentLocation       string    `json:"current_location"`
 		LastLogin             time.Time `json:"last_login"`
 		Phone                 string    `json:"phone"`
+		PhoneCountry          string    `json:"phone_country,omitempty"`
 		PhysicallyChallenged  bool      `json:"physically_challenged"`
 		HighestEducationLevel string    `json:"highest_education_level"`
 		CreatedAt             time.Time `json:"created_at"`
 		ProfilePictureURL     string    `json:"profile_picture_url,omitempty"`
+		Details               gin.H     `json:"details"`
+		Lifestyle             gin.H     `json:"lifestyle"`
 	}
 
 	orderedProfile := OrderedProfile{
@@ -90,12 +96,16 @@ func (h *Handler) GetProfile(c *gin.Context) {
 		DateOfBirth:           dateOfBirth,
 		Community:             profileData.Community,
 		HomeDistrict:          profileData.HomeDistrict,
+		CurrentLocation:       profileData.CurrentLocation,
 		LastLogin:             profileData.LastLogin.AsTime(),
 		Phone:                 profileData.Phone,
+		PhoneCountry:          profileData.PhoneCountry,
 		PhysicallyChallenged:  profileData.PhysicallyChallenged,
 		HighestEducationLevel: profileData.HighestEducationLevel,
 		CreatedAt:             profileData.CreatedAt.AsTime(),
 		ProfilePictureURL:     profileData.ProfilePictureUrl,
+		Details:               profileDetailsResponse(profileData.Details),
+		Lifestyle:             lifestyleResponse(profileData.Lifestyle),
 	}
 
 	pkghttp.Success(c, http.StatusOK, message, gin.H{
@@ -0,0 +1,92 @@
+package user
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	userpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/user/v1"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
+)
+
+// LifestylePreferencesRequest lists the lifestyle values wanted in a partner.
+// An empty list means no preference.
+type LifestylePreferencesRequest struct {
+	PreferredPrayerFrequencies []string `json:"preferred_prayer_frequencies"`
+	PreferredHijab             []string `json:"preferred_hijab"`
+	PreferredBeard             []string `json:"preferred_beard"`
+	PreferredMadrasaEducation  []string `json:"preferred_madrasa_education"`
+	PreferredDiets             []string `json:"preferred_diets"`
+	PreferredSmoking           []string `json:"preferred_smoking"`
+	PreferredRelocation        []string `json:"preferred_relocation"`
+}
+
+// validate checks every preferred lifestyle value
+func (r *LifestylePreferencesRequest) validate() error {
+	lists := []struct {
+		name     string
+		values   []string
+		validate func(string) error
+	}{
+		{"prayer frequency", r.PreferredPrayerFrequencies, validation.ValidatePrayerFrequency},
+		{"hijab", r.PreferredHijab, validation.ValidateHijab},
+		{"beard", r.PreferredBeard, validation.ValidateBeard},
+		{"madrasa education", r.PreferredMadrasaEducation, validation.ValidateMadrasaEducation},
+		{"diet", r.PreferredDiets, validation.ValidateDiet},
+		{"smoking", r.PreferredSmoking, validation.ValidateSmoking},
+		{"relocation", r.PreferredRelocation, validation.ValidateRelocation},
+	}
+
+	for _, list := range lists {
+		for _, value := range list.values {
+			if err := list.validate(value); err != nil {
+				return fmt.Errorf("invalid preferred %s: %s", list.name, value)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *LifestylePreferencesRequest) toProto() *userpb.LifestylePreferencesData {
+	return &userpb.LifestylePreferencesData{
+		PreferredPrayerFrequencies: r.PreferredPrayerFrequencies,
+		PreferredHijab:             r.PreferredHijab,
+		PreferredBeard:             r.PreferredBeard,
+		PreferredMadrasaEducation:  r.PreferredMadrasaEducation,
+		PreferredDiets:             r.PreferredDiets,
+		PreferredSmoking:           r.PreferredSmoking,
+		PreferredRelocation:        r.PreferredRelocation,
+	}
+}
+
+// lifestyleResponse formats the lifestyle attributes of a profile
+func lifestyleResponse(lifestyle *userpb.LifestyleData) gin.H {
+	return gin.H{
+		"prayer_frequency":    lifestyle.GetPrayerFrequency(),
+		"hijab":               lifestyle.GetHijab(),
+		"beard":               lifestyle.GetBeard(),
+		"madrasa_education":   lifestyle.GetMadrasaEducation(),
+		"diet":                lifestyle.GetDiet(),
+		"smoking":             lifestyle.GetSmoking(),
+		"willing_to_relocate": lifestyle.GetWillingToRelocate(),
+	}
+}
+
+// lifestylePreferencesResponse formats lifestyle partner preferences
+func lifestylePreferencesResponse(prefs *userpb.LifestylePreferencesData) gin.H {
+	return gin.H{
+		"preferred_prayer_frequencies": nonNilStrings(prefs.GetPreferredPrayerFrequencies()),
+		"preferred_hijab":              nonNilStrings(prefs.GetPreferredHijab()),
+		"preferred_beard":              nonNilStrings(prefs.GetPreferredBeard()),
+		"preferred_madrasa_education":  nonNilStrings(prefs.GetPreferredMadrasaEducation()),
+		"preferred_diets":              nonNilStrings(prefs.GetPreferredDiets()),
+		"preferred_smoking":            nonNilStrings(prefs.GetPreferredSmoking()),
+		"preferred_relocation":         nonNilStrings(prefs.GetPreferredRelocation()),
+	}
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -23,6 +23,9 @@ type UpdatePartnerPreferencesRequest struct {
 	PreferredProfessionTypes   []string `json:"preferred_profession_types"`
 	PreferredEducationLevels   []string `json:"preferred_education_levels"`
 	PreferredHomeDistricts     []string `json:"preferred_home_districts"`
+	PreferredCurrentLocations  []string `json:"preferred_current_locations"`
+
+	Lifestyle LifestylePreferencesRequest `json:"lifestyle"`
 }
 
 var (
@@ -89,13 +92,8 @@ func (h *Handler) UpdatePartnerPreferences(c *gin.Context) {
 		return
 	}
 
-	// Validate preferred communities
-	for _, community := range req.PreferredCommunities {
-		if err := validation.ValidateCommunity(community); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest("Invalid community: "+community, err))
-			return
-		}
-	}
+	// Preferred communities, professions, education levels and home districts
+	// are checked by the user service against its option lists
 
 	// Validate preferred marital statuses
 	for _, status := range req.PreferredMaritalStatus {
@@ -105,14 +103,6 @@ func (h *Handler) UpdatePartnerPreferences(c *gin.Context) {
 		}
 	}
 
-	// Validate preferred professions
-	for _, profession := range req.PreferredProfessions {
-		if err := validation.ValidateProfession(profession); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest("Invalid profession: "+profession, err))
-			return
-		}
-	}
-
 	// Validate preferred profession types
 	for _, profType := range req.PreferredProfessionTypes {
 		if err := validation.ValidateProfessionType(profType); err != nil {
@@ -121,20 +111,9 @@ func (h *Handler) UpdatePartnerPreferences(c *gin.Context) {
 		}
 	}
 
-	// Validate preferred education levels
-	for _, level := range req.PreferredEducationLevels {
-		if err := validation.ValidateEducationLevel(level); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest("Invalid education level: "+level, err))
-			return
-		}
-	}
-
-	// Validate preferred home districts
-	for _, district := range req.PreferredHomeDistricts {
-		if err := validation.ValidateHomeDistrict(district); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest("Invalid home district: "+district, err))
-			return
-		}
+	if err := req.Lifestyle.validate(); err != nil {
+		pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
+		return
 	}
 
 	// Initialize empty arrays if they're nil to avoid null in JSON
@@ -156,6 +135,9 @@ func (h *Handler) UpdatePartnerPreferences(c *gin.Context) {
 	if req.PreferredHomeDistricts == nil {
 		req.PreferredHomeDistricts = []string{}
 	}
+	if req.PreferredCurrentLocations == nil {
+		req.PreferredCurrentLocations = []string{}
+	}
 
 	// Call the user service
 	success, message, err := h.userClient.UpdatePartnerPreferences(
@@ -172,6 +154,8 @@ func (h *Handler) UpdatePartnerPreferences(c *gin.Context) {
 		req.PreferredProfessionTypes,
 		req.PreferredEducationLevels,
 		req.PreferredHomeDistricts,
+		req.PreferredCurrentLocations,
+		req.Lifestyle.toProto(),
 	)
 
 	if err != nil {
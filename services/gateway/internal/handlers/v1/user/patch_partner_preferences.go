@@ -4,29 +4,35 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	userpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/user/v1"
 	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
 )
 
 type PatchPartnerPreferencesRequest struct {
-	MinAgeYears                   *int     `json:"min_age_years,omitempty"`
-	MaxAgeYears                   *int     `json:"max_age_years,omitempty"`
-	MinHeightCM                   *int     `json:"min_height_cm,omitempty"`
-	MaxHeightCM                   *int     `json:"max_height_cm,omitempty"`
-	AcceptPhysicallyChallenged    *bool    `json:"accept_physically_challenged,omitempty"`
-	PreferredCommunities          []string `json:"preferred_communities,omitempty"`
-	PreferredMaritalStatus        []string `json:"preferred_marital_status,omitempty"`
-	PreferredProfessions          []string `json:"preferred_professions,omitempty"`
-	PreferredProfessionTypes      []string `json:"preferred_profession_types,omitempty"`
-	PreferredEducationLevels      []string `json:"preferred_education_levels,omitempty"`
-	PreferredHomeDistricts        []string `json:"preferred_home_districts,omitempty"`
-	ClearPreferredCommunities     bool     `json:"clear_preferred_communities,omitempty"`
-	ClearPreferredMaritalStatus   bool     `json:"clear_preferred_marital_status,omitempty"`
-	ClearPreferredProfessions     bool     `json:"clear_preferred_professions,omitempty"`
-	ClearPreferredProfessionTypes bool     `json:"clear_preferred_profession_types,omitempty"`
-	ClearPreferredEducationLevels bool     `json:"clear_preferred_education_levels,omitempty"`
-	ClearPreferredHomeDistricts   bool     `json:"clear_preferred_home_districts,omitempty"`
+	MinAgeYears                    *int     `json:"min_age_years,omitempty"`
+	MaxAgeYears                    *int     `json:"max_age_years,omitempty"`
+	MinHeightCM                    *int     `json:"min_height_cm,omitempty"`
+	MaxHeightCM                    *int     `json:"max_height_cm,omitempty"`
+	AcceptPhysicallyChallenged     *bool    `json:"accept_physically_challenged,omitempty"`
+	PreferredCommunities           []string `json:"preferred_communities,omitempty"`
+	PreferredMaritalStatus         []string `json:"preferred_marital_status,omitempty"`
+	PreferredProfessions           []string `json:"preferred_professions,omitempty"`
+	PreferredProfessionTypes       []string `json:"preferred_profession_types,omitempty"`
+	PreferredEducationLevels       []string `json:"preferred_education_levels,omitempty"`
+	PreferredHomeDistricts         []string `json:"preferred_home_districts,omitempty"`
+	PreferredCurrentLocations      []string `json:"preferred_current_locations,omitempty"`
+	ClearPreferredCommunities      bool     `json:"clear_preferred_communities,omitempty"`
+	ClearPreferredMaritalStatus    bool     `json:"clear_preferred_marital_status,omitempty"`
+	ClearPreferredProfessions      bool     `json:"clear_preferred_professions,omitempty"`
+	ClearPreferredProfessionTypes  bool     `json:"clear_preferred_profession_types,omitempty"`
+	ClearPreferredEducationLevels  bool     `json:"clear_preferred_education_levels,omitempty"`
+	ClearPreferredHomeDistricts    bool     `json:"clear_preferred_home_districts,omitempty"`
+	ClearPreferredCurrentLocations bool     `json:"clear_preferred_current_locations,omitempty"`
+
+	// Replaces all lifestyle preferences when present
+	Lifestyle *LifestylePreferencesRequest `json:"lifestyle,omitempty"`
 }
 
 func (h *Handler) PatchPartnerPreferences(c *gin.Context) {
@@ -60,15 +66,6 @@ func (h *Handler) PatchPartnerPreferences(c *gin.Context) {
 	}
 
 	// Validate array elements if provided
-	if len(req.PreferredCommunities) > 0 {
-		for _, community := range req.PreferredCommunities {
-			if err := validation.ValidateCommunity(community); err != nil {
-				pkghttp.Error(c, pkghttp.NewBadRequest("Invalid community: "+community, err))
-				return
-			}
-		}
-	}
-
 	if len(req.PreferredMaritalStatus) > 0 {
 		for _, status := range req.PreferredMaritalStatus {
 			if err := validation.ValidateMaritalStatus(status); err != nil {
@@ -78,15 +75,6 @@ func (h *Handler) PatchPartnerPreferences(c *gin.Context) {
 		}
 	}
 
-	if len(req.PreferredProfessions) > 0 {
-		for _, profession := range req.PreferredProfessions {
-			if err := validation.ValidateProfession(profession); err != nil {
-				pkghttp.Error(c, pkghttp.NewBadRequest("Invalid profession: "+profession, err))
-				return
-			}
-		}
-	}
-
 	if len(req.PreferredProfessionTypes) > 0 {
 		for _, profType := range req.PreferredProfessionTypes {
 			if err := validation.ValidateProfessionType(profType); err != nil {
@@ -96,22 +84,13 @@ func (h *Handler) PatchPartnerPreferences(c *gin.Context) {
 		}
 	}
 
-	if len(req.PreferredEducationLevels) > 0 {
-		for _, level := range req.PreferredEducationLevels {
-			if err := validation.ValidateEducationLevel(level); err != nil {
-				pkghttp.Error(c, pkghttp.NewBadRequest("Invalid education level: "+level, err))
-				return
-			}
-		}
-	}
-
-	if len(req.PreferredHomeDistricts) > 0 {
-		for _, district := range req.PreferredHomeDistricts {
-			if err := validation.ValidateHomeDistrict(district); err != nil {
-				pkghttp.Error(c, pkghttp.NewBadRequest("Invalid home district: "+district, err))
-				return
-			}
+	var lifestyle *userpb.LifestylePreferencesData
+	if req.Lifestyle != nil {
+		if err := req.Lifestyle.validate(); err != nil {
+			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
+			return
 		}
+		lifestyle = req.Lifestyle.toProto()
 	}
 
 	success, message, err := h.userClient.PatchPartnerPreferences(
@@ -128,12 +107,15 @@ func (h *Handler) PatchPartnerPreferences(c *gin.Context) {
 		req.PreferredProfessionTypes,
 		req.PreferredEducationLevels,
 		req.PreferredHomeDistricts,
+		req.PreferredCurrentLocations,
 		req.ClearPreferredCommunities,
 		req.ClearPreferredMaritalStatus,
 		req.ClearPreferredProfessions,
 		req.ClearPreferredProfessionTypes,
 		req.ClearPreferredEducationLevels,
 		req.ClearPreferredHomeDistricts,
+		req.ClearPreferredCurrentLocations,
+		lifestyle,
 	)
 
 	if err != nil {
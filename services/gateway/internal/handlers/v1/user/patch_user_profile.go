@@ -2,10 +2,12 @@ package user
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
+	userclient "github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/user"
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
 )
 
@@ -21,8 +23,31 @@ type PatchProfileRequest struct {
 	ProfessionType        *string `json:"profession_type,omitempty"`
 	HighestEducationLevel *string `json:"highest_education_level,omitempty"`
 	HomeDistrict          *string `json:"home_district,omitempty"`
+	CurrentLocation       *string `json:"current_location,omitempty"`
 	ClearDateOfBirth      bool    `json:"clear_date_of_birth,omitempty"`
 	ClearHeightCM         bool    `json:"clear_height_cm,omitempty"`
+
+	// Extended profile details
+	AboutMe          *string   `json:"about_me,omitempty"`
+	AnnualIncome     *string   `json:"annual_income,omitempty"`
+	Languages        *[]string `json:"languages,omitempty"`
+	CurrentCity      *string   `json:"current_city,omitempty"`
+	CurrentCountry   *string   `json:"current_country,omitempty"`
+	ResidencyStatus  *string   `json:"residency_status,omitempty"`
+	FatherOccupation *string   `json:"father_occupation,omitempty"`
+	MotherOccupation *string   `json:"mother_occupation,omitempty"`
+	BrothersCount    *int      `json:"brothers_count,omitempty"`
+	SistersCount     *int      `json:"sisters_count,omitempty"`
+	FamilyType       *string   `json:"family_type,omitempty"`
+
+	// Lifestyle attributes
+	PrayerFrequency   *string `json:"prayer_frequency,omitempty"`
+	Hijab             *string `json:"hijab,omitempty"`
+	Beard             *string `json:"beard,omitempty"`
+	MadrasaEducation  *string `json:"madrasa_education,omitempty"`
+	Diet              *string `json:"diet,omitempty"`
+	Smoking           *string `json:"smoking,omitempty"`
+	WillingToRelocate *string `json:"willing_to_relocate,omitempty"`
 }
 
 func (h *Handler) PatchProfile(c *gin.Context) {
@@ -48,13 +73,6 @@ func (h *Handler) PatchProfile(c *gin.Context) {
 		}
 	}
 
-	if req.Community != nil {
-		if err := validation.ValidateCommunity(*req.Community); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
-			return
-		}
-	}
-
 	if req.MaritalStatus != nil {
 		if err := validation.ValidateMaritalStatus(*req.MaritalStatus); err != nil {
 			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
@@ -62,13 +80,6 @@ func (h *Handler) PatchProfile(c *gin.Context) {
 		}
 	}
 
-	if req.Profession != nil {
-		if err := validation.ValidateProfession(*req.Profession); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
-			return
-		}
-	}
-
 	if req.ProfessionType != nil {
 		if err := validation.ValidateProfessionType(*req.ProfessionType); err != nil {
 			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
@@ -76,25 +87,21 @@ func (h *Handler) PatchProfile(c *gin.Context) {
 		}
 	}
 
-	if req.HighestEducationLevel != nil {
-		if err := validation.ValidateEducationLevel(*req.HighestEducationLevel); err != nil {
+	if req.DateOfBirth != "" {
+		if err := validation.ValidateDateOfBirth(req.DateOfBirth); err != nil {
 			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
 			return
 		}
 	}
 
-	if req.HomeDistrict != nil {
-		if err := validation.ValidateHomeDistrict(*req.HomeDistrict); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
-			return
-		}
+	if err := validateProfileDetails(&req); err != nil {
+		pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
+		return
 	}
 
-	if req.DateOfBirth != "" {
-		if err := validation.ValidateDateOfBirth(req.DateOfBirth); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
-			return
-		}
+	if err := validateLifestyle(&req); err != nil {
+		pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
+		return
 	}
 
 	// Call the user service with user ID from context
@@ -112,8 +119,31 @@ func (h *Handler) PatchProfile(c *gin.Context) {
 		req.ProfessionType,
 		req.HighestEducationLevel,
 		req.HomeDistrict,
+		req.CurrentLocation,
 		req.ClearDateOfBirth,
 		req.ClearHeightCM,
+		userclient.ProfileDetails{
+			AboutMe:          req.AboutMe,
+			AnnualIncome:     req.AnnualIncome,
+			Languages:        req.Languages,
+			CurrentCity:      req.CurrentCity,
+			CurrentCountry:   req.CurrentCountry,
+			ResidencyStatus:  req.ResidencyStatus,
+			FatherOccupation: req.FatherOccupation,
+			MotherOccupation: req.MotherOccupation,
+			BrothersCount:    req.BrothersCount,
+			SistersCount:     req.SistersCount,
+			FamilyType:       req.FamilyType,
+		},
+		userclient.Lifestyle{
+			PrayerFrequency:   req.PrayerFrequency,
+			Hijab:             req.Hijab,
+			Beard:             req.Beard,
+			MadrasaEducation:  req.MadrasaEducation,
+			Diet:              req.Diet,
+			Smoking:           req.Smoking,
+			WillingToRelocate: req.WillingToRelocate,
+		},
 	)
 
 	if err != nil {
@@ -126,3 +156,79 @@ func (h *Handler) PatchProfile(c *gin.Context) {
 		"success": success,
 	})
 }
+
+// validateProfileDetails checks the extended profile fields that are present
+func validateProfileDetails(req *PatchProfileRequest) error {
+	if req.AboutMe != nil {
+		if err := validation.ValidateAboutMe(*req.AboutMe); err != nil {
+			return err
+		}
+	}
+
+	if req.AnnualIncome != nil {
+		if err := validation.ValidateIncomeBand(*req.AnnualIncome); err != nil {
+			return err
+		}
+	}
+
+	if req.Languages != nil {
+		for _, language := range *req.Languages {
+			if err := validation.ValidateLanguage(strings.ToLower(strings.TrimSpace(language))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if req.ResidencyStatus != nil {
+		if err := validation.ValidateResidencyStatus(*req.ResidencyStatus); err != nil {
+			return err
+		}
+	}
+
+	if req.FamilyType != nil {
+		if err := validation.ValidateFamilyType(*req.FamilyType); err != nil {
+			return err
+		}
+	}
+
+	for _, value := range []*string{req.CurrentCity, req.CurrentCountry, req.FatherOccupation, req.MotherOccupation} {
+		if value == nil {
+			continue
+		}
+		if err := validation.ValidateShortText(*value); err != nil {
+			return err
+		}
+	}
+
+	if err := validation.ValidateSiblingCount(req.BrothersCount); err != nil {
+		return err
+	}
+
+	return validation.ValidateSiblingCount(req.SistersCount)
+}
+
+// validateLifestyle checks the lifestyle attributes that are present
+func validateLifestyle(req *PatchProfileRequest) error {
+	fields := []struct {
+		value    *string
+		validate func(string) error
+	}{
+		{req.PrayerFrequency, validation.ValidatePrayerFrequency},
+		{req.Hijab, validation.ValidateHijab},
+		{req.Beard, validation.ValidateBeard},
+		{req.MadrasaEducation, validation.ValidateMadrasaEducation},
+		{req.Diet, validation.ValidateDiet},
+		{req.Smoking, validation.ValidateSmoking},
+		{req.WillingToRelocate, validation.ValidateRelocation},
+	}
+
+	for _, field := range fields {
+		if field.value == nil {
+			continue
+		}
+		if err := field.validate(*field.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
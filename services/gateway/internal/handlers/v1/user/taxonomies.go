@@ -0,0 +1,41 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	pkghttp "github.com/mohamedfawas/qubool-kallyanam/pkg/http"
+)
+
+// ListTaxonomyOptions handles GET /api/v1/taxonomies. It returns the
+// selectable community, profession, education level and location values,
+// grouped by category, so clients can build their option lists. Locations
+// also carry their level and parent so clients can show them as a tree. Pass
+// ?category= to fetch a single category.
+func (h *Handler) ListTaxonomyOptions(c *gin.Context) {
+	category := c.Query("category")
+
+	options, err := h.userClient.ListTaxonomyOptions(c.Request.Context(), category)
+	if err != nil {
+		h.logger.Error("Failed to list taxonomy options", "error", err, "category", category)
+		pkghttp.Error(c, pkghttp.FromGRPCError(err))
+		return
+	}
+
+	grouped := make(map[string][]gin.H)
+	for _, option := range options {
+		item := gin.H{
+			"value": option.Value,
+			"label": option.Label,
+		}
+		if option.Level != "" {
+			item["level"] = option.Level
+			item["parent"] = option.Parent
+		}
+		grouped[option.Category] = append(grouped[option.Category], item)
+	}
+
+	pkghttp.Success(c, http.StatusOK, "Options retrieved successfully", gin.H{
+		"options": grouped,
+	})
+}
@@ -9,18 +9,21 @@ import (
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/metrics"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/user"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/export"
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
 )
 
 type Handler struct {
 	userClient *user.Client
+	exporter   *export.Exporter
 	logger     logging.Logger
 	metrics    *metrics.Metrics
 }
 
-func NewHandler(userClient *user.Client, logger logging.Logger, metrics *metrics.Metrics) *Handler {
+func NewHandler(userClient *user.Client, exporter *export.Exporter, logger logging.Logger, metrics *metrics.Metrics) *Handler {
 	return &Handler{
 		userClient: userClient,
+		exporter:   exporter,
 		logger:     logger,
 		metrics:    metrics,
 	}
@@ -38,6 +41,7 @@ type UpdateProfileRequest struct {
 	ProfessionType        string `json:"profession_type"`
 	HighestEducationLevel string `json:"highest_education_level"`
 	HomeDistrict          string `json:"home_district"`
+	CurrentLocation       string `json:"current_location"`
 }
 
 func (h *Handler) UpdateProfile(c *gin.Context) {
@@ -57,7 +61,8 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 		return
 	}
 
-	// Validate input fields
+	// Validate input fields. Community, profession, education level and home
+	// district come from admin-managed option lists the user service checks.
 	if req.HeightCM != 0 {
 		if err := validation.ValidateHeight(&req.HeightCM); err != nil {
 			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
@@ -65,13 +70,6 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 		}
 	}
 
-	if req.Community != "" {
-		if err := validation.ValidateCommunity(req.Community); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
-			return
-		}
-	}
-
 	if req.MaritalStatus != "" {
 		if err := validation.ValidateMaritalStatus(req.MaritalStatus); err != nil {
 			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
@@ -79,13 +77,6 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 		}
 	}
 
-	if req.Profession != "" {
-		if err := validation.ValidateProfession(req.Profession); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
-			return
-		}
-	}
-
 	if req.ProfessionType != "" {
 		if err := validation.ValidateProfessionType(req.ProfessionType); err != nil {
 			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
@@ -93,20 +84,6 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 		}
 	}
 
-	if req.HighestEducationLevel != "" {
-		if err := validation.ValidateEducationLevel(req.HighestEducationLevel); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
-			return
-		}
-	}
-
-	if req.HomeDistrict != "" {
-		if err := validation.ValidateHomeDistrict(req.HomeDistrict); err != nil {
-			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
-			return
-		}
-	}
-
 	if req.DateOfBirth != "" {
 		if err := validation.ValidateDateOfBirth(req.DateOfBirth); err != nil {
 			pkghttp.Error(c, pkghttp.NewBadRequest(err.Error(), nil))
@@ -129,6 +106,7 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 		req.ProfessionType,
 		req.HighestEducationLevel,
 		req.HomeDistrict,
+		req.CurrentLocation,
 	)
 
 	if err != nil {
@@ -11,19 +11,29 @@ import (
 
 // Constants for context keys
 const (
-	UserIDKey = "userID"
-	RoleKey   = "userRole"
+	UserIDKey      = "userID"
+	RoleKey        = "userRole"
+	SessionIDKey   = "sessionID"
+	PermissionsKey = "permissions"
 )
 
+// RevocationChecker reports whether a valid access token was revoked before it expired,
+// e.g. by logout or a password change. It is implemented by the auth service client.
+type RevocationChecker interface {
+	IsTokenRevoked(ctx context.Context, accessToken string) (bool, error)
+}
+
 // Auth provides JWT authentication middleware for the gateway
 type Auth struct {
 	jwtManager *jwt.Manager
+	revocation RevocationChecker
 }
 
 // NewAuth creates a new auth middleware
-func NewAuth(jwtManager *jwt.Manager) *Auth {
+func NewAuth(jwtManager *jwt.Manager, revocation RevocationChecker) *Auth {
 	return &Auth{
 		jwtManager: jwtManager,
+		revocation: revocation,
 	}
 }
 
@@ -63,9 +73,25 @@ func (a *Auth) Authenticate() gin.HandlerFunc {
 			return
 		}
 
+		// A signature check alone accepts tokens that were logged out or revoked.
+		// Fail closed: if revocation cannot be checked, the token is not trusted.
+		revoked, err := a.revocation.IsTokenRevoked(c.Request.Context(), tokenString)
+		if err != nil {
+			pkghttp.Error(c, pkghttp.NewUnauthorized("Unable to verify token", nil))
+			c.Abort()
+			return
+		}
+		if revoked {
+			pkghttp.Error(c, pkghttp.NewUnauthorized("Token has been revoked", nil))
+			c.Abort()
+			return
+		}
+
 		// Store essential claims in context
 		c.Set(UserIDKey, claims.UserID)
 		c.Set(RoleKey, claims.Role)
+		c.Set(SessionIDKey, claims.SessionID)
+		c.Set(PermissionsKey, claims.Permissions)
 
 		// Store token for forwarding to microservices
 		c.Set("token", tokenString)
@@ -109,6 +135,32 @@ func (a *Auth) RequireRole(roles ...jwt.Role) gin.HandlerFunc {
 	}
 }
 
+// RequirePermission returns a middleware that checks if the authenticated admin
+// has all of the specified permissions. It is used together with
+// RequireRole(jwt.RoleAdmin), e.g. RequirePermission(jwt.PermissionPaymentsRead)
+// keeps moderators away from payment data.
+func (a *Auth) RequirePermission(permissions ...jwt.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, exists := c.Get(PermissionsKey)
+		if !exists {
+			pkghttp.Error(c, pkghttp.NewUnauthorized("Authentication required", nil))
+			c.Abort()
+			return
+		}
+
+		granted := value.([]jwt.Permission)
+		for _, permission := range permissions {
+			if !jwt.HasPermission(granted, permission) {
+				pkghttp.Error(c, pkghttp.NewForbidden("Insufficient permissions", nil))
+				c.Abort()
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
 // WithUserID extracts the userID from Gin context and injects it into Go's request context
 // so that any downstream HTTP client or service call can access userID via c.Request.Context()
 func (a *Auth) WithUserID() gin.HandlerFunc {
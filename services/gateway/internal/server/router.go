@@ -41,6 +41,7 @@ func SetupRouter(
 	)
 
 	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	r.GET("/.well-known/jwks.json", authH.JWKS)
 
 	// API v1 base group
 	v1 := r.Group("/api/v1")
@@ -51,6 +52,10 @@ func SetupRouter(
 	registerChatRoutes(v1.Group("/chat"), chatH, auth)
 	registerPaymentRoutes(v1.Group("/payment"), paymentH, auth)
 	registerAdminRoutes(v1.Group("/admin"), adminH, auth)
+
+	// Option lists for profile forms are public so sign-up screens can use them
+	v1.GET("/taxonomies", userH.ListTaxonomyOptions)
+
 	// Health check endpoint
 	r.GET("/health", healthHandler)
 }
@@ -59,7 +64,17 @@ func SetupRouter(
 func registerAuthRoutes(rg *gin.RouterGroup, h *authHandler.Handler, auth *middleware.Auth) {
 	rg.POST("/register", h.Register)
 	rg.POST("/verify", h.Verify)
+	rg.POST("/resend-otp", h.ResendOTP)
 	rg.POST("/login", h.Login)
+	rg.POST("/login/otp/request", h.RequestLoginOTP)
+	rg.POST("/login/otp", h.LoginWithOTP)
+	rg.POST("/forgot-password", h.ForgotPassword)
+	rg.POST("/reset-password", h.ResetPassword)
+	rg.POST("/restore", h.RestoreAccount)
+	rg.GET("/login-alert/report", h.ConfirmUnrecognizedLogin)
+	rg.POST("/login-alert/report", h.ReportUnrecognizedLogin)
+	rg.GET("/oidc/:provider/start", h.StartOIDCLogin)
+	rg.GET("/oidc/:provider/callback", h.CompleteOIDCLogin)
 
 	// Protected user-auth routes
 	protected := rg.Group("/")
@@ -68,12 +83,33 @@ func registerAuthRoutes(rg *gin.RouterGroup, h *authHandler.Handler, auth *middl
 		protected.POST("/logout", h.Logout)
 		protected.POST("/refresh", h.RefreshToken)
 		protected.DELETE("/delete", h.DeleteAccount)
+		protected.POST("/deactivate", h.DeactivateAccount)
+		protected.POST("/reactivate", h.ReactivateAccount)
+
+		protected.POST("/phone/send-otp", h.SendPhoneVerificationOTP)
+		protected.POST("/phone/verify", h.VerifyPhone)
+
+		protected.POST("/email/change", h.RequestEmailChange)
+		protected.POST("/email/change/verify", h.ConfirmEmailChange)
+		protected.POST("/phone/change", h.RequestPhoneChange)
+		protected.POST("/phone/change/verify", h.ConfirmPhoneChange)
+
+		protected.GET("/sessions", h.ListSessions)
+		protected.DELETE("/sessions/:id", h.RevokeSession)
+		protected.POST("/sessions/revoke-others", h.RevokeAllOtherSessions)
+
+		protected.GET("/security-activity", h.ListSecurityActivity)
+
+		protected.POST("/oidc/:provider/link", h.StartOIDCLink)
 	}
 
 	// Admin-specific routes
 	admin := rg.Group("/admin")
 	{
 		admin.POST("/login", h.AdminLogin)
+		admin.POST("/login/2fa/enroll", h.AdminEnroll2FA)
+		admin.POST("/login/2fa/verify", h.AdminVerify2FA)
+		admin.POST("/invites/accept", h.AcceptAdminInvite)
 		adminProtected := admin.Group("/")
 		adminProtected.Use(
 			auth.Authenticate(),
@@ -81,6 +117,17 @@ func registerAuthRoutes(rg *gin.RouterGroup, h *authHandler.Handler, auth *middl
 		)
 		{
 			adminProtected.POST("/logout", h.AdminLogout)
+
+			// Admin account management
+			accounts := adminProtected.Group("/admins")
+			accounts.Use(auth.RequirePermission(jwt.PermissionAdminsManage))
+			{
+				accounts.GET("", h.ListAdmins)
+				accounts.POST("", h.CreateAdmin)
+				accounts.POST("/invite", h.InviteAdmin)
+				accounts.POST("/:id/deactivate", h.DeactivateAdmin)
+				accounts.POST("/:id/reset-password", h.ResetAdminPassword)
+			}
 		}
 	}
 }
@@ -113,6 +160,8 @@ func registerUserRoutes(rg *gin.RouterGroup, h *userHandler.Handler, auth *middl
 		rg.GET("/matches/history", h.GetMatchHistory)
 		rg.GET("/matches/mutual", h.GetMutualMatches)
 		rg.GET("/profile/:id", h.GetDetailedProfile)
+		rg.POST("/data-export", h.RequestDataExport)
+		rg.GET("/data-export/:id", h.GetDataExport)
 	}
 }
 
@@ -164,13 +213,15 @@ func healthHandler(c *gin.Context) {
 }
 
 func registerAdminRoutes(rg *gin.RouterGroup, h *adminHandler.Handler, auth *middleware.Auth) {
-	// All admin routes require authentication and admin role
+	// All admin routes require authentication and admin role,
+	// and each route requires the permission for its action
 	rg.Use(
 		auth.Authenticate(),
 		auth.RequireRole(jwt.RoleAdmin),
+		auth.ForwardToken(), // The user service checks taxonomy permissions from the token
 	)
 	{
-		rg.GET("/users", func(c *gin.Context) {
+		rg.GET("/users", auth.RequirePermission(jwt.PermissionUsersRead), func(c *gin.Context) {
 			// Check if it's a search request
 			if search := c.Query("search"); search != "" {
 				h.SearchUsers(c)
@@ -178,6 +229,27 @@ func registerAdminRoutes(rg *gin.RouterGroup, h *adminHandler.Handler, auth *mid
 			}
 			h.GetUsers(c)
 		})
-		rg.GET("/users/:id", h.GetUser)
+		rg.GET("/users/:id", auth.RequirePermission(jwt.PermissionUsersRead), h.GetUser)
+		rg.GET("/users/:id/auth-events", auth.RequirePermission(jwt.PermissionUsersRead), h.GetUserAuthEvents)
+
+		moderation := rg.Group("/users/:id")
+		moderation.Use(auth.RequirePermission(jwt.PermissionUsersManage))
+		{
+			moderation.POST("/suspend", h.SuspendUser)
+			moderation.POST("/ban", h.BanUser)
+			moderation.POST("/reinstate", h.ReinstateUser)
+		}
+
+		rg.POST("/users/:id/premium", auth.RequirePermission(jwt.PermissionPremiumGrant), h.GrantPremium)
+		rg.GET("/users/:id/payments", auth.RequirePermission(jwt.PermissionPaymentsRead), h.GetUserPayments)
+
+		taxonomies := rg.Group("/taxonomies")
+		taxonomies.Use(auth.RequirePermission(jwt.PermissionTaxonomiesManage))
+		{
+			taxonomies.GET("", h.ListTaxonomyOptions)
+			taxonomies.POST("", h.CreateTaxonomyOption)
+			taxonomies.PATCH("/:id", h.UpdateTaxonomyOption)
+			taxonomies.DELETE("/:id", h.DeleteTaxonomyOption)
+		}
 	}
 }
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	adminHandler "github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/handlers/v1/admin"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/middleware"
+)
+
+type notRevoked struct{}
+
+func (notRevoked) IsTokenRevoked(context.Context, string) (bool, error) {
+	return false, nil
+}
+
+// Moderators review user accounts, so premium and payment routes are off limits
+func TestAdminRoutesForbidModerator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	manager := jwt.NewManager(jwt.Config{
+		SecretKey:      "test-secret",
+		AccessTokenTTL: time.Minute,
+		Issuer:         "test",
+	})
+	token, err := manager.GenerateAdminAccessToken("moderator-1", jwt.AdminRoleModerator, []jwt.Permission{
+		jwt.PermissionUsersRead,
+		jwt.PermissionUsersManage,
+		jwt.PermissionTaxonomiesManage,
+	}, "session-1")
+	if err != nil {
+		t.Fatalf("GenerateAdminAccessToken: %v", err)
+	}
+
+	router := gin.New()
+	auth := middleware.NewAuth(manager, notRevoked{})
+	// The clients are never reached: the permission check rejects the request first
+	registerAdminRoutes(router.Group("/admin"), adminHandler.NewHandler(nil, nil, logging.Default()), auth)
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodPost, "/admin/users/user-1/premium", `{"premium_until":"2030-01-01T00:00:00Z"}`},
+		{http.MethodGet, "/admin/users/user-1/payments", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer "+token)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusForbidden {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, http.StatusForbidden, rec.Body.String())
+			}
+		})
+	}
+}
@@ -2,13 +2,16 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/cdn/s3"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/messaging/rabbitmq"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/metrics"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/tracing"
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/admin" // ✅ Move here
@@ -17,6 +20,7 @@ import (
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/payment"
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/clients/user"
 	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/config"
+	"github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/export"
 	adminHandler "github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/handlers/v1/admin" // ✅ Move here
 	authHandler "github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/handlers/v1/auth"
 	chatHandler "github.com/mohamedfawas/qubool-kallyanam/services/gateway/internal/handlers/v1/chat"
@@ -36,10 +40,14 @@ type Server struct {
 	chatClient    *chat.Client
 	paymentClient *payment.Client
 	adminClient   *admin.Client
+	exporter      *export.Exporter
 	jwtManager    *jwt.Manager
 	auth          *middleware.Auth
 	metrics       *metrics.Metrics
 	tracer        tracing.Tracer
+	chatHandler   *chatHandler.Handler
+	rabbitClient  *rabbitmq.Client // Will be nil if no RabbitMQ DSN is configured
+	stopWorkers   context.CancelFunc
 }
 
 // NewServer creates a new server instance
@@ -52,6 +60,13 @@ func NewServer(cfg *config.Config, logger logging.Logger) (*Server, error) {
 	// Create a new Gin router instance for handling incoming HTTP requests
 	router := gin.New()
 
+	// c.ClientIP() feeds login protection, login alerts and rate limits, so
+	// X-Forwarded-For is only honoured when it was set by our own load balancer.
+	// With no proxies configured the client IP is the peer address.
+	if err := router.SetTrustedProxies(cfg.HTTP.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+
 	// Initialize metrics registry
 	metricsRegistry := metrics.New("gateway")
 
@@ -100,16 +115,52 @@ func NewServer(cfg *config.Config, logger logging.Logger) (*Server, error) {
 		return nil, fmt.Errorf("failed to create admin client: %w", err)
 	}
 
+	// Data exports are stored in a private bucket and only shared through presigned links
+	linkExpiry := time.Duration(cfg.DataExport.LinkExpiryMinutes) * time.Minute
+	exportStorage, err := s3.NewService(s3.NewConfig(
+		cfg.DataExport.S3.Endpoint,
+		cfg.DataExport.S3.Region,
+		cfg.DataExport.S3.AccessKeyID,
+		cfg.DataExport.S3.SecretAccessKey,
+		cfg.DataExport.S3.BucketName,
+		cfg.DataExport.S3.UseSSL,
+	).WithExpiry(linkExpiry))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data export storage: %w", err)
+	}
+	if err := exportStorage.CreatePrivateBucket(context.Background()); err != nil {
+		logger.Error("Failed to create data export bucket", "error", err)
+	}
+
+	exporter := export.NewExporter(
+		authClient,
+		userClient,
+		chatClient,
+		paymentClient,
+		exportStorage,
+		time.Duration(cfg.DataExport.JobTimeoutMinutes)*time.Minute,
+		linkExpiry,
+		time.Duration(cfg.DataExport.CooldownHours)*time.Hour,
+		time.Duration(cfg.DataExport.RetentionHours)*time.Hour,
+		logger,
+	)
+
 	// Create JWT Manager for token validation
+	verificationKeys, err := jwt.LoadVerificationKeys(cfg.Auth.JWT.VerificationKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load JWT verification keys: %w", err)
+	}
+
 	jwtManager := jwt.NewManager(jwt.Config{
-		SecretKey:       cfg.Auth.JWT.SecretKey,
-		AccessTokenTTL:  time.Duration(cfg.Auth.JWT.AccessTokenMinutes) * time.Minute,
-		RefreshTokenTTL: time.Duration(cfg.Auth.JWT.RefreshTokenDays) * 24 * time.Hour,
-		Issuer:          cfg.Auth.JWT.Issuer,
+		SecretKey:        cfg.Auth.JWT.SecretKey,
+		VerificationKeys: verificationKeys,
+		AccessTokenTTL:   time.Duration(cfg.Auth.JWT.AccessTokenMinutes) * time.Minute,
+		RefreshTokenTTL:  time.Duration(cfg.Auth.JWT.RefreshTokenDays) * 24 * time.Hour,
+		Issuer:           cfg.Auth.JWT.Issuer,
 	})
 
 	// Create auth middleware
-	auth := middleware.NewAuth(jwtManager)
+	auth := middleware.NewAuth(jwtManager, authClient)
 
 	// Define the HTTP server with necessary configurations (port, timeouts, router)
 	httpServer := &http.Server{
@@ -136,6 +187,7 @@ func NewServer(cfg *config.Config, logger logging.Logger) (*Server, error) {
 		chatClient:    chatClient,
 		paymentClient: paymentClient,
 		adminClient:   adminClient,
+		exporter:      exporter,
 		jwtManager:    jwtManager,
 		auth:          auth,
 		metrics:       metricsRegistry,
@@ -145,6 +197,10 @@ func NewServer(cfg *config.Config, logger logging.Logger) (*Server, error) {
 	// Register all API routes
 	server.setupRoutes()
 
+	if err := server.subscribeToEvents(); err != nil {
+		return nil, err
+	}
+
 	return server, nil
 }
 
@@ -153,10 +209,11 @@ func (s *Server) setupRoutes() {
 	s.router.Static("/static", "./static")
 
 	authHandler := authHandler.NewHandler(s.authClient, s.logger, s.metrics)
-	userHandler := userHandler.NewHandler(s.userClient, s.logger, s.metrics)
-	chatHandler := chatHandler.NewHandler(s.chatClient, s.userClient, s.logger, s.metrics)
+	userHandler := userHandler.NewHandler(s.userClient, s.exporter, s.logger, s.metrics)
+	chatHandler := chatHandler.NewHandler(s.chatClient, s.userClient, s.authClient, s.logger, s.metrics)
 	paymentHandler := paymentHandler.NewHandler(s.paymentClient, s.logger, s.metrics)
-	adminHandler := adminHandler.NewHandler(s.adminClient, s.logger)
+	adminHandler := adminHandler.NewHandler(s.adminClient, s.paymentClient, s.logger)
+	s.chatHandler = chatHandler
 	SetupRouter(s.router,
 		authHandler,
 		userHandler,
@@ -168,8 +225,74 @@ func (s *Server) setupRoutes() {
 		s.logger)
 }
 
+// subscribeToEvents connects to RabbitMQ and listens for suspended and deleted accounts
+func (s *Server) subscribeToEvents() error {
+	if s.config.RabbitMQ.DSN == "" {
+		s.logger.Warn("RabbitMQ is not configured, open chat connections will not be closed on suspension and data exports are only removed after their retention period")
+		return nil
+	}
+
+	rabbitClient, err := rabbitmq.NewClient(s.config.RabbitMQ.DSN, s.config.RabbitMQ.ExchangeName)
+	if err != nil {
+		return fmt.Errorf("failed to create RabbitMQ client: %w", err)
+	}
+	s.rabbitClient = rabbitClient
+
+	if err := s.rabbitClient.Subscribe("user.suspended", s.handleUserSuspension); err != nil {
+		return fmt.Errorf("failed to subscribe to user.suspended events: %w", err)
+	}
+
+	if err := s.rabbitClient.Subscribe("user.deleted", s.handleUserDeletion); err != nil {
+		return fmt.Errorf("failed to subscribe to user.deleted events: %w", err)
+	}
+
+	s.logger.Info("Subscribed to user suspension and deletion events")
+	return nil
+}
+
+// handleUserSuspension closes the chat connection of a suspended or banned user.
+// Every gateway instance gets its own queue, so each one drops its own connections.
+func (s *Server) handleUserSuspension(message []byte) error {
+	var event struct {
+		UserID    string    `json:"user_id"`
+		Banned    bool      `json:"banned"`
+		EventType string    `json:"event_type"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal user suspension event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received user suspension event", "userID", event.UserID, "banned", event.Banned)
+	s.chatHandler.DisconnectUser(event.UserID)
+	return nil
+}
+
+// handleUserDeletion removes the data exports of a purged account
+func (s *Server) handleUserDeletion(message []byte) error {
+	var event struct {
+		UserID    string    `json:"user_id"`
+		EventType string    `json:"event_type"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal user deletion event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received user deletion event", "userID", event.UserID)
+	return s.exporter.DeleteUserExports(context.Background(), event.UserID)
+}
+
 // Start starts the HTTP server
 func (s *Server) Start() error {
+	workerCtx, cancel := context.WithCancel(context.Background())
+	s.stopWorkers = cancel
+	go s.exporter.RunCleanup(workerCtx, time.Hour)
+
 	s.logger.Info("Starting HTTP server", "port", s.config.HTTP.Port)
 	return s.httpServer.ListenAndServe()
 }
@@ -177,6 +300,9 @@ func (s *Server) Start() error {
 // Stop stops the HTTP server
 func (s *Server) Stop(ctx context.Context) error {
 	s.logger.Info("Stopping HTTP server")
+	if s.stopWorkers != nil {
+		s.stopWorkers()
+	}
 	if s.authClient != nil {
 		s.authClient.Close()
 	}
@@ -193,6 +319,9 @@ func (s *Server) Stop(ctx context.Context) error {
 	if s.adminClient != nil {
 		s.adminClient.Close()
 	}
+	if s.rabbitClient != nil {
+		s.rabbitClient.Close()
+	}
 	// Shutdown tracer
 	if s.tracer != nil {
 		s.tracer.Shutdown(ctx)
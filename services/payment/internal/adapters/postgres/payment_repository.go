@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -130,6 +131,34 @@ func (r *paymentRepository) GetSubscriptionsByUserID(ctx context.Context, userID
 	return subscriptions, nil
 }
 
+// DeletePendingPayments removes the user's unpaid orders. Completed payments are kept as financial records.
+func (r *paymentRepository) DeletePendingPayments(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, models.PaymentStatusPending).
+		Delete(&models.Payment{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete pending payments: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *paymentRepository) GetActiveSubscriptionsEndingBefore(ctx context.Context, before time.Time, after *repositories.SubscriptionCursor, limit int) ([]*models.Subscription, error) {
+	query := r.db.WithContext(ctx).
+		Where("status = ? AND end_date IS NOT NULL AND end_date <= ?", models.SubscriptionStatusActive, before)
+	if after != nil {
+		query = query.Where("(end_date, id) > (?, ?)", after.EndDate, after.ID)
+	}
+
+	var subscriptions []*models.Subscription
+	if err := query.
+		Order("end_date ASC, id ASC").
+		Limit(limit).
+		Find(&subscriptions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get subscriptions ending before %s: %w", before, err)
+	}
+	return subscriptions, nil
+}
+
 // Transaction support
 func (r *paymentRepository) WithTx(ctx context.Context, fn func(context.Context) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
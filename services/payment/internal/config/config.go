@@ -5,6 +5,8 @@ import (
 	"os"
 
 	"github.com/spf13/viper"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/payment/internal/constants"
 )
 
 type Config struct {
@@ -15,6 +17,7 @@ type Config struct {
 	RabbitMQ RabbitMQConfig `mapstructure:"rabbitmq"`
 	Gateway  GatewayConfig  `mapstructure:"gateway"`
 	Plans    PlansConfig    `mapstructure:"plans"`
+	Expiry   ExpiryConfig   `mapstructure:"expiry"`
 }
 
 type GRPCConfig struct {
@@ -51,6 +54,11 @@ type RabbitMQConfig struct {
 	ExchangeName string `mapstructure:"exchange_name"`
 }
 
+type ExpiryConfig struct {
+	CheckIntervalMinutes int   `mapstructure:"check_interval_minutes"` // How often expired subscriptions are looked for
+	ReminderDays         []int `mapstructure:"reminder_days"`          // Days before expiry a reminder email is sent
+}
+
 type GatewayConfig struct {
 	Address string `mapstructure:"address"`
 }
@@ -82,6 +90,13 @@ func LoadConfig(path string) (*Config, error) {
 		config.Gateway.Address = gatewayAddr
 	}
 
+	if config.Expiry.CheckIntervalMinutes <= 0 {
+		config.Expiry.CheckIntervalMinutes = constants.DefaultExpiryCheckInterval
+	}
+	if len(config.Expiry.ReminderDays) == 0 {
+		config.Expiry.ReminderDays = constants.DefaultExpiryReminderDays
+	}
+
 	// Set default plans if none configured
 	if len(config.Plans.Available) == 0 {
 		config.Plans = *GetDefaultPlansConfig()
@@ -39,6 +39,25 @@ const (
 	StatusRefunded  = "refunded"
 )
 
+// Subscription expiry
+const (
+	TopicSubscriptionExpired  = "subscription.expired"
+	TopicSubscriptionExpiring = "subscription.expiring"
+
+	DefaultExpiryCheckInterval = 60 // minutes
+	ExpiryBatchSize            = 100
+)
+
+// DefaultExpiryReminderDays are the days before expiry a reminder is sent
+var DefaultExpiryReminderDays = []int{7, 1}
+
+// Account deletion purge
+const (
+	TopicUserDeleted        = "user.deleted"
+	TopicUserPurgeCompleted = "user.purge_completed"
+	PurgeStepPayment        = "payment"
+)
+
 // Error messages
 const (
 	ErrMsgInvalidPlan                 = "invalid subscription plan"
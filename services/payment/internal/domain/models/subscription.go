@@ -28,6 +28,9 @@ type Subscription struct {
 	PaymentID *uuid.UUID         `gorm:"type:uuid;default:null"`
 	CreatedAt time.Time          `gorm:"not null"`
 	UpdatedAt time.Time          `gorm:"not null"`
+
+	// Days before expiry of the last reminder sent, nil when none was sent yet
+	ExpiryReminderDays *int `gorm:"default:null"`
 }
 
 func (s *Subscription) BeforeCreate(tx *gorm.DB) error {
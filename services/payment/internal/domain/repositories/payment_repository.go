@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mohamedfawas/qubool-kallyanam/services/payment/internal/domain/models"
@@ -14,6 +15,7 @@ type PaymentRepository interface {
 	GetPaymentByID(ctx context.Context, id uuid.UUID) (*models.Payment, error)
 	UpdatePayment(ctx context.Context, payment *models.Payment) error
 	GetPaymentsByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*models.Payment, int64, error)
+	DeletePendingPayments(ctx context.Context, userID uuid.UUID) (int64, error)
 
 	// Subscription operations
 	CreateSubscription(ctx context.Context, subscription *models.Subscription) error
@@ -21,7 +23,23 @@ type PaymentRepository interface {
 	GetSubscriptionByID(ctx context.Context, id uuid.UUID) (*models.Subscription, error)
 	UpdateSubscription(ctx context.Context, subscription *models.Subscription) error
 	GetSubscriptionsByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Subscription, error)
+	// GetActiveSubscriptionsEndingBefore returns active subscriptions whose end date is before the given time,
+	// ordered by end date and ID and starting after the cursor (nil for the first page)
+	GetActiveSubscriptionsEndingBefore(ctx context.Context, before time.Time, after *SubscriptionCursor, limit int) ([]*models.Subscription, error)
 
 	// Transaction support
 	WithTx(ctx context.Context, fn func(context.Context) error) error
 }
+
+// SubscriptionCursor is the position of the last subscription of a page.
+// Paging by position rather than offset keeps rows that change status from
+// shifting later pages, and lets rows that keep failing be skipped.
+type SubscriptionCursor struct {
+	EndDate time.Time
+	ID      uuid.UUID
+}
+
+// NewSubscriptionCursor returns the cursor positioned at the subscription
+func NewSubscriptionCursor(subscription *models.Subscription) *SubscriptionCursor {
+	return &SubscriptionCursor{EndDate: *subscription.EndDate, ID: subscription.ID}
+}
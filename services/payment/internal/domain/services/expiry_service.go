@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/messaging/rabbitmq"
+	"github.com/mohamedfawas/qubool-kallyanam/services/payment/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/payment/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/payment/internal/domain/repositories"
+)
+
+// ExpiryService expires subscriptions once their end date has passed and
+// announces upcoming expiries so the user can be reminded to renew.
+type ExpiryService struct {
+	paymentRepo   repositories.PaymentRepository
+	messageBroker *rabbitmq.Client
+	reminderDays  []int // Sorted from the earliest reminder to the last one
+	logger        logging.Logger
+}
+
+func NewExpiryService(
+	paymentRepo repositories.PaymentRepository,
+	messageBroker *rabbitmq.Client,
+	reminderDays []int,
+	logger logging.Logger,
+) *ExpiryService {
+	days := append([]int(nil), reminderDays...)
+	sort.Sort(sort.Reverse(sort.IntSlice(days)))
+
+	return &ExpiryService{
+		paymentRepo:   paymentRepo,
+		messageBroker: messageBroker,
+		reminderDays:  days,
+		logger:        logger,
+	}
+}
+
+// ExpireSubscriptions marks active subscriptions past their end date as expired
+// and publishes subscription.expired for each of them. It pages through every
+// due subscription; one that fails to update is skipped until the next run.
+func (s *ExpiryService) ExpireSubscriptions(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	expired := 0
+	var cursor *repositories.SubscriptionCursor
+	for {
+		subscriptions, err := s.paymentRepo.GetActiveSubscriptionsEndingBefore(ctx, now, cursor, constants.ExpiryBatchSize)
+		if err != nil {
+			return expired, err
+		}
+
+		for _, subscription := range subscriptions {
+			subscription.Status = models.SubscriptionStatusExpired
+			subscription.UpdatedAt = now
+			if err := s.paymentRepo.UpdateSubscription(ctx, subscription); err != nil {
+				s.logger.Error("Failed to expire subscription", "subscriptionID", subscription.ID, "error", err)
+				continue
+			}
+
+			s.publish(constants.TopicSubscriptionExpired, map[string]interface{}{
+				"user_id":         subscription.UserID.String(),
+				"subscription_id": subscription.ID.String(),
+				"plan_id":         subscription.PlanID,
+				"end_date":        subscription.EndDate,
+				"timestamp":       now,
+			})
+			expired++
+		}
+
+		if len(subscriptions) < constants.ExpiryBatchSize {
+			return expired, nil
+		}
+		cursor = repositories.NewSubscriptionCursor(subscriptions[len(subscriptions)-1])
+	}
+}
+
+// SendExpiryReminders publishes subscription.expiring for active subscriptions
+// that reached a reminder day. Each reminder is only sent once per subscription.
+func (s *ExpiryService) SendExpiryReminders(ctx context.Context) (int, error) {
+	if len(s.reminderDays) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	horizon := now.AddDate(0, 0, s.reminderDays[0])
+
+	sent := 0
+	var cursor *repositories.SubscriptionCursor
+	for {
+		subscriptions, err := s.paymentRepo.GetActiveSubscriptionsEndingBefore(ctx, horizon, cursor, constants.ExpiryBatchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, subscription := range subscriptions {
+			if s.sendReminder(ctx, subscription, now) {
+				sent++
+			}
+		}
+
+		if len(subscriptions) < constants.ExpiryBatchSize {
+			return sent, nil
+		}
+		cursor = repositories.NewSubscriptionCursor(subscriptions[len(subscriptions)-1])
+	}
+}
+
+func (s *ExpiryService) sendReminder(ctx context.Context, subscription *models.Subscription, now time.Time) bool {
+	if !subscription.EndDate.After(now) {
+		return false // Expired, handled by ExpireSubscriptions
+	}
+
+	reminderDay, due := s.dueReminder(subscription, now)
+	if !due {
+		return false
+	}
+
+	subscription.ExpiryReminderDays = &reminderDay
+	subscription.UpdatedAt = now
+	if err := s.paymentRepo.UpdateSubscription(ctx, subscription); err != nil {
+		s.logger.Error("Failed to record expiry reminder", "subscriptionID", subscription.ID, "error", err)
+		return false
+	}
+
+	s.publish(constants.TopicSubscriptionExpiring, map[string]interface{}{
+		"user_id":         subscription.UserID.String(),
+		"subscription_id": subscription.ID.String(),
+		"plan_id":         subscription.PlanID,
+		"end_date":        subscription.EndDate,
+		"days_left":       int(math.Ceil(subscription.EndDate.Sub(now).Hours() / 24)),
+		"timestamp":       now,
+	})
+	return true
+}
+
+// Run expires subscriptions and sends reminders at the given interval until the context is cancelled
+func (s *ExpiryService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ExpiryService) runOnce(ctx context.Context) {
+	expired, err := s.ExpireSubscriptions(ctx)
+	if err != nil {
+		s.logger.Error("Subscription expiry run failed", "error", err)
+	} else if expired > 0 {
+		s.logger.Info("Expired subscriptions", "count", expired)
+	}
+
+	sent, err := s.SendExpiryReminders(ctx)
+	if err != nil {
+		s.logger.Error("Expiry reminder run failed", "error", err)
+	} else if sent > 0 {
+		s.logger.Info("Sent expiry reminders", "count", sent)
+	}
+}
+
+// dueReminder returns the latest reminder day the subscription has reached,
+// unless that reminder or a later one was already sent
+func (s *ExpiryService) dueReminder(subscription *models.Subscription, now time.Time) (int, bool) {
+	due := -1
+	for _, day := range s.reminderDays {
+		if !subscription.EndDate.After(now.AddDate(0, 0, day)) {
+			due = day
+		}
+	}
+	if due < 0 {
+		return 0, false
+	}
+	if subscription.ExpiryReminderDays != nil && *subscription.ExpiryReminderDays <= due {
+		return 0, false
+	}
+	return due, true
+}
+
+func (s *ExpiryService) publish(topic string, event map[string]interface{}) {
+	if s.messageBroker == nil {
+		return
+	}
+	if err := s.messageBroker.Publish(topic, event); err != nil {
+		s.logger.Error("Failed to publish subscription event", "topic", topic, "userID", event["user_id"], "error", err)
+	}
+}
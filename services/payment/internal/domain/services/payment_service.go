@@ -281,6 +281,41 @@ func (s *PaymentService) CreatePaymentURL(ctx context.Context, userID, planID st
 	}, nil
 }
 
+// HandleUserDeletion cancels the subscriptions of a deleted user and removes
+// unpaid orders. Completed payments are kept for accounting.
+func (s *PaymentService) HandleUserDeletion(ctx context.Context, userID string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	subscriptions, err := s.paymentRepo.GetSubscriptionsByUserID(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+
+	cancelled := 0
+	for _, subscription := range subscriptions {
+		if subscription.Status != models.SubscriptionStatusActive && subscription.Status != models.SubscriptionStatusPending {
+			continue
+		}
+		subscription.Status = models.SubscriptionStatusCancelled
+		subscription.UpdatedAt = time.Now()
+		if err := s.paymentRepo.UpdateSubscription(ctx, subscription); err != nil {
+			return err
+		}
+		cancelled++
+	}
+
+	deleted, err := s.paymentRepo.DeletePendingPayments(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Purged payment data of deleted user", "userID", userID, "cancelledSubscriptions", cancelled, "deletedPendingPayments", deleted)
+	return nil
+}
+
 // Helper functions
 func getStringPtr(s *string) string {
 	if s == nil {
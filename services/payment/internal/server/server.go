@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
@@ -20,6 +21,7 @@ import (
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/payment/razorpay"
 	"github.com/mohamedfawas/qubool-kallyanam/services/payment/internal/adapters/postgres"
 	"github.com/mohamedfawas/qubool-kallyanam/services/payment/internal/config"
+	"github.com/mohamedfawas/qubool-kallyanam/services/payment/internal/constants"
 	"github.com/mohamedfawas/qubool-kallyanam/services/payment/internal/domain/models"
 	"github.com/mohamedfawas/qubool-kallyanam/services/payment/internal/domain/services"
 	grpcv1 "github.com/mohamedfawas/qubool-kallyanam/services/payment/internal/handlers/grpc/v1"
@@ -34,6 +36,10 @@ type Server struct {
 	httpServer   *http.Server
 	pgClient     *pgdb.Client
 	rabbitClient *rabbitmq.Client
+
+	paymentService *services.PaymentService
+	expiryService  *services.ExpiryService
+	stopWorkers    context.CancelFunc
 }
 
 func NewServer(cfg *config.Config, logger logging.Logger) (*Server, error) {
@@ -83,18 +89,35 @@ func NewServer(cfg *config.Config, logger logging.Logger) (*Server, error) {
 	}
 
 	// Register services
-	if err := registerServices(grpcServer, httpRouter, pgClient.DB, cfg, logger, rabbitClient); err != nil {
+	paymentService, err := registerServices(grpcServer, httpRouter, pgClient.DB, cfg, logger, rabbitClient)
+	if err != nil {
 		return nil, fmt.Errorf("failed to register services: %w", err)
 	}
 
-	return &Server{
+	expiryService := services.NewExpiryService(
+		postgres.NewPaymentRepository(pgClient.DB),
+		rabbitClient,
+		cfg.Expiry.ReminderDays,
+		logger,
+	)
+
+	server := &Server{
 		config:       cfg,
 		logger:       logger,
 		grpcServer:   grpcServer,
 		httpServer:   httpServer,
 		pgClient:     pgClient,
 		rabbitClient: rabbitClient,
-	}, nil
+
+		paymentService: paymentService,
+		expiryService:  expiryService,
+	}
+
+	if err := server.subscribeToEvents(); err != nil {
+		return nil, fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+
+	return server, nil
 }
 
 func autoMigrate(db *gorm.DB) error {
@@ -111,7 +134,7 @@ func registerServices(
 	cfg *config.Config,
 	logger logging.Logger,
 	rabbitClient *rabbitmq.Client,
-) error {
+) (*services.PaymentService, error) {
 	// Register health service for gRPC
 	health.RegisterHealthService(grpcServer, db)
 
@@ -136,6 +159,47 @@ func registerServices(
 	setupHTTPRoutes(httpRouter, httpHandler)
 
 	logger.Info("Payment services registered successfully")
+	return paymentService, nil
+}
+
+// subscribeToEvents listens for deleted accounts so their payment data can be purged
+func (s *Server) subscribeToEvents() error {
+	if err := s.rabbitClient.Subscribe(constants.TopicUserDeleted, s.handleUserDeletion); err != nil {
+		return fmt.Errorf("failed to subscribe to %s events: %w", constants.TopicUserDeleted, err)
+	}
+
+	s.logger.Info("Subscribed to user deletion events")
+	return nil
+}
+
+// handleUserDeletion purges the payment data of a deleted user and confirms it to the auth service
+func (s *Server) handleUserDeletion(message []byte) error {
+	var event struct {
+		UserID    string    `json:"user_id"`
+		EventType string    `json:"event_type"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal user deletion event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received user deletion event", "userID", event.UserID)
+
+	if err := s.paymentService.HandleUserDeletion(context.Background(), event.UserID); err != nil {
+		s.logger.Error("Failed to purge user payment data", "userID", event.UserID, "error", err)
+		return err
+	}
+
+	ack := map[string]interface{}{
+		"user_id":   event.UserID,
+		"step":      constants.PurgeStepPayment,
+		"timestamp": time.Now(),
+	}
+	if err := s.rabbitClient.Publish(constants.TopicUserPurgeCompleted, ack); err != nil {
+		s.logger.Error("Failed to publish purge completed event", "userID", event.UserID, "error", err)
+	}
 	return nil
 }
 
@@ -179,6 +243,11 @@ func createErrorInterceptor() grpc.UnaryServerInterceptor {
 }
 
 func (s *Server) Start() error {
+	// Start the subscription expiry worker
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	s.stopWorkers = stopWorkers
+	go s.expiryService.Run(workerCtx, time.Duration(s.config.Expiry.CheckIntervalMinutes)*time.Minute)
+
 	// Start HTTP server in a goroutine
 	go func() {
 		s.logger.Info("Starting HTTP server", "port", s.config.HTTP.Port)
@@ -200,6 +269,11 @@ func (s *Server) Start() error {
 func (s *Server) Stop() {
 	s.logger.Info("Stopping servers")
 
+	// Stop background workers
+	if s.stopWorkers != nil {
+		s.stopWorkers()
+	}
+
 	// Stop gRPC server
 	s.grpcServer.GracefulStop()
 
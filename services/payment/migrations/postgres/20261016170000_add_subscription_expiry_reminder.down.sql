@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_subscriptions_active_end_date;
+
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS expiry_reminder_days;
@@ -0,0 +1,3 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS expiry_reminder_days INT;
+
+CREATE INDEX IF NOT EXISTS idx_subscriptions_active_end_date ON subscriptions (end_date) WHERE status = 'active';
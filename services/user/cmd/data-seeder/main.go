@@ -175,6 +175,7 @@ func generateRandomUserProfile(batchNumber, recordIndex int) models.UserProfile
 		HighestEducationLevel: getRandomEducationLevel(r),
 		HomeDistrict:          getRandomHomeDistrict(r),
 		ProfilePictureURL:     &profilePicURL,
+		Lifestyle:             models.NotMentionedLifestyle(),
 		LastLogin:             time.Now().Add(-time.Duration(r.Intn(30*24)) * time.Hour),
 		CreatedAt:             time.Now(),
 		UpdatedAt:             time.Now(),
@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
 	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/constants"
 	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
 	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/repositories"
@@ -41,8 +42,10 @@ func (r *MatchRepo) GetPotentialProfiles(
 	excludeIDs []uuid.UUID,
 	preferences *models.PartnerPreferences) ([]*models.UserProfile, error) {
 
+	// Hide deactivated and suspended profiles. Suspensions with an end time lapse without a reinstatement event.
 	query := r.db.WithContext(ctx).
-		Where("user_id != ? AND is_deleted = ?", userID, false)
+		Where("user_id != ? AND is_deleted = ? AND is_deactivated = ?", userID, false, false).
+		Where("(is_suspended = ? OR (suspended_until IS NOT NULL AND suspended_until <= ?))", false, indianstandardtime.Now())
 
 	// Exclude already matched profiles
 	if len(excludeIDs) > 0 {
@@ -97,13 +100,10 @@ func (r *MatchRepo) GetPotentialProfiles(
 			query = query.Where("highest_education_level IN ?", levels)
 		}
 
-		if len(preferences.PreferredHomeDistricts) > 0 {
-			districts := make([]string, len(preferences.PreferredHomeDistricts))
-			for i, d := range preferences.PreferredHomeDistricts {
-				districts[i] = string(d)
-			}
-			query = query.Where("home_district IN ?", districts)
-		}
+		// Preferred home districts and current locations are not filters: the
+		// matchmaking service grades locations by distance and adjacency, so a
+		// profile from a bordering district still ranks close behind one inside
+		// the preferred area
 	}
 
 	var profiles []*models.UserProfile
@@ -235,13 +235,16 @@ func (r *MatchRepo) GetMatchHistory(ctx context.Context, userID uuid.UUID, statu
 
 // GetMutualMatches retrieves mutual matches for a user
 func (r *MatchRepo) GetMutualMatches(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*models.MutualMatchData, int, error) {
+	now := indianstandardtime.Now()
+
 	// Get total count
 	var total int64
 	countQuery := r.db.WithContext(ctx).
 		Table("mutual_matches mm").
 		Joins("JOIN user_profiles up ON (CASE WHEN mm.user_id_1 = ? THEN mm.user_id_2 ELSE mm.user_id_1 END) = up.user_id", userID).
-		Where("(mm.user_id_1 = ? OR mm.user_id_2 = ?) AND mm.is_active = ? AND mm.is_deleted = ? AND up.is_deleted = ?",
-			userID, userID, true, false, false)
+		Where("(mm.user_id_1 = ? OR mm.user_id_2 = ?) AND mm.is_active = ? AND mm.is_deleted = ? AND up.is_deleted = ? AND up.is_deactivated = ?",
+			userID, userID, true, false, false, false).
+		Where("(up.is_suspended = ? OR (up.suspended_until IS NOT NULL AND up.suspended_until <= ?))", false, now)
 
 	if err := countQuery.Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -267,8 +270,9 @@ func (r *MatchRepo) GetMutualMatches(ctx context.Context, userID uuid.UUID, limi
 			mm.matched_at
 		`).
 		Joins("JOIN user_profiles up ON (CASE WHEN mm.user_id_1 = ? THEN mm.user_id_2 ELSE mm.user_id_1 END) = up.user_id", userID).
-		Where("(mm.user_id_1 = ? OR mm.user_id_2 = ?) AND mm.is_active = ? AND mm.is_deleted = ? AND up.is_deleted = ?",
-			userID, userID, true, false, false)
+		Where("(mm.user_id_1 = ? OR mm.user_id_2 = ?) AND mm.is_active = ? AND mm.is_deleted = ? AND up.is_deleted = ? AND up.is_deactivated = ?",
+			userID, userID, true, false, false, false).
+		Where("(up.is_suspended = ? OR (up.suspended_until IS NOT NULL AND up.suspended_until <= ?))", false, now)
 
 	var matches []*models.MutualMatchData
 	err := query.
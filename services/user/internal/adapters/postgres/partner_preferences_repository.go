@@ -43,6 +43,8 @@ func (r *PartnerPreferencesRepo) GetPartnerPreferences(ctx context.Context, user
 		MinHeightCM:                prefsWithArrays.MinHeightCM,
 		MaxHeightCM:                prefsWithArrays.MaxHeightCM,
 		AcceptPhysicallyChallenged: prefsWithArrays.AcceptPhysicallyChallenged,
+		PreferredCurrentLocations:  prefsWithArrays.PreferredCurrentLocations,
+		LifestylePreferences:       prefsWithArrays.LifestylePreferences,
 		CreatedAt:                  prefsWithArrays.CreatedAt,
 		UpdatedAt:                  prefsWithArrays.UpdatedAt,
 		IsDeleted:                  prefsWithArrays.IsDeleted,
@@ -94,6 +96,8 @@ func (r *PartnerPreferencesRepo) UpdatePartnerPreferences(ctx context.Context, p
 		MinHeightCM:                prefs.MinHeightCM,
 		MaxHeightCM:                prefs.MaxHeightCM,
 		AcceptPhysicallyChallenged: prefs.AcceptPhysicallyChallenged,
+		PreferredCurrentLocations:  prefs.PreferredCurrentLocations,
+		LifestylePreferences:       prefs.LifestylePreferences,
 		CreatedAt:                  prefs.CreatedAt,
 		UpdatedAt:                  prefs.UpdatedAt,
 		IsDeleted:                  prefs.IsDeleted,
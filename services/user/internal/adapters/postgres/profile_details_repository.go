@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/repositories"
+)
+
+// ProfileDetailsRepo implements the profile details repository interface
+type ProfileDetailsRepo struct {
+	db *gorm.DB
+}
+
+// NewProfileDetailsRepository creates a new profile details repository
+func NewProfileDetailsRepository(db *gorm.DB) repositories.ProfileDetailsRepository {
+	return &ProfileDetailsRepo{
+		db: db,
+	}
+}
+
+// GetProfileDetails retrieves the extended details of a profile, or nil when none were saved yet
+func (r *ProfileDetailsRepo) GetProfileDetails(ctx context.Context, userProfileID uint) (*models.ProfileDetails, error) {
+	var details models.ProfileDetails
+	err := r.db.WithContext(ctx).Where("user_profile_id = ?", userProfileID).First(&details).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &details, nil
+}
+
+// UpsertProfileDetails applies the updates to the profile's details, creating the row if needed
+func (r *ProfileDetailsRepo) UpsertProfileDetails(ctx context.Context, userProfileID uint, updates map[string]interface{}) error {
+	now := indianstandardtime.Now()
+
+	row := map[string]interface{}{
+		"user_profile_id": userProfileID,
+		"created_at":      now,
+		"updated_at":      now,
+	}
+	columns := []string{"updated_at"}
+	for column, value := range updates {
+		row[column] = value
+		columns = append(columns, column)
+	}
+
+	return r.db.WithContext(ctx).
+		Model(&models.ProfileDetails{}).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_profile_id"}},
+			DoUpdates: clause.AssignmentColumns(columns),
+		}).
+		Create(row).Error
+}
+
+// DeleteProfileDetails removes the extended details of a profile
+func (r *ProfileDetailsRepo) DeleteProfileDetails(ctx context.Context, userProfileID uint) error {
+	return r.db.WithContext(ctx).
+		Where("user_profile_id = ?", userProfileID).
+		Delete(&models.ProfileDetails{}).Error
+}
@@ -104,6 +104,7 @@ func (r *ProfileRepo) UpdateProfile(ctx context.Context, profile *models.UserPro
 			"profession_type":         profile.ProfessionType,
 			"highest_education_level": profile.HighestEducationLevel,
 			"home_district":           profile.HomeDistrict,
+			"current_location":        profile.CurrentLocation,
 			"updated_at":              profile.UpdatedAt,
 		})
 
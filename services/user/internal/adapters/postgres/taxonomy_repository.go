@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/repositories"
+)
+
+// TaxonomyRepo implements the taxonomy repository interface
+type TaxonomyRepo struct {
+	db *gorm.DB
+}
+
+// NewTaxonomyRepository creates a new taxonomy repository
+func NewTaxonomyRepository(db *gorm.DB) repositories.TaxonomyRepository {
+	return &TaxonomyRepo{
+		db: db,
+	}
+}
+
+// ListOptions retrieves all taxonomy options, active or not
+func (r *TaxonomyRepo) ListOptions(ctx context.Context) ([]*models.TaxonomyOption, error) {
+	var options []*models.TaxonomyOption
+	err := r.db.WithContext(ctx).
+		Order("category ASC, sort_order ASC, label ASC").
+		Find(&options).Error
+	if err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// GetOptionByID retrieves a taxonomy option by its ID, or nil when it does not exist
+func (r *TaxonomyRepo) GetOptionByID(ctx context.Context, id uint) (*models.TaxonomyOption, error) {
+	var option models.TaxonomyOption
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&option).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &option, nil
+}
+
+// GetOptionByValue retrieves the option with the given value in a category, or nil when it does not exist
+func (r *TaxonomyRepo) GetOptionByValue(ctx context.Context, category models.TaxonomyCategory, value string) (*models.TaxonomyOption, error) {
+	var option models.TaxonomyOption
+	err := r.db.WithContext(ctx).
+		Where("category = ? AND value = ?", category, value).
+		First(&option).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &option, nil
+}
+
+// CreateOption inserts a new taxonomy option
+func (r *TaxonomyRepo) CreateOption(ctx context.Context, option *models.TaxonomyOption) error {
+	return r.db.WithContext(ctx).Create(option).Error
+}
+
+// UpdateOption saves the label, sort order, active flag, parent, level and coordinates of an option
+func (r *TaxonomyRepo) UpdateOption(ctx context.Context, option *models.TaxonomyOption) error {
+	return r.db.WithContext(ctx).
+		Model(&models.TaxonomyOption{}).
+		Where("id = ?", option.ID).
+		Updates(map[string]interface{}{
+			"label":        option.Label,
+			"sort_order":   option.SortOrder,
+			"is_active":    option.IsActive,
+			"parent_value": option.ParentValue,
+			"level":        option.Level,
+			"latitude":     option.Latitude,
+			"longitude":    option.Longitude,
+			"updated_at":   option.UpdatedAt,
+		}).Error
+}
+
+// ListLocationAdjacencies retrieves every pair of bordering locations
+func (r *TaxonomyRepo) ListLocationAdjacencies(ctx context.Context) ([]*models.LocationAdjacency, error) {
+	var adjacencies []*models.LocationAdjacency
+	if err := r.db.WithContext(ctx).Find(&adjacencies).Error; err != nil {
+		return nil, err
+	}
+	return adjacencies, nil
+}
+
+// AddLocationAdjacencies inserts a pair for each bordering location, unless
+// the pair is already stored in either order
+func (r *TaxonomyRepo) AddLocationAdjacencies(ctx context.Context, value string, adjacent []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, other := range adjacent {
+			err := tx.Exec(`
+				INSERT INTO location_adjacency (location_value, adjacent_value)
+				SELECT ?, ?
+				WHERE NOT EXISTS (
+					SELECT 1 FROM location_adjacency
+					WHERE (location_value = ? AND adjacent_value = ?)
+					   OR (location_value = ? AND adjacent_value = ?)
+				)`,
+				value, other,
+				value, other, other, value,
+			).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveLocationAdjacencies deletes the pairs of a location and the given locations
+func (r *TaxonomyRepo) RemoveLocationAdjacencies(ctx context.Context, value string, adjacent []string) error {
+	if len(adjacent) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Where("(location_value = ? AND adjacent_value IN ?) OR (adjacent_value = ? AND location_value IN ?)",
+			value, adjacent, value, adjacent).
+		Delete(&models.LocationAdjacency{}).Error
+}
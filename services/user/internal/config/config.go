@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
 	"github.com/spf13/viper"
 )
 
@@ -17,6 +19,12 @@ type Config struct {
 	Auth        AuthConfig        `mapstructure:"auth"`
 	Storage     StorageConfig     `mapstructure:"storage"`
 	Matchmaking MatchmakingConfig `mapstructure:"matchmaking"`
+	Taxonomy    TaxonomyConfig    `mapstructure:"taxonomy"`
+}
+
+// TaxonomyConfig controls the in-memory cache of admin-managed option lists
+type TaxonomyConfig struct {
+	CacheTTL time.Duration `mapstructure:"cache_ttl"` // How long changes made on another instance take to show up
 }
 
 type EmailConfig struct {
@@ -35,6 +43,16 @@ type StorageConfig struct {
 type MatchmakingConfig struct {
 	Weights     MatchWeights      `mapstructure:"weights"`
 	HardFilters HardFiltersConfig `mapstructure:"hard_filters"`
+	Location    LocationScoring   `mapstructure:"location"`
+}
+
+// LocationScoring controls how the location score falls off with distance
+// for profiles outside the preferred areas
+type LocationScoring struct {
+	HalfScoreDistanceKM float64 `mapstructure:"half_score_distance_km"` // Distance at which the score halves
+	AdjacentScore       float64 `mapstructure:"adjacent_score"`         // Lowest score of a location bordering a preferred one
+	MinScore            float64 `mapstructure:"min_score"`              // Floor of the distance decay, also given when the distance is unknown
+	NearbyDistanceKM    float64 `mapstructure:"nearby_distance_km"`     // Match reasons mention the distance up to this far
 }
 
 type MatchWeights struct {
@@ -42,6 +60,18 @@ type MatchWeights struct {
 	Profession float64 `mapstructure:"profession"`
 	Location   float64 `mapstructure:"location"`
 	Recency    float64 `mapstructure:"recency"`
+
+	// Where the user currently lives, scored against the preferred current locations
+	CurrentLocation float64 `mapstructure:"current_location"`
+
+	// Lifestyle attributes
+	Prayer           float64 `mapstructure:"prayer"`
+	Hijab            float64 `mapstructure:"hijab"`
+	Beard            float64 `mapstructure:"beard"`
+	MadrasaEducation float64 `mapstructure:"madrasa_education"`
+	Diet             float64 `mapstructure:"diet"`
+	Smoking          float64 `mapstructure:"smoking"`
+	Relocation       float64 `mapstructure:"relocation"`
 }
 
 type HardFiltersConfig struct {
@@ -67,10 +97,14 @@ type AuthConfig struct {
 }
 
 type JWTConfig struct {
-	SecretKey string `mapstructure:"secret_key"`
-	Issuer    string `mapstructure:"issuer"`
+	SecretKey        string         `mapstructure:"secret_key"` // Only needed to accept HS256 tokens
+	Issuer           string         `mapstructure:"issuer"`
+	VerificationKeys []JWTKeyConfig `mapstructure:"verification_keys"`
 }
 
+// JWTKeyConfig points to a public key published by the auth service
+type JWTKeyConfig = jwt.VerificationKeyConfig
+
 type RabbitMQConfig struct {
 	DSN          string `mapstructure:"dsn"`
 	ExchangeName string `mapstructure:"exchange_name"`
@@ -110,6 +144,23 @@ func LoadConfig(path string) (*Config, error) {
 	viper.SetConfigFile(path)
 	viper.AutomaticEnv()
 
+	// Lifestyle weights are newer than most config files; a weight of 0 disables the attribute
+	viper.SetDefault("matchmaking.weights.prayer", 2.0)
+	viper.SetDefault("matchmaking.weights.hijab", 1.0)
+	viper.SetDefault("matchmaking.weights.beard", 0.5)
+	viper.SetDefault("matchmaking.weights.madrasa_education", 0.5)
+	viper.SetDefault("matchmaking.weights.diet", 0.5)
+	viper.SetDefault("matchmaking.weights.smoking", 1.5)
+	viper.SetDefault("matchmaking.weights.relocation", 1.0)
+	viper.SetDefault("matchmaking.weights.current_location", 1.0)
+
+	viper.SetDefault("matchmaking.location.half_score_distance_km", 40.0)
+	viper.SetDefault("matchmaking.location.adjacent_score", 0.75)
+	viper.SetDefault("matchmaking.location.min_score", 0.1)
+	viper.SetDefault("matchmaking.location.nearby_distance_km", 100.0)
+
+	viper.SetDefault("taxonomy.cache_ttl", "5m")
+
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
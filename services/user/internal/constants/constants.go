@@ -24,6 +24,9 @@ const (
 	MaxHeight = 220 // cm
 )
 
+// Maximum number of spoken languages on a profile
+const MaxLanguages = 10
+
 // Photo constraints
 const (
 	MaxAdditionalPhotos  = 3
@@ -39,6 +42,13 @@ const (
 	MinPaginationOffset    = 0   // Minimum offset value
 )
 
+// Purge acknowledgement for deleted accounts
+const (
+	TopicUserPurgeCompleted = "user.purge_completed"
+	PurgeStepUserData       = "user"
+	PurgeStepMedia          = "media"
+)
+
 // Match action constants
 var (
 	// ValidMatchActions defines the allowed match actions
@@ -50,7 +60,51 @@ const (
 	DefaultNotMentioned = "not_mentioned"
 )
 
-// Profile enums
+// TaxonomyCategory names an admin-managed option list in the taxonomy_options table
+type TaxonomyCategory string
+
+const (
+	TaxonomyCommunity      TaxonomyCategory = "community"
+	TaxonomyProfession     TaxonomyCategory = "profession"
+	TaxonomyEducationLevel TaxonomyCategory = "education_level"
+	TaxonomyLocation       TaxonomyCategory = "location" // Native place and current residence
+)
+
+// TaxonomyCategories lists the categories admins can manage
+var TaxonomyCategories = []TaxonomyCategory{
+	TaxonomyCommunity,
+	TaxonomyProfession,
+	TaxonomyEducationLevel,
+	TaxonomyLocation,
+}
+
+// LocationLevel is the level of a location option in the
+// country > state > district > city hierarchy
+type LocationLevel string
+
+const (
+	LocationCountry  LocationLevel = "country"
+	LocationState    LocationLevel = "state"
+	LocationDistrict LocationLevel = "district"
+	LocationCity     LocationLevel = "city"
+)
+
+// LocationLevels lists the location levels from the broadest to the narrowest
+var LocationLevels = []LocationLevel{
+	LocationCountry,
+	LocationState,
+	LocationDistrict,
+	LocationCity,
+}
+
+// Taxonomy option constraints
+const (
+	MaxTaxonomyValueLength = 100
+	MaxTaxonomyLabelLength = 200
+)
+
+// Profile enums. Community, Profession, EducationLevel and HomeDistrict list
+// the seeded values only; admins can add more through the taxonomy.
 type Community string
 
 const (
@@ -126,6 +180,109 @@ const (
 	DistrictNotMentioned       HomeDistrict = DefaultNotMentioned
 )
 
+type IncomeBand string
+
+// Annual income bands, in Indian rupees
+const (
+	IncomeUpTo3Lakh        IncomeBand = "upto_3_lakh"
+	Income3To5Lakh         IncomeBand = "3_to_5_lakh"
+	Income5To10Lakh        IncomeBand = "5_to_10_lakh"
+	Income10To20Lakh       IncomeBand = "10_to_20_lakh"
+	Income20To50Lakh       IncomeBand = "20_to_50_lakh"
+	IncomeAbove50Lakh      IncomeBand = "above_50_lakh"
+	IncomeBandNotMentioned IncomeBand = DefaultNotMentioned
+)
+
+type FamilyType string
+
+const (
+	FamilyTypeNuclear      FamilyType = "nuclear"
+	FamilyTypeJoint        FamilyType = "joint"
+	FamilyTypeExtended     FamilyType = "extended"
+	FamilyTypeNotMentioned FamilyType = DefaultNotMentioned
+)
+
+type ResidencyStatus string
+
+// Residency status in the country the user currently lives in
+const (
+	ResidencyCitizen           ResidencyStatus = "citizen"
+	ResidencyPermanentResident ResidencyStatus = "permanent_resident"
+	ResidencyWorkVisa          ResidencyStatus = "work_visa"
+	ResidencyStudentVisa       ResidencyStatus = "student_visa"
+	ResidencyDependentVisa     ResidencyStatus = "dependent_visa"
+	ResidencyNotMentioned      ResidencyStatus = DefaultNotMentioned
+)
+
+type PrayerFrequency string
+
+// How regularly the user offers the five daily prayers
+const (
+	PrayerFiveTimes    PrayerFrequency = "five_times"
+	PrayerMostly       PrayerFrequency = "mostly"
+	PrayerSometimes    PrayerFrequency = "sometimes"
+	PrayerRarely       PrayerFrequency = "rarely"
+	PrayerNotMentioned PrayerFrequency = DefaultNotMentioned
+)
+
+type Hijab string
+
+// Head covering worn by brides
+const (
+	HijabNiqab        Hijab = "niqab"
+	HijabHijab        Hijab = "hijab"
+	HijabNone         Hijab = "none"
+	HijabNotMentioned Hijab = DefaultNotMentioned
+)
+
+type Beard string
+
+// Beard kept by grooms
+const (
+	BeardFull         Beard = "full"
+	BeardTrimmed      Beard = "trimmed"
+	BeardNone         Beard = "none"
+	BeardNotMentioned Beard = DefaultNotMentioned
+)
+
+type MadrasaEducation string
+
+const (
+	MadrasaHafiz        MadrasaEducation = "hafiz"
+	MadrasaCompleted    MadrasaEducation = "completed"
+	MadrasaBasic        MadrasaEducation = "basic"
+	MadrasaNone         MadrasaEducation = "none"
+	MadrasaNotMentioned MadrasaEducation = DefaultNotMentioned
+)
+
+type Diet string
+
+const (
+	DietNonVegetarian Diet = "non_vegetarian"
+	DietVegetarian    Diet = "vegetarian"
+	DietEggetarian    Diet = "eggetarian"
+	DietNotMentioned  Diet = DefaultNotMentioned
+)
+
+type Smoking string
+
+const (
+	SmokingNever        Smoking = "never"
+	SmokingOccasionally Smoking = "occasionally"
+	SmokingRegularly    Smoking = "regularly"
+	SmokingNotMentioned Smoking = DefaultNotMentioned
+)
+
+type Relocation string
+
+// Whether the user is willing to move after marriage
+const (
+	RelocationYes          Relocation = "yes"
+	RelocationNo           Relocation = "no"
+	RelocationOpen         Relocation = "open"
+	RelocationNotMentioned Relocation = DefaultNotMentioned
+)
+
 // Match status constants
 type MatchStatus string
 
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"github.com/lib/pq"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/constants"
+)
+
+// Lifestyle holds the religious practice and lifestyle attributes of a
+// profile. It is stored as columns of user_profiles.
+type Lifestyle struct {
+	PrayerFrequency   PrayerFrequency  `gorm:"type:prayer_frequency_enum;column:prayer_frequency"`
+	Hijab             Hijab            `gorm:"type:hijab_enum;column:hijab"` // Brides only
+	Beard             Beard            `gorm:"type:beard_enum;column:beard"` // Grooms only
+	MadrasaEducation  MadrasaEducation `gorm:"type:madrasa_education_enum;column:madrasa_education"`
+	Diet              Diet             `gorm:"type:diet_enum;column:diet"`
+	Smoking           Smoking          `gorm:"type:smoking_enum;column:smoking"`
+	WillingToRelocate Relocation       `gorm:"type:relocation_enum;column:willing_to_relocate"`
+}
+
+// LifestylePreferences lists the lifestyle values a user is looking for in a
+// partner. An empty list means the user has no preference. It is stored as
+// array columns of partner_preferences.
+type LifestylePreferences struct {
+	PreferredPrayerFrequencies pq.StringArray `gorm:"type:prayer_frequency_enum[];column:preferred_prayer_frequencies"`
+	PreferredHijab             pq.StringArray `gorm:"type:hijab_enum[];column:preferred_hijab"`
+	PreferredBeard             pq.StringArray `gorm:"type:beard_enum[];column:preferred_beard"`
+	PreferredMadrasaEducation  pq.StringArray `gorm:"type:madrasa_education_enum[];column:preferred_madrasa_education"`
+	PreferredDiets             pq.StringArray `gorm:"type:diet_enum[];column:preferred_diets"`
+	PreferredSmoking           pq.StringArray `gorm:"type:smoking_enum[];column:preferred_smoking"`
+	PreferredRelocation        pq.StringArray `gorm:"type:relocation_enum[];column:preferred_relocation"`
+}
+
+// NotMentionedLifestyle returns a Lifestyle with every attribute set to not_mentioned,
+// used for new profiles since the enum columns do not accept empty values
+func NotMentionedLifestyle() Lifestyle {
+	return Lifestyle{
+		PrayerFrequency:   constants.PrayerNotMentioned,
+		Hijab:             constants.HijabNotMentioned,
+		Beard:             constants.BeardNotMentioned,
+		MadrasaEducation:  constants.MadrasaNotMentioned,
+		Diet:              constants.DietNotMentioned,
+		Smoking:           constants.SmokingNotMentioned,
+		WillingToRelocate: constants.RelocationNotMentioned,
+	}
+}
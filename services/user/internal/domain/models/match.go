@@ -60,6 +60,7 @@ type RecommendedProfile struct {
 	ProfessionType        ProfessionType
 	HighestEducationLevel EducationLevel
 	HomeDistrict          HomeDistrict
+	CurrentLocation       string
 	ProfilePictureURL     *string
 	LastLogin             time.Time
 	MatchReasons          []string
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ProfileDetails holds the extended matrimonial profile: the about me text,
+// income, spoken languages, current residence and family background.
+// Every profile has at most one row, created on the first update.
+type ProfileDetails struct {
+	ID               uint            `gorm:"primaryKey;autoIncrement;column:id"`
+	UserProfileID    uint            `gorm:"not null;uniqueIndex;column:user_profile_id"`
+	AboutMe          string          `gorm:"type:text;column:about_me"`
+	AnnualIncome     IncomeBand      `gorm:"type:income_band_enum;column:annual_income"`
+	Languages        pq.StringArray  `gorm:"type:varchar(50)[];column:languages"`
+	CurrentCity      string          `gorm:"size:100;column:current_city"`
+	CurrentCountry   string          `gorm:"size:100;column:current_country"`
+	ResidencyStatus  ResidencyStatus `gorm:"type:residency_status_enum;column:residency_status"`
+	FatherOccupation string          `gorm:"size:100;column:father_occupation"`
+	MotherOccupation string          `gorm:"size:100;column:mother_occupation"`
+	BrothersCount    *int            `gorm:"column:brothers_count;check:brothers_count>=0 AND brothers_count<=20"`
+	SistersCount     *int            `gorm:"column:sisters_count;check:sisters_count>=0 AND sisters_count<=20"`
+	FamilyType       FamilyType      `gorm:"type:family_type_enum;column:family_type"`
+	CreatedAt        time.Time       `gorm:"not null;default:now();column:created_at"`
+	UpdatedAt        time.Time       `gorm:"not null;default:now();column:updated_at"`
+}
+
+// TableName returns the table name for ProfileDetails
+func (ProfileDetails) TableName() string {
+	return "profile_details"
+}
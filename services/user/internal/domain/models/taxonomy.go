@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/constants"
+)
+
+type TaxonomyCategory = constants.TaxonomyCategory
+type LocationLevel = constants.LocationLevel
+
+// TaxonomyOption is one selectable value of an admin-managed option list,
+// such as a profession or a home district. Value is what profiles store and
+// never changes; Label is what clients display. Location options also have a
+// level and, below country level, the value of their parent location;
+// districts and cities also have the coordinates of their centroid.
+type TaxonomyOption struct {
+	ID          uint             `gorm:"primaryKey;autoIncrement;column:id"`
+	Category    TaxonomyCategory `gorm:"size:50;not null;uniqueIndex:uq_taxonomy_options_category_value;column:category"`
+	Value       string           `gorm:"size:100;not null;uniqueIndex:uq_taxonomy_options_category_value;column:value"`
+	Label       string           `gorm:"size:200;not null;column:label"`
+	SortOrder   int              `gorm:"not null;default:0;column:sort_order"`
+	IsActive    bool             `gorm:"not null;default:true;column:is_active"` // Inactive options are kept for existing profiles but cannot be selected
+	ParentValue *string          `gorm:"size:100;column:parent_value"`
+	Level       *LocationLevel   `gorm:"size:20;column:level"`
+	Latitude    *float64         `gorm:"column:latitude"`
+	Longitude   *float64         `gorm:"column:longitude"`
+	CreatedAt   time.Time        `gorm:"not null;default:now();column:created_at"`
+	UpdatedAt   time.Time        `gorm:"not null;default:now();column:updated_at"`
+}
+
+// TableName returns the table name for TaxonomyOption
+func (TaxonomyOption) TableName() string {
+	return "taxonomy_options"
+}
+
+// LocationAdjacency records that two locations share a border. Each pair is
+// stored once, in either order.
+type LocationAdjacency struct {
+	LocationValue string    `gorm:"size:100;primaryKey;column:location_value"`
+	AdjacentValue string    `gorm:"size:100;primaryKey;column:adjacent_value"`
+	CreatedAt     time.Time `gorm:"not null;default:now();column:created_at"`
+}
+
+// TableName returns the table name for LocationAdjacency
+func (LocationAdjacency) TableName() string {
+	return "location_adjacency"
+}
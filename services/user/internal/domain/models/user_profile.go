@@ -16,6 +16,16 @@ type Profession = constants.Profession
 type ProfessionType = constants.ProfessionType
 type EducationLevel = constants.EducationLevel
 type HomeDistrict = constants.HomeDistrict
+type IncomeBand = constants.IncomeBand
+type FamilyType = constants.FamilyType
+type ResidencyStatus = constants.ResidencyStatus
+type PrayerFrequency = constants.PrayerFrequency
+type Hijab = constants.Hijab
+type Beard = constants.Beard
+type MadrasaEducation = constants.MadrasaEducation
+type Diet = constants.Diet
+type Smoking = constants.Smoking
+type Relocation = constants.Relocation
 
 // UserProfile represents the user_profiles table in PostgreSQL
 // Note: ID is BIGSERIAL (auto-increment), so we use uint
@@ -36,16 +46,21 @@ type UserProfile struct {
 	DateOfBirth           *time.Time     `gorm:"type:date;column:date_of_birth"`
 	HeightCM              *int           `gorm:"column:height_cm;check:height_cm>=130 AND height_cm<=220"`
 	PhysicallyChallenged  bool           `gorm:"not null;default:false;column:physically_challenged"`
-	Community             Community      `gorm:"type:community_enum"`
+	Community             Community      `gorm:"size:100"`
 	MaritalStatus         MaritalStatus  `gorm:"type:marital_status_enum;column:marital_status"`
-	Profession            Profession     `gorm:"type:profession_enum"`
+	Profession            Profession     `gorm:"size:100"`
 	ProfessionType        ProfessionType `gorm:"type:profession_type_enum;column:profession_type"`
-	HighestEducationLevel EducationLevel `gorm:"type:education_level_enum;column:highest_education_level"`
-	HomeDistrict          HomeDistrict   `gorm:"type:home_district_enum;column:home_district"`
+	HighestEducationLevel EducationLevel `gorm:"size:100;column:highest_education_level"`
+	HomeDistrict          HomeDistrict   `gorm:"size:100;column:home_district"`    // Native place, a location at any level
+	CurrentLocation       string         `gorm:"size:100;column:current_location"` // Where the user lives now
 	ProfilePictureURL     *string        `gorm:"size:255;column:profile_picture_url"`
+	Lifestyle             `gorm:"embedded"`
 	LastLogin             time.Time      `gorm:"not null;default:now();column:last_login"`
 	CreatedAt             time.Time      `gorm:"not null;default:now();column:created_at"`
 	UpdatedAt             time.Time      `gorm:"not null;default:now();column:updated_at"`
+	IsSuspended           bool           `gorm:"not null;default:false;column:is_suspended"`   // Hidden from matching while the account is suspended or banned
+	SuspendedUntil        *time.Time     `gorm:"column:suspended_until"`                       // Nil for bans and open-ended suspensions
+	IsDeactivated         bool           `gorm:"not null;default:false;column:is_deactivated"` // Hidden by the user until they log in again
 	IsDeleted             bool           `gorm:"not null;default:false;column:is_deleted"`
 	DeletedAt             gorm.DeletedAt `gorm:"index;column:deleted_at"`
 }
@@ -62,12 +77,17 @@ type PartnerPreferences struct {
 
 	AcceptPhysicallyChallenged bool `gorm:"not null;default:true;column:accept_physically_challenged"`
 
-	PreferredCommunities     []Community      `gorm:"type:community_enum[];column:preferred_communities"`
+	PreferredCommunities     []Community      `gorm:"type:varchar(100)[];column:preferred_communities"`
 	PreferredMaritalStatus   []MaritalStatus  `gorm:"type:marital_status_enum[];column:preferred_marital_status"`
-	PreferredProfessions     []Profession     `gorm:"type:profession_enum[];column:preferred_professions"`
+	PreferredProfessions     []Profession     `gorm:"type:varchar(100)[];column:preferred_professions"`
 	PreferredProfessionTypes []ProfessionType `gorm:"type:profession_type_enum[];column:preferred_profession_types"`
-	PreferredEducationLevels []EducationLevel `gorm:"type:education_level_enum[];column:preferred_education_levels"`
-	PreferredHomeDistricts   []HomeDistrict   `gorm:"type:home_district_enum[];column:preferred_home_districts"`
+	PreferredEducationLevels []EducationLevel `gorm:"type:varchar(100)[];column:preferred_education_levels"`
+	PreferredHomeDistricts   []HomeDistrict   `gorm:"type:varchar(100)[];column:preferred_home_districts"`
+	// Preferred areas of current residence; like the home districts, an area
+	// matches every location below it, e.g. "united_arab_emirates"
+	PreferredCurrentLocations pq.StringArray `gorm:"type:varchar(100)[];column:preferred_current_locations"`
+
+	LifestylePreferences `gorm:"embedded"`
 
 	CreatedAt time.Time      `gorm:"not null;default:now();column:created_at"`
 	UpdatedAt time.Time      `gorm:"not null;default:now();column:updated_at"`
@@ -90,16 +110,18 @@ type PartnerPreferencesWithArrays struct {
 	PreferredProfessionTypes      []ProfessionType `gorm:"-"`
 	PreferredEducationLevels      []EducationLevel `gorm:"-"`
 	PreferredHomeDistricts        []HomeDistrict   `gorm:"-"`
-	PreferredCommunitiesArray     pq.StringArray   `gorm:"type:community_enum[];column:preferred_communities"`
+	PreferredCommunitiesArray     pq.StringArray   `gorm:"type:varchar(100)[];column:preferred_communities"`
 	PreferredMaritalStatusArray   pq.StringArray   `gorm:"type:marital_status_enum[];column:preferred_marital_status"`
-	PreferredProfessionsArray     pq.StringArray   `gorm:"type:profession_enum[];column:preferred_professions"`
+	PreferredProfessionsArray     pq.StringArray   `gorm:"type:varchar(100)[];column:preferred_professions"`
 	PreferredProfessionTypesArray pq.StringArray   `gorm:"type:profession_type_enum[];column:preferred_profession_types"`
-	PreferredEducationLevelsArray pq.StringArray   `gorm:"type:education_level_enum[];column:preferred_education_levels"`
-	PreferredHomeDistrictsArray   pq.StringArray   `gorm:"type:home_district_enum[];column:preferred_home_districts"`
-	CreatedAt                     time.Time        `gorm:"not null;default:now();column:created_at"`
-	UpdatedAt                     time.Time        `gorm:"not null;default:now();column:updated_at"`
-	IsDeleted                     bool             `gorm:"not null;default:false;column:is_deleted"`
-	DeletedAt                     gorm.DeletedAt   `gorm:"index;column:deleted_at"`
+	PreferredEducationLevelsArray pq.StringArray   `gorm:"type:varchar(100)[];column:preferred_education_levels"`
+	PreferredHomeDistrictsArray   pq.StringArray   `gorm:"type:varchar(100)[];column:preferred_home_districts"`
+	PreferredCurrentLocations     pq.StringArray   `gorm:"type:varchar(100)[];column:preferred_current_locations"`
+	LifestylePreferences          `gorm:"embedded"`
+	CreatedAt                     time.Time      `gorm:"not null;default:now();column:created_at"`
+	UpdatedAt                     time.Time      `gorm:"not null;default:now();column:updated_at"`
+	IsDeleted                     bool           `gorm:"not null;default:false;column:is_deleted"`
+	DeletedAt                     gorm.DeletedAt `gorm:"index;column:deleted_at"`
 }
 
 func (PartnerPreferencesWithArrays) TableName() string {
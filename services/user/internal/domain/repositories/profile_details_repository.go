@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
+)
+
+type ProfileDetailsRepository interface {
+	GetProfileDetails(ctx context.Context, userProfileID uint) (*models.ProfileDetails, error)
+	UpsertProfileDetails(ctx context.Context, userProfileID uint, updates map[string]interface{}) error
+	DeleteProfileDetails(ctx context.Context, userProfileID uint) error
+}
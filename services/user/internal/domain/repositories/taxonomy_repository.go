@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
+)
+
+type TaxonomyRepository interface {
+	// ListOptions returns every option of every category, ordered for display
+	ListOptions(ctx context.Context) ([]*models.TaxonomyOption, error)
+	GetOptionByID(ctx context.Context, id uint) (*models.TaxonomyOption, error)
+	GetOptionByValue(ctx context.Context, category models.TaxonomyCategory, value string) (*models.TaxonomyOption, error)
+	CreateOption(ctx context.Context, option *models.TaxonomyOption) error
+	UpdateOption(ctx context.Context, option *models.TaxonomyOption) error
+	// ListLocationAdjacencies returns every pair of bordering locations
+	ListLocationAdjacencies(ctx context.Context) ([]*models.LocationAdjacency, error)
+	// AddLocationAdjacencies records that a location borders each of the others; known pairs are skipped
+	AddLocationAdjacencies(ctx context.Context, value string, adjacent []string) error
+	// RemoveLocationAdjacencies removes the pairs of a location and each of the others, in either order
+	RemoveLocationAdjacencies(ctx context.Context, value string, adjacent []string) error
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
@@ -20,8 +21,10 @@ type MatchmakingService struct {
 	profileRepo            repositories.ProfileRepository
 	partnerPreferencesRepo repositories.PartnerPreferencesRepository
 	notificationService    *NotificationService
+	taxonomyService        *TaxonomyService
 	logger                 logging.Logger
 	matchWeights           config.MatchWeights
+	locationScoring        config.LocationScoring
 }
 
 func NewMatchmakingService(
@@ -29,6 +32,7 @@ func NewMatchmakingService(
 	profileRepo repositories.ProfileRepository,
 	partnerPreferencesRepo repositories.PartnerPreferencesRepository,
 	notificationService *NotificationService,
+	taxonomyService *TaxonomyService,
 	logger logging.Logger,
 	config *config.Config,
 ) *MatchmakingService {
@@ -37,8 +41,10 @@ func NewMatchmakingService(
 		profileRepo:            profileRepo,
 		partnerPreferencesRepo: partnerPreferencesRepo,
 		notificationService:    notificationService,
+		taxonomyService:        taxonomyService,
 		logger:                 logger,
 		matchWeights:           config.Matchmaking.Weights,
+		locationScoring:        config.Matchmaking.Location,
 	}
 }
 
@@ -85,7 +91,7 @@ func (s *MatchmakingService) GetRecommendedMatches(ctx context.Context, userID s
 	}
 
 	// Score and sort profiles using scoring logic
-	scoredProfiles := s.scoreAndSortProfiles(potentialProfiles, preferences)
+	scoredProfiles := s.scoreAndSortProfiles(ctx, userProfile, potentialProfiles, preferences)
 
 	totalCount := len(scoredProfiles)
 	end := offset + limit // end index of the profiles to be returned
@@ -117,7 +123,7 @@ func (s *MatchmakingService) GetRecommendedMatches(ctx context.Context, userID s
 			age = s.calculateAge(*profile.DateOfBirth)
 		}
 
-		matchReasons := s.determineMatchReasons(profile, preferences)
+		matchReasons := s.determineMatchReasons(ctx, userProfile, profile, preferences)
 		recommendedProfiles[i] = &models.RecommendedProfile{
 			ID:                    profile.ID,
 			UserID:                profile.UserID,
@@ -131,6 +137,7 @@ func (s *MatchmakingService) GetRecommendedMatches(ctx context.Context, userID s
 			ProfessionType:        profile.ProfessionType,
 			HighestEducationLevel: profile.HighestEducationLevel,
 			HomeDistrict:          profile.HomeDistrict,
+			CurrentLocation:       profile.CurrentLocation,
 			ProfilePictureURL:     profile.ProfilePictureURL,
 			LastLogin:             profile.LastLogin,
 			MatchReasons:          matchReasons,
@@ -217,6 +224,8 @@ func (s *MatchmakingService) RecordMatchAction(ctx context.Context, userID strin
 }
 
 func (s *MatchmakingService) scoreAndSortProfiles(
+	ctx context.Context,
+	userProfile *models.UserProfile,
 	profiles []*models.UserProfile,
 	preferences *models.PartnerPreferences) []*models.UserProfile {
 
@@ -229,7 +238,7 @@ func (s *MatchmakingService) scoreAndSortProfiles(
 	scoredProfiles := make([]scoredProfile, 0, len(profiles))
 
 	for _, profile := range profiles {
-		score := s.calculateMatchScore(profile, preferences)
+		score := s.calculateMatchScore(ctx, userProfile, profile, preferences)
 		scoredProfiles = append(scoredProfiles, scoredProfile{
 			profile: profile,
 			score:   score,
@@ -254,7 +263,7 @@ func (s *MatchmakingService) scoreAndSortProfiles(
 	return result
 }
 
-func (s *MatchmakingService) calculateMatchScore(profile *models.UserProfile, prefs *models.PartnerPreferences) float64 {
+func (s *MatchmakingService) calculateMatchScore(ctx context.Context, userProfile, profile *models.UserProfile, prefs *models.PartnerPreferences) float64 {
 	// if no preferences, use recency score (last login)
 	if prefs == nil {
 		return s.calculateRecencyScore(profile.LastLogin)
@@ -297,24 +306,152 @@ func (s *MatchmakingService) calculateMatchScore(profile *models.UserProfile, pr
 		score += 0.5 * weightProfession
 	}
 
-	if len(prefs.PreferredHomeDistricts) > 0 {
-		locationScore := 0.5
-		for _, d := range prefs.PreferredHomeDistricts {
-			if profile.HomeDistrict == d {
-				locationScore = 1.0
-				break
-			}
-		}
-		score += locationScore * weightLocation
-	} else {
-		score += 0.5 * weightLocation
+	homeDistricts := make([]string, len(prefs.PreferredHomeDistricts))
+	for i, d := range prefs.PreferredHomeDistricts {
+		homeDistricts[i] = string(d)
 	}
+	score += s.locationScore(ctx, string(profile.HomeDistrict), homeDistricts) * weightLocation
+	score += s.locationScore(ctx, profile.CurrentLocation, prefs.PreferredCurrentLocations) * s.matchWeights.CurrentLocation
 
 	score += s.calculateRecencyScore(profile.LastLogin) * weightRecency
 
+	for _, attr := range s.lifestyleAttributes(profile, prefs) {
+		score += lifestyleScore(attr.value, attr.preferred) * attr.weight
+	}
+
+	return score
+}
+
+// locationScore rates a location against the given areas. A location inside
+// any of them, at whatever level, gets a full score of 1.0. Elsewhere the
+// score halves every HalfScoreDistanceKM from the nearest area, and a
+// location bordering one scores at least AdjacentScore, so Malappuram ranks
+// well above Thiruvananthapuram for someone preferring Kozhikode. A location
+// that cannot be placed scores MinScore, no higher than the farthest graded
+// one. Without areas it scores a neutral 0.5.
+func (s *MatchmakingService) locationScore(ctx context.Context, location string, areas []string) float64 {
+	if len(areas) == 0 {
+		return 0.5
+	}
+	if !isKnownLocation(location) {
+		return s.locationScoring.MinScore
+	}
+	if s.withinPreferredLocation(ctx, location, areas) {
+		return 1.0
+	}
+
+	score, graded := 0.0, false
+	for _, area := range areas {
+		if s.taxonomyService.AreAdjacentLocations(ctx, location, area) {
+			score = math.Max(score, s.locationScoring.AdjacentScore)
+			graded = true
+		}
+		if distance, ok := s.taxonomyService.LocationDistanceKM(ctx, location, area); ok {
+			score = math.Max(score, s.distanceDecay(distance))
+			graded = true
+		}
+	}
+	if !graded {
+		return s.locationScoring.MinScore
+	}
 	return score
 }
 
+// distanceDecay is the share of a full location score left at the given
+// distance. It never drops below MinScore.
+func (s *MatchmakingService) distanceDecay(distanceKM float64) float64 {
+	if s.locationScoring.HalfScoreDistanceKM <= 0 {
+		return s.locationScoring.MinScore
+	}
+	return math.Max(s.locationScoring.MinScore, math.Pow(0.5, distanceKM/s.locationScoring.HalfScoreDistanceKM))
+}
+
+// distanceReason describes how far apart two users live, e.g. "Lives 40 km
+// away", or returns "" when that is unknown or beyond NearbyDistanceKM
+func (s *MatchmakingService) distanceReason(ctx context.Context, userProfile, matchProfile *models.UserProfile) string {
+	from, to := residence(userProfile), residence(matchProfile)
+	if !isKnownLocation(from) || !isKnownLocation(to) {
+		return ""
+	}
+
+	distance, ok := s.taxonomyService.LocationDistanceKM(ctx, from, to)
+	if !ok || distance > s.locationScoring.NearbyDistanceKM {
+		return ""
+	}
+
+	// District centroids are approximate, so round to the nearest 5 km
+	rounded := int(math.Round(distance/5) * 5)
+	if rounded == 0 {
+		return "Lives nearby"
+	}
+	return fmt.Sprintf("Lives %d km away", rounded)
+}
+
+// residence is where a user lives now, falling back to their native place
+func residence(profile *models.UserProfile) string {
+	if isKnownLocation(profile.CurrentLocation) {
+		return profile.CurrentLocation
+	}
+	return string(profile.HomeDistrict)
+}
+
+func isKnownLocation(location string) bool {
+	return location != "" && location != constants.DefaultNotMentioned
+}
+
+func (s *MatchmakingService) withinPreferredLocation(ctx context.Context, location string, preferred []string) bool {
+	for _, area := range preferred {
+		if s.taxonomyService.IsWithinLocation(ctx, location, area) {
+			return true
+		}
+	}
+	return false
+}
+
+// lifestyleAttribute pairs a lifestyle attribute of a profile with the values
+// the user prefers for it
+type lifestyleAttribute struct {
+	value     string
+	preferred []string
+	weight    float64
+	reason    string
+}
+
+func (s *MatchmakingService) lifestyleAttributes(profile *models.UserProfile, prefs *models.PartnerPreferences) []lifestyleAttribute {
+	return []lifestyleAttribute{
+		{string(profile.PrayerFrequency), prefs.PreferredPrayerFrequencies, s.matchWeights.Prayer, "Prayer practice match"},
+		{string(profile.Hijab), prefs.PreferredHijab, s.matchWeights.Hijab, "Hijab preference match"},
+		{string(profile.Beard), prefs.PreferredBeard, s.matchWeights.Beard, "Beard preference match"},
+		{string(profile.MadrasaEducation), prefs.PreferredMadrasaEducation, s.matchWeights.MadrasaEducation, "Madrasa education match"},
+		{string(profile.Diet), prefs.PreferredDiets, s.matchWeights.Diet, "Diet match"},
+		{string(profile.Smoking), prefs.PreferredSmoking, s.matchWeights.Smoking, "Smoking habits match"},
+		{string(profile.WillingToRelocate), prefs.PreferredRelocation, s.matchWeights.Relocation, "Relocation preference match"},
+	}
+}
+
+// lifestyleScore rates a lifestyle attribute against the preferred values.
+// Unlike the community and profession scores a mismatch scores 0, since
+// lifestyle preferences such as not smoking are usually firm. Attributes the
+// user has no preference for, or the profile has not filled in, score 0.5.
+func lifestyleScore(value string, preferred []string) float64 {
+	if len(preferred) == 0 || value == "" || value == constants.DefaultNotMentioned {
+		return 0.5
+	}
+	if isPreferredLifestyle(value, preferred) {
+		return 1.0
+	}
+	return 0
+}
+
+func isPreferredLifestyle(value string, preferred []string) bool {
+	for _, p := range preferred {
+		if value == p {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *MatchmakingService) calculateRecencyScore(lastLogin time.Time) float64 {
 	hoursAgo := time.Since(lastLogin).Hours()
 	if hoursAgo < 24 {
@@ -339,11 +476,16 @@ func (s *MatchmakingService) calculateAge(dateOfBirth time.Time) int {
 	return age
 }
 
-func (s *MatchmakingService) determineMatchReasons(matchProfile *models.UserProfile, prefs *models.PartnerPreferences) []string {
+func (s *MatchmakingService) determineMatchReasons(ctx context.Context, userProfile, matchProfile *models.UserProfile, prefs *models.PartnerPreferences) []string {
 	var reasons []string
 
+	distanceReason := s.distanceReason(ctx, userProfile, matchProfile)
+
 	if prefs == nil {
 		reasons = append(reasons, "Compatible profile")
+		if distanceReason != "" {
+			reasons = append(reasons, distanceReason)
+		}
 		return reasons
 	}
 
@@ -365,12 +507,24 @@ func (s *MatchmakingService) determineMatchReasons(matchProfile *models.UserProf
 		}
 	}
 
-	if len(prefs.PreferredHomeDistricts) > 0 {
-		for _, d := range prefs.PreferredHomeDistricts {
-			if matchProfile.HomeDistrict == d {
-				reasons = append(reasons, "Location match")
-				break
-			}
+	for _, d := range prefs.PreferredHomeDistricts {
+		if s.taxonomyService.IsWithinLocation(ctx, string(matchProfile.HomeDistrict), string(d)) {
+			reasons = append(reasons, "Location match")
+			break
+		}
+	}
+
+	if s.withinPreferredLocation(ctx, matchProfile.CurrentLocation, prefs.PreferredCurrentLocations) {
+		reasons = append(reasons, "Lives in preferred area")
+	}
+
+	if distanceReason != "" {
+		reasons = append(reasons, distanceReason)
+	}
+
+	for _, attr := range s.lifestyleAttributes(matchProfile, prefs) {
+		if attr.weight > 0 && len(attr.preferred) > 0 && isPreferredLifestyle(attr.value, attr.preferred) {
+			reasons = append(reasons, attr.reason)
 		}
 	}
 
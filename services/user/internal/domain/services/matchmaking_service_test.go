@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/config"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/repositories"
+)
+
+type fakeMatchRepo struct {
+	repositories.MatchRepository
+	candidates []*models.UserProfile
+}
+
+func (r *fakeMatchRepo) GetMatchedProfileIDs(context.Context, uuid.UUID) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+func (r *fakeMatchRepo) GetPotentialProfiles(context.Context, uuid.UUID, []uuid.UUID, *models.PartnerPreferences) ([]*models.UserProfile, error) {
+	return r.candidates, nil
+}
+
+type fakeProfileRepo struct {
+	repositories.ProfileRepository
+	profile *models.UserProfile
+}
+
+func (r *fakeProfileRepo) GetProfileByUserID(context.Context, uuid.UUID) (*models.UserProfile, error) {
+	return r.profile, nil
+}
+
+type fakePartnerPreferencesRepo struct {
+	repositories.PartnerPreferencesRepository
+	preferences *models.PartnerPreferences
+}
+
+func (r *fakePartnerPreferencesRepo) GetPartnerPreferences(context.Context, uint) (*models.PartnerPreferences, error) {
+	return r.preferences, nil
+}
+
+// testLocationScoring matches the configured defaults
+var testLocationScoring = config.LocationScoring{
+	HalfScoreDistanceKM: 40,
+	AdjacentScore:       0.75,
+	MinScore:            0.1,
+	NearbyDistanceKM:    100,
+}
+
+func newTestMatchmakingService(taxonomyService *TaxonomyService, matchRepo repositories.MatchRepository,
+	profileRepo repositories.ProfileRepository, preferencesRepo repositories.PartnerPreferencesRepository) *MatchmakingService {
+	cfg := &config.Config{
+		Matchmaking: config.MatchmakingConfig{
+			Weights: config.MatchWeights{
+				Location:        3.0,
+				CurrentLocation: 1.0,
+				Recency:         1.0,
+			},
+			Location: testLocationScoring,
+		},
+	}
+	return NewMatchmakingService(matchRepo, profileRepo, preferencesRepo, nil, taxonomyService, logging.Default(), cfg)
+}
+
+// Preferred home districts are no longer a filter, so a profile from a
+// district bordering the preferred one is recommended, and ranks above a
+// more recently active one from the far end of the state
+func TestGetRecommendedMatchesRanksAdjacentDistrictAboveDistant(t *testing.T) {
+	user := &models.UserProfile{ID: 1, UserID: uuid.New(), HomeDistrict: "kozhikode"}
+	distant := &models.UserProfile{
+		ID: 2, UserID: uuid.New(), HomeDistrict: "thiruvananthapuram",
+		LastLogin: time.Now().Add(-time.Hour),
+	}
+	adjacent := &models.UserProfile{
+		ID: 3, UserID: uuid.New(), HomeDistrict: "malappuram",
+		LastLogin: time.Now().Add(-10 * 24 * time.Hour),
+	}
+
+	service := newTestMatchmakingService(
+		newTestTaxonomyService(),
+		&fakeMatchRepo{candidates: []*models.UserProfile{distant, adjacent}},
+		&fakeProfileRepo{profile: user},
+		&fakePartnerPreferencesRepo{preferences: &models.PartnerPreferences{
+			PreferredHomeDistricts: []models.HomeDistrict{"kozhikode"},
+		}},
+	)
+
+	matches, _, err := service.GetRecommendedMatches(context.Background(), user.UserID.String(), 10, 0)
+	if err != nil {
+		t.Fatalf("GetRecommendedMatches: %v", err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("got %d matches, want 2", len(matches))
+	}
+	if matches[0].ID != adjacent.ID || matches[1].ID != distant.ID {
+		t.Errorf("got order %d, %d, want the Malappuram profile %d first", matches[0].ID, matches[1].ID, adjacent.ID)
+	}
+}
+
+func TestDistanceDecay(t *testing.T) {
+	service := &MatchmakingService{locationScoring: testLocationScoring}
+
+	tests := []struct {
+		name       string
+		distanceKM float64
+		want       float64
+	}{
+		{"same place", 0, 1},
+		{"half score distance", 40, 0.5},
+		{"twice the half score distance", 80, 0.25},
+		{"just above the floor", 120, 0.125},
+		{"floored", 200, 0.1},
+		{"far away", 2000, 0.1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := service.distanceDecay(tt.distanceKM); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("distanceDecay(%v) = %v, want %v", tt.distanceKM, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("no half score distance", func(t *testing.T) {
+		scoring := testLocationScoring
+		scoring.HalfScoreDistanceKM = 0
+		service := &MatchmakingService{locationScoring: scoring}
+		if got := service.distanceDecay(0); got != scoring.MinScore {
+			t.Errorf("distanceDecay(0) = %v, want MinScore %v", got, scoring.MinScore)
+		}
+	})
+}
+
+func TestDistanceReason(t *testing.T) {
+	// Places due north of "origin", at the given distance in kilometres
+	kmPerDegree := earthRadiusKM * math.Pi / 180
+	var places []*models.TaxonomyOption
+	places = append(places, testLocation("origin", constants.LocationDistrict, "", 0, 0))
+	for _, km := range []float64{2, 3, 42, 43, 99, 101} {
+		places = append(places, testLocation(fmt.Sprintf("km_%v", km), constants.LocationDistrict, "", km/kmPerDegree, 0))
+	}
+	service := newTestMatchmakingService(newTestTaxonomyService(places...), nil, nil, nil)
+	ctx := context.Background()
+
+	user := &models.UserProfile{CurrentLocation: "origin"}
+	tests := []struct {
+		name    string
+		profile *models.UserProfile
+		want    string
+	}{
+		{"rounds down to nearby", &models.UserProfile{CurrentLocation: "km_2"}, "Lives nearby"},
+		{"rounds up to 5 km", &models.UserProfile{CurrentLocation: "km_3"}, "Lives 5 km away"},
+		{"rounds down to 40 km", &models.UserProfile{CurrentLocation: "km_42"}, "Lives 40 km away"},
+		{"rounds up to 45 km", &models.UserProfile{CurrentLocation: "km_43"}, "Lives 45 km away"},
+		{"within the nearby distance", &models.UserProfile{CurrentLocation: "km_99"}, "Lives 100 km away"},
+		{"beyond the nearby distance", &models.UserProfile{CurrentLocation: "km_101"}, ""},
+		{"falls back to the home district", &models.UserProfile{CurrentLocation: constants.DefaultNotMentioned, HomeDistrict: "km_42"}, "Lives 40 km away"},
+		{"unknown location", &models.UserProfile{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := service.distanceReason(ctx, user, tt.profile); got != tt.want {
+				t.Errorf("distanceReason = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -17,23 +17,27 @@ import (
 type PartnerPreferencesService struct {
 	profileRepo            repositories.ProfileRepository
 	partnerPreferencesRepo repositories.PartnerPreferencesRepository
+	taxonomyService        *TaxonomyService
 	logger                 logging.Logger
 }
 
 func NewPartnerPreferencesService(
 	profileRepo repositories.ProfileRepository,
 	partnerPreferencesRepo repositories.PartnerPreferencesRepository,
+	taxonomyService *TaxonomyService,
 	logger logging.Logger,
 ) *PartnerPreferencesService {
 	return &PartnerPreferencesService{
 		profileRepo:            profileRepo,
 		partnerPreferencesRepo: partnerPreferencesRepo,
+		taxonomyService:        taxonomyService,
 		logger:                 logger,
 	}
 }
 
 // ValidatePartnerPreferences validates partner preferences data
 func (s *PartnerPreferencesService) ValidatePartnerPreferences(
+	ctx context.Context,
 	minAgeYears *int,
 	maxAgeYears *int,
 	minHeightCM *int,
@@ -44,6 +48,7 @@ func (s *PartnerPreferencesService) ValidatePartnerPreferences(
 	preferredProfessionTypes []string,
 	preferredEducationLevels []string,
 	preferredHomeDistricts []string,
+	preferredCurrentLocations []string,
 ) error {
 	// Validate age range
 	if minAgeYears != nil && maxAgeYears != nil {
@@ -69,7 +74,7 @@ func (s *PartnerPreferencesService) ValidatePartnerPreferences(
 
 	// Validate preferred communities
 	for _, community := range preferredCommunities {
-		if err := validation.ValidateCommunity(community); err != nil {
+		if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyCommunity, community); err != nil {
 			return fmt.Errorf("invalid preferred community '%s': %w", community, err)
 		}
 	}
@@ -83,7 +88,7 @@ func (s *PartnerPreferencesService) ValidatePartnerPreferences(
 
 	// Validate preferred professions
 	for _, profession := range preferredProfessions {
-		if err := validation.ValidateProfession(profession); err != nil {
+		if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyProfession, profession); err != nil {
 			return fmt.Errorf("invalid preferred profession '%s': %w", profession, err)
 		}
 	}
@@ -97,18 +102,52 @@ func (s *PartnerPreferencesService) ValidatePartnerPreferences(
 
 	// Validate preferred education levels
 	for _, level := range preferredEducationLevels {
-		if err := validation.ValidateEducationLevel(level); err != nil {
+		if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyEducationLevel, level); err != nil {
 			return fmt.Errorf("invalid preferred education level '%s': %w", level, err)
 		}
 	}
 
 	// Validate preferred home districts
 	for _, district := range preferredHomeDistricts {
-		if err := validation.ValidateHomeDistrict(district); err != nil {
+		if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyLocation, district); err != nil {
 			return fmt.Errorf("invalid preferred home district '%s': %w", district, err)
 		}
 	}
 
+	// Validate preferred current locations
+	for _, location := range preferredCurrentLocations {
+		if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyLocation, location); err != nil {
+			return fmt.Errorf("invalid preferred current location '%s': %w", location, err)
+		}
+	}
+
+	return nil
+}
+
+// validateLifestylePreferences validates the preferred lifestyle values
+func validateLifestylePreferences(prefs models.LifestylePreferences) error {
+	lists := []struct {
+		name     string
+		values   []string
+		validate func(string) error
+	}{
+		{"prayer frequency", prefs.PreferredPrayerFrequencies, validation.ValidatePrayerFrequency},
+		{"hijab", prefs.PreferredHijab, validation.ValidateHijab},
+		{"beard", prefs.PreferredBeard, validation.ValidateBeard},
+		{"madrasa education", prefs.PreferredMadrasaEducation, validation.ValidateMadrasaEducation},
+		{"diet", prefs.PreferredDiets, validation.ValidateDiet},
+		{"smoking", prefs.PreferredSmoking, validation.ValidateSmoking},
+		{"relocation", prefs.PreferredRelocation, validation.ValidateRelocation},
+	}
+
+	for _, list := range lists {
+		for _, value := range list.values {
+			if err := list.validate(value); err != nil {
+				return fmt.Errorf("invalid preferred %s '%s': %w", list.name, value, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -150,6 +189,8 @@ func (s *PartnerPreferencesService) UpdatePartnerPreferences(
 	preferredProfessionTypes []string,
 	preferredEducationLevels []string,
 	preferredHomeDistricts []string,
+	preferredCurrentLocations []string,
+	lifestyle models.LifestylePreferences,
 ) error {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
@@ -167,6 +208,7 @@ func (s *PartnerPreferencesService) UpdatePartnerPreferences(
 
 	// Validate partner preferences
 	err = s.ValidatePartnerPreferences(
+		ctx,
 		minAgeYears,
 		maxAgeYears,
 		minHeightCM,
@@ -177,11 +219,16 @@ func (s *PartnerPreferencesService) UpdatePartnerPreferences(
 		preferredProfessionTypes,
 		preferredEducationLevels,
 		preferredHomeDistricts,
+		preferredCurrentLocations,
 	)
 	if err != nil {
 		return fmt.Errorf("%w: %v", errors.ErrValidation, err)
 	}
 
+	if err := validateLifestylePreferences(lifestyle); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrValidation, err)
+	}
+
 	// Convert string arrays to typed arrays
 	typedCommunities := make([]models.Community, 0, len(preferredCommunities))
 	typedMaritalStatus := make([]models.MaritalStatus, 0, len(preferredMaritalStatus))
@@ -224,6 +271,8 @@ func (s *PartnerPreferencesService) UpdatePartnerPreferences(
 		PreferredProfessionTypes:   typedProfessionTypes,
 		PreferredEducationLevels:   typedEducationLevels,
 		PreferredHomeDistricts:     typedHomeDistricts,
+		PreferredCurrentLocations:  preferredCurrentLocations,
+		LifestylePreferences:       lifestyle,
 		UpdatedAt:                  now,
 	}
 
@@ -251,12 +300,15 @@ func (s *PartnerPreferencesService) PatchPartnerPreferences(
 	preferredProfessionTypes []string,
 	preferredEducationLevels []string,
 	preferredHomeDistricts []string,
+	preferredCurrentLocations []string,
 	clearPreferredCommunities bool,
 	clearPreferredMaritalStatus bool,
 	clearPreferredProfessions bool,
 	clearPreferredProfessionTypes bool,
 	clearPreferredEducationLevels bool,
 	clearPreferredHomeDistricts bool,
+	clearPreferredCurrentLocations bool,
+	lifestyle *models.LifestylePreferences,
 ) error {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
@@ -299,6 +351,8 @@ func (s *PartnerPreferencesService) PatchPartnerPreferences(
 		newPrefs.PreferredProfessionTypes = currentPrefs.PreferredProfessionTypes
 		newPrefs.PreferredEducationLevels = currentPrefs.PreferredEducationLevels
 		newPrefs.PreferredHomeDistricts = currentPrefs.PreferredHomeDistricts
+		newPrefs.PreferredCurrentLocations = currentPrefs.PreferredCurrentLocations
+		newPrefs.LifestylePreferences = currentPrefs.LifestylePreferences
 	} else {
 		// Set defaults for new preferences
 		newPrefs.AcceptPhysicallyChallenged = true
@@ -383,6 +437,17 @@ func (s *PartnerPreferencesService) PatchPartnerPreferences(
 		newPrefs.PreferredHomeDistricts = typedHomeDistricts
 	}
 
+	if clearPreferredCurrentLocations {
+		newPrefs.PreferredCurrentLocations = []string{}
+	} else if len(preferredCurrentLocations) > 0 {
+		newPrefs.PreferredCurrentLocations = preferredCurrentLocations
+	}
+
+	// Lifestyle preferences are replaced as a whole when provided
+	if lifestyle != nil {
+		newPrefs.LifestylePreferences = *lifestyle
+	}
+
 	// Validate the updated preferences
 	communities := make([]string, len(newPrefs.PreferredCommunities))
 	for i, c := range newPrefs.PreferredCommunities {
@@ -415,6 +480,7 @@ func (s *PartnerPreferencesService) PatchPartnerPreferences(
 	}
 
 	if err := s.ValidatePartnerPreferences(
+		ctx,
 		newPrefs.MinAgeYears,
 		newPrefs.MaxAgeYears,
 		newPrefs.MinHeightCM,
@@ -425,10 +491,15 @@ func (s *PartnerPreferencesService) PatchPartnerPreferences(
 		professionTypes,
 		educationLevels,
 		homeDistricts,
+		newPrefs.PreferredCurrentLocations,
 	); err != nil {
 		return fmt.Errorf("%w: %v", errors.ErrValidation, err)
 	}
 
+	if err := validateLifestylePreferences(newPrefs.LifestylePreferences); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrValidation, err)
+	}
+
 	// Update preferences
 	if err := s.partnerPreferencesRepo.UpdatePartnerPreferences(ctx, newPrefs); err != nil {
 		s.logger.Error("Failed to patch partner preferences", "userID", userID, "error", err)
@@ -247,6 +247,27 @@ func (s *PhotoService) DeleteUserPhoto(ctx context.Context, userID string, displ
 	return nil
 }
 
+// DeleteAllUserPhotos removes the profile photo and every additional photo of a
+// deleted user from storage and the database
+func (s *PhotoService) DeleteAllUserPhotos(ctx context.Context, userID string) error {
+	if err := s.DeleteProfilePhoto(ctx, userID); err != nil && err != errors.ErrProfileNotFound {
+		return err
+	}
+
+	photos, err := s.GetUserPhotos(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, photo := range photos {
+		if err := s.DeleteUserPhoto(ctx, userID, photo.DisplayOrder); err != nil {
+			return err
+		}
+	}
+
+	s.logger.Info("All user photos deleted", "userID", userID, "additionalPhotos", len(photos))
+	return nil
+}
+
 // CountUserPhotos returns the number of photos a user has
 func (s *PhotoService) CountUserPhotos(ctx context.Context, userID string) (int, error) {
 	userUUID, err := uuid.Parse(userID)
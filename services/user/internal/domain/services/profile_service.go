@@ -3,9 +3,11 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
@@ -29,19 +31,52 @@ type DetailedProfileData struct {
 	ProfessionType        models.ProfessionType
 	HighestEducationLevel models.EducationLevel
 	HomeDistrict          models.HomeDistrict
+	CurrentLocation       string
 	ProfilePictureURL     *string
 	LastLogin             time.Time
 	PartnerPreferences    *models.PartnerPreferences
 	AdditionalPhotos      []*models.UserPhoto
 	IntroVideo            *models.UserVideo
+	Details               *models.ProfileDetails
+	Lifestyle             models.Lifestyle
 	Age                   int
 }
 
+// LifestylePatch carries the lifestyle attributes of a PatchProfile request.
+// Nil fields are left unchanged.
+type LifestylePatch struct {
+	PrayerFrequency   *string
+	Hijab             *string
+	Beard             *string
+	MadrasaEducation  *string
+	Diet              *string
+	Smoking           *string
+	WillingToRelocate *string
+}
+
+// ProfileDetailsPatch carries the extended profile fields of a PatchProfile
+// request. Nil fields are left unchanged; an empty Languages slice clears the list.
+type ProfileDetailsPatch struct {
+	AboutMe          *string
+	AnnualIncome     *string
+	Languages        *[]string
+	CurrentCity      *string
+	CurrentCountry   *string
+	ResidencyStatus  *string
+	FatherOccupation *string
+	MotherOccupation *string
+	BrothersCount    *int
+	SistersCount     *int
+	FamilyType       *string
+}
+
 type ProfileService struct {
 	profileRepo            repositories.ProfileRepository
 	partnerPreferencesRepo repositories.PartnerPreferencesRepository
 	photoRepo              repositories.PhotoRepository
 	videoRepo              repositories.VideoRepository
+	profileDetailsRepo     repositories.ProfileDetailsRepository
+	taxonomyService        *TaxonomyService
 	logger                 logging.Logger
 }
 
@@ -50,6 +85,8 @@ func NewProfileService(
 	partnerPreferencesRepo repositories.PartnerPreferencesRepository,
 	photoRepo repositories.PhotoRepository,
 	videoRepo repositories.VideoRepository,
+	profileDetailsRepo repositories.ProfileDetailsRepository,
+	taxonomyService *TaxonomyService,
 	logger logging.Logger,
 ) *ProfileService {
 	return &ProfileService{
@@ -57,6 +94,8 @@ func NewProfileService(
 		partnerPreferencesRepo: partnerPreferencesRepo,
 		photoRepo:              photoRepo,
 		videoRepo:              videoRepo,
+		profileDetailsRepo:     profileDetailsRepo,
+		taxonomyService:        taxonomyService,
 		logger:                 logger,
 	}
 }
@@ -67,6 +106,8 @@ func (s *ProfileService) HandleUserLogin(ctx context.Context, userID string, pho
 		return fmt.Errorf("invalid user ID format: %w", err)
 	}
 
+	phone = s.normalizePhone(userID, phone)
+
 	exists, err := s.profileRepo.ProfileExists(ctx, userUUID)
 	if err != nil {
 		return fmt.Errorf("error checking profile existence: %w", err)
@@ -101,6 +142,8 @@ func (s *ProfileService) HandleUserLogin(ctx context.Context, userID string, pho
 		ProfessionType:        constants.ProfessionTypeNotMentioned,
 		HighestEducationLevel: constants.EducationNotMentioned,
 		HomeDistrict:          constants.DistrictNotMentioned,
+		CurrentLocation:       constants.DefaultNotMentioned,
+		Lifestyle:             models.NotMentionedLifestyle(),
 	}
 
 	if err := s.profileRepo.CreateProfile(ctx, profile); err != nil {
@@ -139,14 +182,130 @@ func (s *ProfileService) HandleUserDeletion(ctx context.Context, userID string)
 		return fmt.Errorf("failed to soft delete partner preferences: %w", err)
 	}
 
+	if err := s.profileDetailsRepo.DeleteProfileDetails(ctx, profile.ID); err != nil {
+		s.logger.Error("Failed to delete profile details", "userID", userID, "profileID", profile.ID, "error", err)
+		return fmt.Errorf("failed to delete profile details: %w", err)
+	}
+
 	s.logger.Info("Successfully soft deleted user data", "userID", userID, "profileID", profile.ID)
 	return nil
 }
 
+// HandleUserSuspension hides the user's profile from match recommendations while
+// the account is suspended. A nil until means the suspension or ban has no end.
+func (s *ProfileService) HandleUserSuspension(ctx context.Context, userID string, until *time.Time) error {
+	return s.setSuspension(ctx, userID, true, until)
+}
+
+// HandleUserReinstatement shows the user's profile in match recommendations again
+func (s *ProfileService) HandleUserReinstatement(ctx context.Context, userID string) error {
+	return s.setSuspension(ctx, userID, false, nil)
+}
+
+// HandleUserDeactivation hides the user's profile from recommendations and
+// mutual match lists until the account is reactivated
+func (s *ProfileService) HandleUserDeactivation(ctx context.Context, userID string) error {
+	return s.setDeactivated(ctx, userID, true)
+}
+
+// HandleUserReactivation shows the user's profile again
+func (s *ProfileService) HandleUserReactivation(ctx context.Context, userID string) error {
+	return s.setDeactivated(ctx, userID, false)
+}
+
+// HandleContactChanged copies the email address and phone number the user
+// changed in the auth service to their profile
+func (s *ProfileService) HandleContactChanged(ctx context.Context, userID, email, phone string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		s.logger.Error("Invalid user ID format", "userID", userID, "error", err)
+		return fmt.Errorf("invalid user ID format: %w", err)
+	}
+
+	err = s.profileRepo.PatchProfile(ctx, userUUID, map[string]interface{}{
+		"email": email,
+		"phone": s.normalizePhone(userID, phone),
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			s.logger.Info("User profile not found for contact update - profile not created yet", "userID", userID)
+			return nil
+		}
+		s.logger.Error("Failed to update profile contact details", "userID", userID, "error", err)
+		return fmt.Errorf("failed to update profile contact details: %w", err)
+	}
+
+	s.logger.Info("Updated profile contact details", "userID", userID)
+	return nil
+}
+
+// normalizePhone stores phone numbers in E.164 form. Numbers that cannot be
+// parsed are kept as received so the profile still has a contact number.
+func (s *ProfileService) normalizePhone(userID, phone string) string {
+	// Users who signed up with an external provider may not have added a phone number yet
+	if phone == "" {
+		return phone
+	}
+	normalized, err := validation.NormalizePhone(phone)
+	if err != nil {
+		s.logger.Warn("Could not normalize phone number", "userID", userID, "error", err)
+		return phone
+	}
+	return normalized
+}
+
+func (s *ProfileService) setDeactivated(ctx context.Context, userID string, deactivated bool) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		s.logger.Error("Invalid user ID format", "userID", userID, "error", err)
+		return fmt.Errorf("invalid user ID format: %w", err)
+	}
+
+	err = s.profileRepo.PatchProfile(ctx, userUUID, map[string]interface{}{
+		"is_deactivated": deactivated,
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			s.logger.Info("User profile not found for deactivation update - profile not created yet", "userID", userID)
+			return nil
+		}
+		s.logger.Error("Failed to update profile deactivation", "userID", userID, "error", err)
+		return fmt.Errorf("failed to update profile deactivation: %w", err)
+	}
+
+	s.logger.Info("Updated profile deactivation", "userID", userID, "deactivated", deactivated)
+	return nil
+}
+
+func (s *ProfileService) setSuspension(ctx context.Context, userID string, suspended bool, until *time.Time) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		s.logger.Error("Invalid user ID format", "userID", userID, "error", err)
+		return fmt.Errorf("invalid user ID format: %w", err)
+	}
+
+	err = s.profileRepo.PatchProfile(ctx, userUUID, map[string]interface{}{
+		"is_suspended":    suspended,
+		"suspended_until": until,
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			s.logger.Info("User profile not found for suspension update - profile not created yet", "userID", userID)
+			return nil
+		}
+		s.logger.Error("Failed to update profile suspension", "userID", userID, "error", err)
+		return fmt.Errorf("failed to update profile suspension: %w", err)
+	}
+
+	s.logger.Info("Updated profile suspension", "userID", userID, "suspended", suspended, "until", until)
+	return nil
+}
+
 func (s *ProfileService) UpdateProfile(ctx context.Context, userID string, isBride bool, fullName string,
 	dateOfBirth string, heightCM *int, physicallyChallenged bool,
 	community string, maritalStatus string, profession string,
-	professionType string, educationLevel string, homeDistrict string) error {
+	professionType string, educationLevel string, homeDistrict string,
+	currentLocation string) error {
 
 	// Validate the userID
 	userUUID, err := uuid.Parse(userID)
@@ -163,9 +322,14 @@ func (s *ProfileService) UpdateProfile(ctx context.Context, userID string, isBri
 		return errors.ErrProfileNotFound
 	}
 
+	// Current residence is optional, unlike the native place
+	if currentLocation == "" {
+		currentLocation = constants.DefaultNotMentioned
+	}
+
 	// Validate all fields
-	if err := s.validateProfileFields(heightCM, community, maritalStatus, profession,
-		professionType, educationLevel, homeDistrict, dateOfBirth); err != nil {
+	if err := s.validateProfileFields(ctx, heightCM, community, maritalStatus, profession,
+		professionType, educationLevel, homeDistrict, currentLocation, dateOfBirth); err != nil {
 		return fmt.Errorf("%w: %v", errors.ErrValidation, err)
 	}
 
@@ -192,6 +356,7 @@ func (s *ProfileService) UpdateProfile(ctx context.Context, userID string, isBri
 	existingProfile.ProfessionType = models.ProfessionType(professionType)
 	existingProfile.HighestEducationLevel = models.EducationLevel(educationLevel)
 	existingProfile.HomeDistrict = models.HomeDistrict(homeDistrict)
+	existingProfile.CurrentLocation = currentLocation
 
 	// Update the updated_at timestamp
 	existingProfile.UpdatedAt = indianstandardtime.Now()
@@ -205,9 +370,9 @@ func (s *ProfileService) UpdateProfile(ctx context.Context, userID string, isBri
 	return nil
 }
 
-func (s *ProfileService) validateProfileFields(heightCM *int, community string, maritalStatus string,
+func (s *ProfileService) validateProfileFields(ctx context.Context, heightCM *int, community string, maritalStatus string,
 	profession string, professionType string, educationLevel string,
-	homeDistrict string, dateOfBirth string) error {
+	homeDistrict string, currentLocation string, dateOfBirth string) error {
 
 	if heightCM != nil {
 		if err := validation.ValidateHeight(heightCM); err != nil {
@@ -215,7 +380,7 @@ func (s *ProfileService) validateProfileFields(heightCM *int, community string,
 		}
 	}
 
-	if err := validation.ValidateCommunity(community); err != nil {
+	if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyCommunity, community); err != nil {
 		return err
 	}
 
@@ -223,7 +388,7 @@ func (s *ProfileService) validateProfileFields(heightCM *int, community string,
 		return err
 	}
 
-	if err := validation.ValidateProfession(profession); err != nil {
+	if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyProfession, profession); err != nil {
 		return err
 	}
 
@@ -231,14 +396,18 @@ func (s *ProfileService) validateProfileFields(heightCM *int, community string,
 		return err
 	}
 
-	if err := validation.ValidateEducationLevel(educationLevel); err != nil {
+	if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyEducationLevel, educationLevel); err != nil {
 		return err
 	}
 
-	if err := validation.ValidateHomeDistrict(homeDistrict); err != nil {
+	if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyLocation, homeDistrict); err != nil {
 		return err
 	}
 
+	if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyLocation, currentLocation); err != nil {
+		return fmt.Errorf("invalid current location value")
+	}
+
 	return nil
 }
 
@@ -246,18 +415,19 @@ func (s *ProfileService) PatchProfile(ctx context.Context, userID string,
 	isBride *bool, fullName *string, dateOfBirth string, heightCM *int,
 	physicallyChallenged *bool, community *string, maritalStatus *string,
 	profession *string, professionType *string, educationLevel *string,
-	homeDistrict *string, clearDateOfBirth bool, clearHeightCM bool) error {
+	homeDistrict *string, currentLocation *string, clearDateOfBirth bool, clearHeightCM bool,
+	details ProfileDetailsPatch, lifestyle LifestylePatch) error {
 
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		return fmt.Errorf("%w: invalid user ID format: %v", errors.ErrInvalidInput, err)
 	}
 
-	exists, err := s.profileRepo.ProfileExists(ctx, userUUID)
+	profile, err := s.profileRepo.GetProfileByUserID(ctx, userUUID)
 	if err != nil {
-		return fmt.Errorf("error checking profile existence: %w", err)
+		return fmt.Errorf("error retrieving profile: %w", err)
 	}
-	if !exists {
+	if profile == nil {
 		return errors.ErrProfileNotFound
 	}
 
@@ -269,7 +439,7 @@ func (s *ProfileService) PatchProfile(ctx context.Context, userID string,
 	}
 
 	if community != nil {
-		if err := validation.ValidateCommunity(*community); err != nil {
+		if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyCommunity, *community); err != nil {
 			return fmt.Errorf("%w: %v", errors.ErrValidation, err)
 		}
 	}
@@ -281,7 +451,7 @@ func (s *ProfileService) PatchProfile(ctx context.Context, userID string,
 	}
 
 	if profession != nil {
-		if err := validation.ValidateProfession(*profession); err != nil {
+		if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyProfession, *profession); err != nil {
 			return fmt.Errorf("%w: %v", errors.ErrValidation, err)
 		}
 	}
@@ -293,18 +463,32 @@ func (s *ProfileService) PatchProfile(ctx context.Context, userID string,
 	}
 
 	if educationLevel != nil {
-		if err := validation.ValidateEducationLevel(*educationLevel); err != nil {
+		if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyEducationLevel, *educationLevel); err != nil {
 			return fmt.Errorf("%w: %v", errors.ErrValidation, err)
 		}
 	}
 
 	if homeDistrict != nil {
-		if err := validation.ValidateHomeDistrict(*homeDistrict); err != nil {
+		if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyLocation, *homeDistrict); err != nil {
 			return fmt.Errorf("%w: %v", errors.ErrValidation, err)
 		}
 	}
 
-	updates := make(map[string]interface{})
+	if currentLocation != nil {
+		if err := s.taxonomyService.ValidateOption(ctx, constants.TaxonomyLocation, *currentLocation); err != nil {
+			return fmt.Errorf("%w: invalid current location value", errors.ErrValidation)
+		}
+	}
+
+	detailUpdates, err := s.profileDetailsUpdates(details)
+	if err != nil {
+		return err
+	}
+
+	updates, err := lifestyleUpdates(lifestyle)
+	if err != nil {
+		return err
+	}
 
 	if isBride != nil {
 		updates["is_bride"] = *isBride
@@ -362,19 +546,172 @@ func (s *ProfileService) PatchProfile(ctx context.Context, userID string,
 		updates["home_district"] = *homeDistrict
 	}
 
-	if len(updates) == 0 {
+	if currentLocation != nil {
+		updates["current_location"] = *currentLocation
+	}
+
+	if len(updates) == 0 && len(detailUpdates) == 0 {
 		s.logger.Debug("No fields to update", "userID", userID)
 		return nil
 	}
 
-	if err := s.profileRepo.PatchProfile(ctx, userUUID, updates); err != nil {
-		return fmt.Errorf("failed to patch profile: %w", err)
+	if len(updates) > 0 {
+		if err := s.profileRepo.PatchProfile(ctx, userUUID, updates); err != nil {
+			return fmt.Errorf("failed to patch profile: %w", err)
+		}
 	}
 
-	s.logger.Info("Profile patched successfully", "userID", userID, "fieldsUpdated", len(updates))
+	if len(detailUpdates) > 0 {
+		if err := s.profileDetailsRepo.UpsertProfileDetails(ctx, profile.ID, detailUpdates); err != nil {
+			return fmt.Errorf("failed to patch profile details: %w", err)
+		}
+	}
+
+	s.logger.Info("Profile patched successfully", "userID", userID, "fieldsUpdated", len(updates)+len(detailUpdates))
 	return nil
 }
 
+// lifestyleUpdates validates the lifestyle attributes being updated and
+// returns them as user_profiles column updates
+func lifestyleUpdates(lifestyle LifestylePatch) (map[string]interface{}, error) {
+	fields := []struct {
+		column   string
+		value    *string
+		validate func(string) error
+	}{
+		{"prayer_frequency", lifestyle.PrayerFrequency, validation.ValidatePrayerFrequency},
+		{"hijab", lifestyle.Hijab, validation.ValidateHijab},
+		{"beard", lifestyle.Beard, validation.ValidateBeard},
+		{"madrasa_education", lifestyle.MadrasaEducation, validation.ValidateMadrasaEducation},
+		{"diet", lifestyle.Diet, validation.ValidateDiet},
+		{"smoking", lifestyle.Smoking, validation.ValidateSmoking},
+		{"willing_to_relocate", lifestyle.WillingToRelocate, validation.ValidateRelocation},
+	}
+
+	updates := make(map[string]interface{})
+	for _, field := range fields {
+		if field.value == nil {
+			continue
+		}
+		if err := field.validate(*field.value); err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrValidation, err)
+		}
+		updates[field.column] = *field.value
+	}
+	return updates, nil
+}
+
+// profileDetailsUpdates validates the extended profile fields being updated
+// and returns them as profile_details column updates
+func (s *ProfileService) profileDetailsUpdates(details ProfileDetailsPatch) (map[string]interface{}, error) {
+	updates := make(map[string]interface{})
+
+	if details.AboutMe != nil {
+		aboutMe := strings.TrimSpace(*details.AboutMe)
+		if err := validation.ValidateAboutMe(aboutMe); err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrValidation, err)
+		}
+		updates["about_me"] = aboutMe
+	}
+
+	if details.AnnualIncome != nil {
+		if err := validation.ValidateIncomeBand(*details.AnnualIncome); err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrValidation, err)
+		}
+		updates["annual_income"] = *details.AnnualIncome
+	}
+
+	if details.Languages != nil {
+		languages, err := normalizeLanguages(*details.Languages)
+		if err != nil {
+			return nil, err
+		}
+		updates["languages"] = languages
+	}
+
+	if details.ResidencyStatus != nil {
+		if err := validation.ValidateResidencyStatus(*details.ResidencyStatus); err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrValidation, err)
+		}
+		updates["residency_status"] = *details.ResidencyStatus
+	}
+
+	if details.FamilyType != nil {
+		if err := validation.ValidateFamilyType(*details.FamilyType); err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrValidation, err)
+		}
+		updates["family_type"] = *details.FamilyType
+	}
+
+	textFields := []struct {
+		column string
+		value  *string
+	}{
+		{"current_city", details.CurrentCity},
+		{"current_country", details.CurrentCountry},
+		{"father_occupation", details.FatherOccupation},
+		{"mother_occupation", details.MotherOccupation},
+	}
+	for _, field := range textFields {
+		if field.value == nil {
+			continue
+		}
+		value := strings.TrimSpace(*field.value)
+		if err := validation.ValidateShortText(value); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", errors.ErrValidation, field.column, err)
+		}
+		updates[field.column] = value
+	}
+
+	if details.BrothersCount != nil {
+		if err := validation.ValidateSiblingCount(details.BrothersCount); err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrValidation, err)
+		}
+		updates["brothers_count"] = *details.BrothersCount
+	}
+
+	if details.SistersCount != nil {
+		if err := validation.ValidateSiblingCount(details.SistersCount); err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrValidation, err)
+		}
+		updates["sisters_count"] = *details.SistersCount
+	}
+
+	return updates, nil
+}
+
+// normalizeLanguages validates the spoken languages and removes duplicates
+func normalizeLanguages(languages []string) (pq.StringArray, error) {
+	if len(languages) > constants.MaxLanguages {
+		return nil, fmt.Errorf("%w: at most %d languages can be listed", errors.ErrValidation, constants.MaxLanguages)
+	}
+
+	seen := make(map[string]bool, len(languages))
+	normalized := make(pq.StringArray, 0, len(languages))
+	for _, language := range languages {
+		language = strings.ToLower(strings.TrimSpace(language))
+		if err := validation.ValidateLanguage(language); err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrValidation, err)
+		}
+		if seen[language] {
+			continue
+		}
+		seen[language] = true
+		normalized = append(normalized, language)
+	}
+	return normalized, nil
+}
+
+// GetProfileDetails returns the extended details of the user's profile, or nil
+// when none were saved yet
+func (s *ProfileService) GetProfileDetails(ctx context.Context, profileID uint) (*models.ProfileDetails, error) {
+	details, err := s.profileDetailsRepo.GetProfileDetails(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving profile details: %w", err)
+	}
+	return details, nil
+}
+
 func (s *ProfileService) GetProfile(ctx context.Context, userID string) (*models.UserProfile, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
@@ -463,7 +800,9 @@ func (s *ProfileService) GetDetailedProfileByID(ctx context.Context, profileID u
 		ProfessionType:        profile.ProfessionType,
 		HighestEducationLevel: profile.HighestEducationLevel,
 		HomeDistrict:          profile.HomeDistrict,
+		CurrentLocation:       profile.CurrentLocation,
 		ProfilePictureURL:     profile.ProfilePictureURL,
+		Lifestyle:             profile.Lifestyle,
 		LastLogin:             profile.LastLogin,
 	}
 
@@ -486,6 +825,11 @@ func (s *ProfileService) GetDetailedProfileByID(ctx context.Context, profileID u
 		detailedData.IntroVideo = video
 	}
 
+	// Get extended profile details
+	if details, err := s.profileDetailsRepo.GetProfileDetails(ctx, profile.ID); err == nil && details != nil {
+		detailedData.Details = details
+	}
+
 	return detailedData, nil
 }
 
@@ -521,7 +865,9 @@ func (s *ProfileService) GetDetailedProfileByUUID(ctx context.Context, userUUID
 		ProfessionType:        profile.ProfessionType,
 		HighestEducationLevel: profile.HighestEducationLevel,
 		HomeDistrict:          profile.HomeDistrict,
+		CurrentLocation:       profile.CurrentLocation,
 		ProfilePictureURL:     profile.ProfilePictureURL,
+		Lifestyle:             profile.Lifestyle,
 		LastLogin:             profile.LastLogin,
 	}
 
@@ -544,5 +890,10 @@ func (s *ProfileService) GetDetailedProfileByUUID(ctx context.Context, userUUID
 		detailedData.IntroVideo = video
 	}
 
+	// Get extended profile details
+	if details, err := s.profileDetailsRepo.GetProfileDetails(ctx, profile.ID); err == nil && details != nil {
+		detailedData.Details = details
+	}
+
 	return detailedData, nil
 }
@@ -0,0 +1,637 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/repositories"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/errors"
+)
+
+// earthRadiusKM is the mean radius of the earth, used for distances between locations
+const earthRadiusKM = 6371.0
+
+// taxonomyValuePattern is the format of stored option values, e.g. "software_engineer"
+var taxonomyValuePattern = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`)
+
+// TaxonomyOptionUpdate carries the fields of an UpdateTaxonomyOption request.
+// Nil fields are left unchanged. The value of an option never changes, since
+// profiles and partner preferences store it.
+type TaxonomyOptionUpdate struct {
+	Label     *string
+	SortOrder *int
+	IsActive  *bool
+	// Locations only; an empty parent removes it
+	Parent         *string
+	Level          *string
+	Coordinates    *LocationCoordinates
+	AddAdjacent    []string
+	RemoveAdjacent []string
+}
+
+// LocationCoordinates is the centroid of a district or city
+type LocationCoordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// TaxonomyService manages the admin-editable option lists for community,
+// profession, education level and location, and validates profile values
+// against them. Options and the location adjacency graph are cached in
+// memory and reloaded after cacheTTL, so changes made through another
+// instance show up within that time.
+type TaxonomyService struct {
+	taxonomyRepo repositories.TaxonomyRepository
+	cacheTTL     time.Duration
+	logger       logging.Logger
+
+	mu       sync.RWMutex
+	options  []*models.TaxonomyOption
+	byValue  map[models.TaxonomyCategory]map[string]*models.TaxonomyOption
+	adjacent map[string]map[string]bool
+	loadedAt time.Time
+}
+
+// NewTaxonomyService creates a new taxonomy service
+func NewTaxonomyService(
+	taxonomyRepo repositories.TaxonomyRepository,
+	cacheTTL time.Duration,
+	logger logging.Logger,
+) *TaxonomyService {
+	return &TaxonomyService{
+		taxonomyRepo: taxonomyRepo,
+		cacheTTL:     cacheTTL,
+		logger:       logger,
+	}
+}
+
+// Refresh reloads the option cache from the database
+func (s *TaxonomyService) Refresh(ctx context.Context) error {
+	options, err := s.taxonomyRepo.ListOptions(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load taxonomy options: %w", err)
+	}
+
+	byValue := make(map[models.TaxonomyCategory]map[string]*models.TaxonomyOption)
+	for _, option := range options {
+		if byValue[option.Category] == nil {
+			byValue[option.Category] = make(map[string]*models.TaxonomyOption)
+		}
+		byValue[option.Category][option.Value] = option
+	}
+
+	adjacencies, err := s.taxonomyRepo.ListLocationAdjacencies(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load location adjacency: %w", err)
+	}
+
+	// The graph is undirected, so record each pair both ways
+	adjacent := make(map[string]map[string]bool)
+	for _, adjacency := range adjacencies {
+		for _, pair := range [][2]string{
+			{adjacency.LocationValue, adjacency.AdjacentValue},
+			{adjacency.AdjacentValue, adjacency.LocationValue},
+		} {
+			if adjacent[pair[0]] == nil {
+				adjacent[pair[0]] = make(map[string]bool)
+			}
+			adjacent[pair[0]][pair[1]] = true
+		}
+	}
+
+	s.mu.Lock()
+	s.options = options
+	s.byValue = byValue
+	s.adjacent = adjacent
+	s.loadedAt = time.Now()
+	s.mu.Unlock()
+
+	return nil
+}
+
+// cached returns the cached options, reloading them once they are older than
+// the TTL. When a reload fails the stale options keep being served.
+func (s *TaxonomyService) cached(ctx context.Context) ([]*models.TaxonomyOption, map[models.TaxonomyCategory]map[string]*models.TaxonomyOption, error) {
+	s.mu.RLock()
+	options, byValue, loadedAt := s.options, s.byValue, s.loadedAt
+	s.mu.RUnlock()
+
+	if byValue != nil && time.Since(loadedAt) < s.cacheTTL {
+		return options, byValue, nil
+	}
+
+	if err := s.Refresh(ctx); err != nil {
+		if byValue != nil {
+			s.logger.Warn("Serving stale taxonomy options", "error", err)
+			return options, byValue, nil
+		}
+		return nil, nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.options, s.byValue, nil
+}
+
+// ValidateOption checks that value is an active option of the category.
+// The not_mentioned placeholder is accepted for every category.
+func (s *TaxonomyService) ValidateOption(ctx context.Context, category models.TaxonomyCategory, value string) error {
+	if value == constants.DefaultNotMentioned {
+		return nil
+	}
+
+	_, byValue, err := s.cached(ctx)
+	if err != nil {
+		return err
+	}
+
+	option, ok := byValue[category][value]
+	if !ok || !option.IsActive {
+		return fmt.Errorf("invalid %s value", strings.ReplaceAll(string(category), "_", " "))
+	}
+	return nil
+}
+
+// ListOptions returns the options of a category, or of every category when
+// category is empty. Inactive options are only included when asked for.
+func (s *TaxonomyService) ListOptions(ctx context.Context, category string, includeInactive bool) ([]*models.TaxonomyOption, error) {
+	if category != "" && !isTaxonomyCategory(category) {
+		return nil, fmt.Errorf("%w: unknown taxonomy category %q", errors.ErrValidation, category)
+	}
+
+	options, _, err := s.cached(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.TaxonomyOption, 0, len(options))
+	for _, option := range options {
+		if category != "" && string(option.Category) != category {
+			continue
+		}
+		if !option.IsActive && !includeInactive {
+			continue
+		}
+		result = append(result, option)
+	}
+	return result, nil
+}
+
+// CreateOption adds a new option to a category. Locations also take a level
+// and, below country level, the value of the broader location they belong to,
+// and optionally their coordinates and the locations bordering them.
+func (s *TaxonomyService) CreateOption(ctx context.Context, category, value, label string, sortOrder int, parent, level string,
+	coordinates *LocationCoordinates, adjacent []string) (*models.TaxonomyOption, error) {
+	value = strings.TrimSpace(value)
+	label = strings.TrimSpace(label)
+	parent = strings.TrimSpace(parent)
+	level = strings.TrimSpace(level)
+	adjacent = normalizeLocationValues(adjacent)
+
+	if !isTaxonomyCategory(category) {
+		return nil, fmt.Errorf("%w: unknown taxonomy category %q", errors.ErrValidation, category)
+	}
+	if len(value) > constants.MaxTaxonomyValueLength || !taxonomyValuePattern.MatchString(value) {
+		return nil, fmt.Errorf("%w: value must be lowercase letters, digits and single underscores, at most %d characters",
+			errors.ErrValidation, constants.MaxTaxonomyValueLength)
+	}
+	if value == constants.DefaultNotMentioned {
+		return nil, fmt.Errorf("%w: %s is reserved", errors.ErrValidation, constants.DefaultNotMentioned)
+	}
+	if err := validateTaxonomyLabel(label); err != nil {
+		return nil, err
+	}
+	if err := s.validateLocationPlacement(ctx, category, parent, level); err != nil {
+		return nil, err
+	}
+	if err := s.validateLocationProximity(ctx, category, value, coordinates, adjacent); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.taxonomyRepo.GetOptionByValue(ctx, models.TaxonomyCategory(category), value)
+	if err != nil {
+		return nil, fmt.Errorf("error checking taxonomy option: %w", err)
+	}
+	if existing != nil {
+		return nil, errors.ErrTaxonomyOptionExists
+	}
+
+	now := indianstandardtime.Now()
+	option := &models.TaxonomyOption{
+		Category:  models.TaxonomyCategory(category),
+		Value:     value,
+		Label:     label,
+		SortOrder: sortOrder,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if parent != "" {
+		option.ParentValue = &parent
+	}
+	if level != "" {
+		locationLevel := models.LocationLevel(level)
+		option.Level = &locationLevel
+	}
+	if coordinates != nil {
+		option.Latitude = &coordinates.Latitude
+		option.Longitude = &coordinates.Longitude
+	}
+	if err := s.taxonomyRepo.CreateOption(ctx, option); err != nil {
+		return nil, fmt.Errorf("failed to create taxonomy option: %w", err)
+	}
+	if len(adjacent) > 0 {
+		if err := s.taxonomyRepo.AddLocationAdjacencies(ctx, value, adjacent); err != nil {
+			s.invalidate()
+			return nil, fmt.Errorf("failed to save adjacent locations: %w", err)
+		}
+	}
+
+	s.invalidate()
+	s.logger.Info("Taxonomy option created", "category", category, "value", value)
+	return option, nil
+}
+
+// UpdateOption changes the label, sort order or active flag of an option,
+// and for locations the parent, level, coordinates and bordering locations
+func (s *TaxonomyService) UpdateOption(ctx context.Context, id uint, update TaxonomyOptionUpdate) (*models.TaxonomyOption, error) {
+	option, err := s.taxonomyRepo.GetOptionByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving taxonomy option: %w", err)
+	}
+	if option == nil {
+		return nil, errors.ErrTaxonomyOptionNotFound
+	}
+
+	if update.Label != nil {
+		label := strings.TrimSpace(*update.Label)
+		if err := validateTaxonomyLabel(label); err != nil {
+			return nil, err
+		}
+		option.Label = label
+	}
+	if update.SortOrder != nil {
+		option.SortOrder = *update.SortOrder
+	}
+	if update.IsActive != nil {
+		option.IsActive = *update.IsActive
+	}
+	if update.Parent != nil || update.Level != nil {
+		if err := s.moveLocation(ctx, option, update.Parent, update.Level); err != nil {
+			return nil, err
+		}
+	}
+	addAdjacent := normalizeLocationValues(update.AddAdjacent)
+	removeAdjacent := normalizeLocationValues(update.RemoveAdjacent)
+	if update.Coordinates != nil || len(addAdjacent) > 0 || len(removeAdjacent) > 0 {
+		if err := s.validateLocationProximity(ctx, string(option.Category), option.Value, update.Coordinates, addAdjacent); err != nil {
+			return nil, err
+		}
+		if update.Coordinates != nil {
+			option.Latitude = &update.Coordinates.Latitude
+			option.Longitude = &update.Coordinates.Longitude
+		}
+	}
+	option.UpdatedAt = indianstandardtime.Now()
+
+	if err := s.taxonomyRepo.UpdateOption(ctx, option); err != nil {
+		return nil, fmt.Errorf("failed to update taxonomy option: %w", err)
+	}
+	if err := s.taxonomyRepo.RemoveLocationAdjacencies(ctx, option.Value, removeAdjacent); err != nil {
+		s.invalidate()
+		return nil, fmt.Errorf("failed to remove adjacent locations: %w", err)
+	}
+	if len(addAdjacent) > 0 {
+		if err := s.taxonomyRepo.AddLocationAdjacencies(ctx, option.Value, addAdjacent); err != nil {
+			s.invalidate()
+			return nil, fmt.Errorf("failed to save adjacent locations: %w", err)
+		}
+	}
+
+	s.invalidate()
+	s.logger.Info("Taxonomy option updated", "id", id, "category", option.Category, "value", option.Value)
+	return option, nil
+}
+
+// DeleteOption deactivates an option. It stays on the profiles that already
+// use it but can no longer be selected.
+func (s *TaxonomyService) DeleteOption(ctx context.Context, id uint) (*models.TaxonomyOption, error) {
+	inactive := false
+	return s.UpdateOption(ctx, id, TaxonomyOptionUpdate{IsActive: &inactive})
+}
+
+// moveLocation applies a new parent or level to an option. The placement must
+// pass the same checks as a new option, the option cannot end up inside its
+// own sub-locations, and its sub-locations must stay narrower than it.
+func (s *TaxonomyService) moveLocation(ctx context.Context, option *models.TaxonomyOption, newParent, newLevel *string) error {
+	var parent, level string
+	if option.ParentValue != nil {
+		parent = *option.ParentValue
+	}
+	if option.Level != nil {
+		level = string(*option.Level)
+	}
+	if newParent != nil {
+		parent = strings.TrimSpace(*newParent)
+	}
+	if newLevel != nil {
+		level = strings.TrimSpace(*newLevel)
+	}
+
+	if err := s.validateLocationPlacement(ctx, string(option.Category), parent, level); err != nil {
+		return err
+	}
+
+	if option.Category == constants.TaxonomyLocation {
+		options, err := s.taxonomyRepo.ListOptions(ctx)
+		if err != nil {
+			return fmt.Errorf("error loading locations: %w", err)
+		}
+
+		locations := make(map[string]*models.TaxonomyOption)
+		for _, location := range options {
+			if location.Category == constants.TaxonomyLocation {
+				locations[location.Value] = location
+			}
+		}
+
+		// Walk up from the new parent; reaching the option means it would contain itself
+		for current, steps := parent, 0; current != "" && steps <= len(locations); steps++ {
+			if current == option.Value {
+				return fmt.Errorf("%w: a location cannot be placed inside itself or one of its sub-locations", errors.ErrValidation)
+			}
+			next := locations[current]
+			if next == nil || next.ParentValue == nil {
+				break
+			}
+			current = *next.ParentValue
+		}
+
+		rank := locationLevelRank(models.LocationLevel(level))
+		for _, child := range locations {
+			if child.ParentValue == nil || *child.ParentValue != option.Value {
+				continue
+			}
+			if child.Level == nil || locationLevelRank(*child.Level) <= rank {
+				return fmt.Errorf("%w: sub-location %q must be narrower than a %s", errors.ErrValidation, child.Value, level)
+			}
+		}
+	}
+
+	option.ParentValue = nil
+	if parent != "" {
+		option.ParentValue = &parent
+	}
+	option.Level = nil
+	if level != "" {
+		locationLevel := models.LocationLevel(level)
+		option.Level = &locationLevel
+	}
+	return nil
+}
+
+// validateLocationProximity checks the coordinates and bordering locations of
+// an option. Only locations have them; coordinates must be a valid latitude
+// and longitude, and every bordering location must exist.
+func (s *TaxonomyService) validateLocationProximity(ctx context.Context, category, value string, coordinates *LocationCoordinates, adjacent []string) error {
+	if category != string(constants.TaxonomyLocation) {
+		if coordinates != nil || len(adjacent) > 0 {
+			return fmt.Errorf("%w: only locations have coordinates or bordering locations", errors.ErrValidation)
+		}
+		return nil
+	}
+
+	if coordinates != nil {
+		if math.IsNaN(coordinates.Latitude) || coordinates.Latitude < -90 || coordinates.Latitude > 90 ||
+			math.IsNaN(coordinates.Longitude) || coordinates.Longitude < -180 || coordinates.Longitude > 180 {
+			return fmt.Errorf("%w: latitude must be between -90 and 90 and longitude between -180 and 180", errors.ErrValidation)
+		}
+	}
+
+	for _, other := range adjacent {
+		if other == value {
+			return fmt.Errorf("%w: a location cannot border itself", errors.ErrValidation)
+		}
+		location, err := s.taxonomyRepo.GetOptionByValue(ctx, constants.TaxonomyLocation, other)
+		if err != nil {
+			return fmt.Errorf("error checking adjacent location: %w", err)
+		}
+		if location == nil {
+			return fmt.Errorf("%w: adjacent location %q does not exist", errors.ErrValidation, other)
+		}
+	}
+	return nil
+}
+
+// normalizeLocationValues trims location values and drops blanks and duplicates
+func normalizeLocationValues(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var normalized []string
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		normalized = append(normalized, value)
+	}
+	return normalized
+}
+
+// validateLocationPlacement checks the level and parent of a new option.
+// Only locations have them; a location's parent must be an existing location
+// of a broader level, and every level below country needs one.
+func (s *TaxonomyService) validateLocationPlacement(ctx context.Context, category, parent, level string) error {
+	if category != string(constants.TaxonomyLocation) {
+		if parent != "" || level != "" {
+			return fmt.Errorf("%w: only locations have a parent or level", errors.ErrValidation)
+		}
+		return nil
+	}
+
+	rank := locationLevelRank(models.LocationLevel(level))
+	if rank < 0 {
+		return fmt.Errorf("%w: location level must be one of country, state, district or city", errors.ErrValidation)
+	}
+	if rank == 0 {
+		if parent != "" {
+			return fmt.Errorf("%w: a country cannot have a parent location", errors.ErrValidation)
+		}
+		return nil
+	}
+	if parent == "" {
+		return fmt.Errorf("%w: a %s needs a parent location", errors.ErrValidation, level)
+	}
+
+	parentOption, err := s.taxonomyRepo.GetOptionByValue(ctx, constants.TaxonomyLocation, parent)
+	if err != nil {
+		return fmt.Errorf("error checking parent location: %w", err)
+	}
+	if parentOption == nil {
+		return fmt.Errorf("%w: parent location %q does not exist", errors.ErrValidation, parent)
+	}
+	if parentOption.Level == nil || locationLevelRank(*parentOption.Level) >= rank {
+		return fmt.Errorf("%w: parent location must be broader than a %s", errors.ErrValidation, level)
+	}
+	return nil
+}
+
+// LocationPath returns the location and the broader locations containing it,
+// narrowest first. Unknown values return an empty path.
+func (s *TaxonomyService) LocationPath(ctx context.Context, value string) []*models.TaxonomyOption {
+	_, byValue, err := s.cached(ctx)
+	if err != nil {
+		s.logger.Warn("Failed to load locations", "error", err)
+		return nil
+	}
+
+	locations := byValue[constants.TaxonomyLocation]
+	var path []*models.TaxonomyOption
+	for option := locations[value]; option != nil && len(path) <= len(constants.LocationLevels); {
+		path = append(path, option)
+		if option.ParentValue == nil {
+			break
+		}
+		option = locations[*option.ParentValue]
+	}
+	return path
+}
+
+// IsWithinLocation reports whether value is area itself or lies inside it,
+// e.g. dubai_city is within dubai and united_arab_emirates.
+func (s *TaxonomyService) IsWithinLocation(ctx context.Context, value, area string) bool {
+	if value == "" || value == constants.DefaultNotMentioned {
+		return false
+	}
+	for _, option := range s.LocationPath(ctx, value) {
+		if option.Value == area {
+			return true
+		}
+	}
+	return false
+}
+
+// LocationDistanceKM returns the great-circle distance between the centroids
+// of two locations. A location without coordinates of its own, such as an
+// admin-added town, uses the nearest broader location that has them. ok is
+// false when either location has no coordinates at any level.
+func (s *TaxonomyService) LocationDistanceKM(ctx context.Context, a, b string) (float64, bool) {
+	latA, lonA, ok := s.locationCentroid(ctx, a)
+	if !ok {
+		return 0, false
+	}
+	latB, lonB, ok := s.locationCentroid(ctx, b)
+	if !ok {
+		return 0, false
+	}
+	return haversineKM(latA, lonA, latB, lonB), true
+}
+
+// AreAdjacentLocations reports whether two locations share a border, e.g.
+// Malappuram and Kozhikode. A city without a recorded border of its own
+// falls back to its district. States and countries are never compared:
+// Kerala bordering Tamil Nadu says nothing about Kasaragod and Chennai.
+func (s *TaxonomyService) AreAdjacentLocations(ctx context.Context, a, b string) bool {
+	candidatesA := s.adjacencyCandidates(ctx, a)
+	candidatesB := s.adjacencyCandidates(ctx, b)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, valueA := range candidatesA {
+		for _, valueB := range candidatesB {
+			if s.adjacent[valueA][valueB] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// adjacencyCandidates returns the location itself and, below district
+// level, its nearest district. Unknown locations and those above district
+// level have none.
+func (s *TaxonomyService) adjacencyCandidates(ctx context.Context, value string) []string {
+	path := s.LocationPath(ctx, value)
+	if len(path) == 0 || isAboveDistrict(path[0]) {
+		return nil
+	}
+
+	candidates := []string{path[0].Value}
+	for _, option := range path[1:] {
+		if option.Level != nil && *option.Level == constants.LocationDistrict {
+			return append(candidates, option.Value)
+		}
+	}
+	return candidates
+}
+
+func (s *TaxonomyService) locationCentroid(ctx context.Context, value string) (float64, float64, bool) {
+	for _, option := range s.LocationPath(ctx, value) {
+		if option.Latitude != nil && option.Longitude != nil {
+			return *option.Latitude, *option.Longitude, true
+		}
+	}
+	return 0, 0, false
+}
+
+// haversineKM returns the great-circle distance between two points in kilometres
+func haversineKM(lat1, lon1, lat2, lon2 float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKM * math.Asin(math.Sqrt(h))
+}
+
+// invalidate makes the next lookup reload the options
+func (s *TaxonomyService) invalidate() {
+	s.mu.Lock()
+	s.loadedAt = time.Time{}
+	s.mu.Unlock()
+}
+
+func isTaxonomyCategory(category string) bool {
+	for _, c := range constants.TaxonomyCategories {
+		if string(c) == category {
+			return true
+		}
+	}
+	return false
+}
+
+func isAboveDistrict(option *models.TaxonomyOption) bool {
+	return option.Level != nil && locationLevelRank(*option.Level) < locationLevelRank(constants.LocationDistrict)
+}
+
+// locationLevelRank is the position of level in constants.LocationLevels,
+// or -1 when it is not a location level
+func locationLevelRank(level models.LocationLevel) int {
+	for i, l := range constants.LocationLevels {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
+
+func validateTaxonomyLabel(label string) error {
+	if label == "" {
+		return fmt.Errorf("%w: label is required", errors.ErrValidation)
+	}
+	if utf8.RuneCountInString(label) > constants.MaxTaxonomyLabelLength {
+		return fmt.Errorf("%w: label must be at most %d characters", errors.ErrValidation, constants.MaxTaxonomyLabelLength)
+	}
+	return nil
+}
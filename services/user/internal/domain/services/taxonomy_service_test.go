@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/constants"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/repositories"
+)
+
+// fakeTaxonomyRepo serves fixed options and adjacencies; the methods the
+// tests do not need are left to the embedded nil interface
+type fakeTaxonomyRepo struct {
+	repositories.TaxonomyRepository
+	options     []*models.TaxonomyOption
+	adjacencies []*models.LocationAdjacency
+}
+
+func (r *fakeTaxonomyRepo) ListOptions(context.Context) ([]*models.TaxonomyOption, error) {
+	return r.options, nil
+}
+
+func (r *fakeTaxonomyRepo) ListLocationAdjacencies(context.Context) ([]*models.LocationAdjacency, error) {
+	return r.adjacencies, nil
+}
+
+// testLocation builds a location option; coordinates are latitude and longitude
+func testLocation(value string, level constants.LocationLevel, parent string, coordinates ...float64) *models.TaxonomyOption {
+	option := &models.TaxonomyOption{
+		Category: constants.TaxonomyLocation,
+		Value:    value,
+		Label:    value,
+		IsActive: true,
+		Level:    &level,
+	}
+	if parent != "" {
+		option.ParentValue = &parent
+	}
+	if len(coordinates) == 2 {
+		option.Latitude, option.Longitude = &coordinates[0], &coordinates[1]
+	}
+	return option
+}
+
+// newTestTaxonomyService returns a taxonomy service over a few Kerala and
+// Tamil Nadu locations, with the district centroids of the seed migration
+func newTestTaxonomyService(extra ...*models.TaxonomyOption) *TaxonomyService {
+	options := []*models.TaxonomyOption{
+		testLocation("india", constants.LocationCountry, ""),
+		testLocation("kerala", constants.LocationState, "india"),
+		testLocation("tamil_nadu", constants.LocationState, "india"),
+		testLocation("thiruvananthapuram", constants.LocationDistrict, "kerala", 8.60, 77.05),
+		testLocation("palakkad", constants.LocationDistrict, "kerala", 10.78, 76.65),
+		testLocation("malappuram", constants.LocationDistrict, "kerala", 11.07, 76.07),
+		testLocation("kozhikode", constants.LocationDistrict, "kerala", 11.45, 75.90),
+		testLocation("coimbatore", constants.LocationDistrict, "tamil_nadu", 11.02, 76.96),
+		testLocation("feroke", constants.LocationCity, "kozhikode"), // An admin-added town without coordinates
+	}
+	repo := &fakeTaxonomyRepo{
+		options: append(options, extra...),
+		adjacencies: []*models.LocationAdjacency{
+			{LocationValue: "kozhikode", AdjacentValue: "malappuram"},
+			{LocationValue: "malappuram", AdjacentValue: "palakkad"},
+			{LocationValue: "palakkad", AdjacentValue: "coimbatore"},
+			{LocationValue: "kerala", AdjacentValue: "tamil_nadu"},
+		},
+	}
+	return NewTaxonomyService(repo, time.Hour, logging.Default())
+}
+
+func TestAreAdjacentLocations(t *testing.T) {
+	service := newTestTaxonomyService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"bordering districts", "kozhikode", "malappuram", true},
+		{"either order", "malappuram", "kozhikode", true},
+		{"districts across a state border", "palakkad", "coimbatore", true},
+		{"city falls back to its district", "feroke", "malappuram", true},
+		{"distant districts", "kozhikode", "thiruvananthapuram", false},
+		{"districts in bordering states", "kozhikode", "coimbatore", false},
+		{"bordering states", "kerala", "tamil_nadu", false},
+		{"district and bordering state", "coimbatore", "kerala", false},
+		{"same district", "kozhikode", "kozhikode", false},
+		{"unknown location", "atlantis", "malappuram", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := service.AreAdjacentLocations(ctx, tt.a, tt.b); got != tt.want {
+				t.Errorf("AreAdjacentLocations(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHaversineKM(t *testing.T) {
+	tests := []struct {
+		name                   string
+		lat1, lon1, lat2, lon2 float64
+		want                   float64
+	}{
+		{"same point", 11.45, 75.90, 11.45, 75.90, 0},
+		{"one degree of latitude", 0, 0, 1, 0, 111.19},
+		{"quarter of the equator", 0, 0, 0, 90, 10007.54},
+		{"kozhikode to malappuram", 11.45, 75.90, 11.07, 76.07, 46.14},
+		{"kozhikode to thiruvananthapuram", 11.45, 75.90, 8.60, 77.05, 341.00},
+		{"dubai to abu dhabi", 25.20, 55.27, 24.45, 54.38, 122.56},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := haversineKM(tt.lat1, tt.lon1, tt.lat2, tt.lon2)
+			if math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("haversineKM = %.2f, want %.2f", got, tt.want)
+			}
+			if reverse := haversineKM(tt.lat2, tt.lon2, tt.lat1, tt.lon1); math.Abs(reverse-got) > 1e-9 {
+				t.Errorf("reverse distance %.6f differs from %.6f", reverse, got)
+			}
+		})
+	}
+}
+
+func TestLocationDistanceKM(t *testing.T) {
+	service := newTestTaxonomyService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		a, b   string
+		want   float64
+		wantOK bool
+	}{
+		{"district centroids", "kozhikode", "malappuram", 46.14, true},
+		{"across a state border", "palakkad", "coimbatore", 43.10, true},
+		{"city uses its district", "feroke", "thiruvananthapuram", 341.00, true},
+		{"same district", "kozhikode", "feroke", 0, true},
+		{"state without coordinates", "kerala", "malappuram", 0, false},
+		{"unknown location", "malappuram", "atlantis", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := service.LocationDistanceKM(ctx, tt.a, tt.b)
+			if ok != tt.wantOK {
+				t.Fatalf("LocationDistanceKM(%q, %q) ok = %v, want %v", tt.a, tt.b, ok, tt.wantOK)
+			}
+			if math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("LocationDistanceKM(%q, %q) = %.2f, want %.2f", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -17,6 +17,12 @@ var (
 	ErrPartnerPreferencesNotFound = errors.New("partner preferences not found")
 )
 
+// Taxonomy errors
+var (
+	ErrTaxonomyOptionNotFound = errors.New("taxonomy option not found")
+	ErrTaxonomyOptionExists   = errors.New("taxonomy option already exists")
+)
+
 // Matchmaking service errors
 var (
 	ErrMatchActionFailed       = errors.New("failed to record match action")
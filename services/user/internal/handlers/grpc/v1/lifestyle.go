@@ -0,0 +1,52 @@
+package v1
+
+import (
+	userpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/user/v1"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
+)
+
+// toLifestyleData converts the lifestyle attributes of a profile to their proto form
+func toLifestyleData(lifestyle models.Lifestyle) *userpb.LifestyleData {
+	return &userpb.LifestyleData{
+		PrayerFrequency:   string(lifestyle.PrayerFrequency),
+		Hijab:             string(lifestyle.Hijab),
+		Beard:             string(lifestyle.Beard),
+		MadrasaEducation:  string(lifestyle.MadrasaEducation),
+		Diet:              string(lifestyle.Diet),
+		Smoking:           string(lifestyle.Smoking),
+		WillingToRelocate: string(lifestyle.WillingToRelocate),
+	}
+}
+
+// toLifestylePreferencesData converts lifestyle partner preferences to their proto form
+func toLifestylePreferencesData(prefs models.LifestylePreferences) *userpb.LifestylePreferencesData {
+	return &userpb.LifestylePreferencesData{
+		PreferredPrayerFrequencies: nonNilStrings(prefs.PreferredPrayerFrequencies),
+		PreferredHijab:             nonNilStrings(prefs.PreferredHijab),
+		PreferredBeard:             nonNilStrings(prefs.PreferredBeard),
+		PreferredMadrasaEducation:  nonNilStrings(prefs.PreferredMadrasaEducation),
+		PreferredDiets:             nonNilStrings(prefs.PreferredDiets),
+		PreferredSmoking:           nonNilStrings(prefs.PreferredSmoking),
+		PreferredRelocation:        nonNilStrings(prefs.PreferredRelocation),
+	}
+}
+
+// fromLifestylePreferencesData converts lifestyle partner preferences from a request
+func fromLifestylePreferencesData(data *userpb.LifestylePreferencesData) models.LifestylePreferences {
+	return models.LifestylePreferences{
+		PreferredPrayerFrequencies: data.GetPreferredPrayerFrequencies(),
+		PreferredHijab:             data.GetPreferredHijab(),
+		PreferredBeard:             data.GetPreferredBeard(),
+		PreferredMadrasaEducation:  data.GetPreferredMadrasaEducation(),
+		PreferredDiets:             data.GetPreferredDiets(),
+		PreferredSmoking:           data.GetPreferredSmoking(),
+		PreferredRelocation:        data.GetPreferredRelocation(),
+	}
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -180,6 +180,7 @@ func (h *MatchHandler) GetRecommendedMatches(ctx context.Context, req *userpb.Ge
 			ProfessionType:        string(profile.ProfessionType),
 			HighestEducationLevel: string(profile.HighestEducationLevel),
 			HomeDistrict:          string(profile.HomeDistrict),
+			CurrentLocation:       profile.CurrentLocation,
 			ProfilePictureUrl:     profilePictureURL,
 			LastLogin:             timestamppb.New(profile.LastLogin),
 			MatchReasons:          profile.MatchReasons,
@@ -12,6 +12,7 @@ import (
 	userpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/user/v1"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
 	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/services"
 	userErrors "github.com/mohamedfawas/qubool-kallyanam/services/user/internal/errors"
 )
@@ -116,6 +117,8 @@ func (h *PartnerPreferencesHandler) UpdatePartnerPreferences(ctx context.Context
 		req.PreferredProfessionTypes,
 		req.PreferredEducationLevels,
 		req.PreferredHomeDistricts,
+		req.PreferredCurrentLocations,
+		fromLifestylePreferencesData(req.Lifestyle),
 	)
 
 	if err != nil {
@@ -195,6 +198,12 @@ func (h *PartnerPreferencesHandler) PatchPartnerPreferences(ctx context.Context,
 		acceptPhysicallyChallenged = &value
 	}
 
+	var lifestyle *models.LifestylePreferences
+	if req.Lifestyle != nil {
+		prefs := fromLifestylePreferencesData(req.Lifestyle)
+		lifestyle = &prefs
+	}
+
 	// Call service method
 	err = h.partnerPreferencesService.PatchPartnerPreferences(
 		ctx,
@@ -210,12 +219,15 @@ func (h *PartnerPreferencesHandler) PatchPartnerPreferences(ctx context.Context,
 		req.PreferredProfessionTypes,
 		req.PreferredEducationLevels,
 		req.PreferredHomeDistricts,
+		req.PreferredCurrentLocations,
 		req.ClearPreferredCommunities,
 		req.ClearPreferredMaritalStatus,
 		req.ClearPreferredProfessions,
 		req.ClearPreferredProfessionTypes,
 		req.ClearPreferredEducationLevels,
 		req.ClearPreferredHomeDistricts,
+		req.ClearPreferredCurrentLocations,
+		lifestyle,
 	)
 
 	if err != nil {
@@ -305,6 +317,8 @@ func (h *PartnerPreferencesHandler) GetPartnerPreferences(ctx context.Context, r
 				PreferredProfessionTypes:   []string{},
 				PreferredEducationLevels:   []string{},
 				PreferredHomeDistricts:     []string{},
+				PreferredCurrentLocations:  []string{},
+				Lifestyle:                  toLifestylePreferencesData(models.LifestylePreferences{}),
 			},
 		}, nil
 	}
@@ -368,6 +382,8 @@ func (h *PartnerPreferencesHandler) GetPartnerPreferences(ctx context.Context, r
 			PreferredProfessionTypes:   professionTypes,
 			PreferredEducationLevels:   educationLevels,
 			PreferredHomeDistricts:     homeDistricts,
+			PreferredCurrentLocations:  prefs.PreferredCurrentLocations,
+			Lifestyle:                  toLifestylePreferencesData(prefs.LifestylePreferences),
 		},
 	}, nil
 }
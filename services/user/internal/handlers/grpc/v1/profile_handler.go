@@ -10,10 +10,13 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	userpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/user/v1"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/validation"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
 	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/services"
 	userErrors "github.com/mohamedfawas/qubool-kallyanam/services/user/internal/errors"
 )
@@ -105,6 +108,7 @@ func (h *ProfileHandler) UpdateProfile(ctx context.Context, req *userpb.UpdatePr
 		req.GetProfessionType(),
 		req.GetHighestEducationLevel(),
 		req.GetHomeDistrict(),
+		req.GetCurrentLocation(),
 	)
 
 	if err != nil {
@@ -226,6 +230,33 @@ func (h *ProfileHandler) PatchProfile(ctx context.Context, req *userpb.PatchProf
 		homeDistrict = &value
 	}
 
+	details := services.ProfileDetailsPatch{
+		AboutMe:          stringValue(req.AboutMe),
+		AnnualIncome:     stringValue(req.AnnualIncome),
+		CurrentCity:      stringValue(req.CurrentCity),
+		CurrentCountry:   stringValue(req.CurrentCountry),
+		ResidencyStatus:  stringValue(req.ResidencyStatus),
+		FatherOccupation: stringValue(req.FatherOccupation),
+		MotherOccupation: stringValue(req.MotherOccupation),
+		BrothersCount:    intValue(req.BrothersCount),
+		SistersCount:     intValue(req.SistersCount),
+		FamilyType:       stringValue(req.FamilyType),
+	}
+	if req.Languages != nil {
+		languages := req.Languages.Languages
+		details.Languages = &languages
+	}
+
+	lifestyle := services.LifestylePatch{
+		PrayerFrequency:   stringValue(req.PrayerFrequency),
+		Hijab:             stringValue(req.Hijab),
+		Beard:             stringValue(req.Beard),
+		MadrasaEducation:  stringValue(req.MadrasaEducation),
+		Diet:              stringValue(req.Diet),
+		Smoking:           stringValue(req.Smoking),
+		WillingToRelocate: stringValue(req.WillingToRelocate),
+	}
+
 	// Call service layer
 	err = h.profileService.PatchProfile(
 		ctx,
@@ -241,8 +272,11 @@ func (h *ProfileHandler) PatchProfile(ctx context.Context, req *userpb.PatchProf
 		professionType,
 		educationLevel,
 		homeDistrict,
+		stringValue(req.CurrentLocation),
 		req.ClearDateOfBirth,
 		req.ClearHeightCm,
+		details,
+		lifestyle,
 	)
 
 	if err != nil {
@@ -321,6 +355,7 @@ func (h *ProfileHandler) GetProfile(ctx context.Context, req *userpb.GetProfileR
 		IsBride:               profile.IsBride,
 		FullName:              profile.FullName,
 		Phone:                 profile.Phone,
+		PhoneCountry:          validation.PhoneRegion(profile.Phone),
 		PhysicallyChallenged:  profile.PhysicallyChallenged,
 		Community:             string(profile.Community),
 		MaritalStatus:         string(profile.MaritalStatus),
@@ -328,6 +363,7 @@ func (h *ProfileHandler) GetProfile(ctx context.Context, req *userpb.GetProfileR
 		ProfessionType:        string(profile.ProfessionType),
 		HighestEducationLevel: string(profile.HighestEducationLevel),
 		HomeDistrict:          string(profile.HomeDistrict),
+		CurrentLocation:       profile.CurrentLocation,
 		CreatedAt:             timestamppb.New(profile.CreatedAt),
 		LastLogin:             timestamppb.New(profile.LastLogin),
 	}
@@ -345,6 +381,13 @@ func (h *ProfileHandler) GetProfile(ctx context.Context, req *userpb.GetProfileR
 		profileData.ProfilePictureUrl = *profile.ProfilePictureURL
 	}
 
+	details, err := h.profileService.GetProfileDetails(ctx, profile.ID)
+	if err != nil {
+		h.logger.Error("Failed to get profile details", "error", err, "userID", userID)
+	}
+	profileData.Details = toProfileDetailsData(details)
+	profileData.Lifestyle = toLifestyleData(profile.Lifestyle)
+
 	return &userpb.GetProfileResponse{
 		Success: true,
 		Message: "Profile retrieved successfully",
@@ -512,6 +555,7 @@ func (h *ProfileHandler) GetDetailedProfile(ctx context.Context, req *userpb.Get
 		ProfessionType:        string(detailedProfile.ProfessionType),
 		HighestEducationLevel: string(detailedProfile.HighestEducationLevel),
 		HomeDistrict:          string(detailedProfile.HomeDistrict),
+		CurrentLocation:       detailedProfile.CurrentLocation,
 		LastLogin:             timestamppb.New(detailedProfile.LastLogin),
 		Age:                   int32(detailedProfile.Age),
 	}
@@ -590,6 +634,8 @@ func (h *ProfileHandler) GetDetailedProfile(ctx context.Context, req *userpb.Get
 			PreferredProfessionTypes:   professionTypes,
 			PreferredEducationLevels:   educationLevels,
 			PreferredHomeDistricts:     homeDistricts,
+			PreferredCurrentLocations:  prefs.PreferredCurrentLocations,
+			Lifestyle:                  toLifestylePreferencesData(prefs.LifestylePreferences),
 		}
 	}
 
@@ -620,6 +666,9 @@ func (h *ProfileHandler) GetDetailedProfile(ctx context.Context, req *userpb.Get
 		}
 	}
 
+	responseProfile.Details = toProfileDetailsData(detailedProfile.Details)
+	responseProfile.Lifestyle = toLifestyleData(detailedProfile.Lifestyle)
+
 	return &userpb.GetDetailedProfileResponse{
 		Success: true,
 		Message: "Detailed profile retrieved successfully",
@@ -680,6 +729,7 @@ func (h *ProfileHandler) GetProfileForAdmin(ctx context.Context, req *userpb.Get
 		ProfessionType:        string(detailedProfile.ProfessionType),
 		HighestEducationLevel: string(detailedProfile.HighestEducationLevel),
 		HomeDistrict:          string(detailedProfile.HomeDistrict),
+		CurrentLocation:       detailedProfile.CurrentLocation,
 		LastLogin:             timestamppb.New(detailedProfile.LastLogin),
 		Age:                   int32(detailedProfile.Age),
 	}
@@ -758,6 +808,8 @@ func (h *ProfileHandler) GetProfileForAdmin(ctx context.Context, req *userpb.Get
 			PreferredProfessionTypes:   professionTypes,
 			PreferredEducationLevels:   educationLevels,
 			PreferredHomeDistricts:     homeDistricts,
+			PreferredCurrentLocations:  prefs.PreferredCurrentLocations,
+			Lifestyle:                  toLifestylePreferencesData(prefs.LifestylePreferences),
 		}
 	}
 
@@ -788,6 +840,9 @@ func (h *ProfileHandler) GetProfileForAdmin(ctx context.Context, req *userpb.Get
 		}
 	}
 
+	responseProfile.Details = toProfileDetailsData(detailedProfile.Details)
+	responseProfile.Lifestyle = toLifestyleData(detailedProfile.Lifestyle)
+
 	h.logger.Info("Successfully retrieved detailed profile for admin", "user_uuid", req.GetUserUuid())
 
 	return &userpb.GetDetailedProfileResponse{
@@ -796,3 +851,48 @@ func (h *ProfileHandler) GetProfileForAdmin(ctx context.Context, req *userpb.Get
 		Profile: responseProfile,
 	}, nil
 }
+
+// toProfileDetailsData converts the extended profile details to their proto
+// form. Profiles without saved details get an empty message.
+func toProfileDetailsData(details *models.ProfileDetails) *userpb.ProfileDetailsData {
+	if details == nil {
+		return &userpb.ProfileDetailsData{Family: &userpb.FamilyDetailsData{}}
+	}
+
+	data := &userpb.ProfileDetailsData{
+		AboutMe:         details.AboutMe,
+		AnnualIncome:    string(details.AnnualIncome),
+		Languages:       details.Languages,
+		CurrentCity:     details.CurrentCity,
+		CurrentCountry:  details.CurrentCountry,
+		ResidencyStatus: string(details.ResidencyStatus),
+		Family: &userpb.FamilyDetailsData{
+			FatherOccupation: details.FatherOccupation,
+			MotherOccupation: details.MotherOccupation,
+			FamilyType:       string(details.FamilyType),
+		},
+	}
+	if details.BrothersCount != nil {
+		data.Family.BrothersCount = int32(*details.BrothersCount)
+	}
+	if details.SistersCount != nil {
+		data.Family.SistersCount = int32(*details.SistersCount)
+	}
+	return data
+}
+
+func stringValue(value *wrapperspb.StringValue) *string {
+	if value == nil {
+		return nil
+	}
+	v := value.Value
+	return &v
+}
+
+func intValue(value *wrapperspb.Int32Value) *int {
+	if value == nil {
+		return nil
+	}
+	v := int(value.Value)
+	return &v
+}
@@ -0,0 +1,226 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/user/v1"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/models"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/services"
+	userErrors "github.com/mohamedfawas/qubool-kallyanam/services/user/internal/errors"
+)
+
+// TaxonomyHandler serves the option lists for community, profession,
+// education level and location. Listing is public; the create, update and
+// delete RPCs are reached through the admin service, which forwards the
+// admin's access token so the caller's permissions can be checked here.
+type TaxonomyHandler struct {
+	taxonomyService *services.TaxonomyService
+	logger          logging.Logger
+}
+
+func NewTaxonomyHandler(
+	taxonomyService *services.TaxonomyService,
+	logger logging.Logger,
+) *TaxonomyHandler {
+	return &TaxonomyHandler{
+		taxonomyService: taxonomyService,
+		logger:          logger,
+	}
+}
+
+// ListTaxonomyOptions returns the options of one category, or of all categories
+func (h *TaxonomyHandler) ListTaxonomyOptions(ctx context.Context, req *userpb.ListTaxonomyOptionsRequest) (*userpb.ListTaxonomyOptionsResponse, error) {
+	options, err := h.taxonomyService.ListOptions(ctx, req.GetCategory(), req.GetIncludeInactive())
+	if err != nil {
+		h.logger.Error("Failed to list taxonomy options", "category", req.GetCategory(), "error", err)
+		errMsg, code := taxonomyErrorStatus(err, "Failed to list options")
+		return &userpb.ListTaxonomyOptionsResponse{
+			Success: false,
+			Message: "Failed to list options",
+			Error:   errMsg,
+		}, status.Error(code, errMsg)
+	}
+
+	response := make([]*userpb.TaxonomyOption, 0, len(options))
+	for _, option := range options {
+		response = append(response, toTaxonomyOption(option))
+	}
+
+	return &userpb.ListTaxonomyOptionsResponse{
+		Success: true,
+		Message: "Options retrieved successfully",
+		Options: response,
+	}, nil
+}
+
+// CreateTaxonomyOption adds an option to a category
+func (h *TaxonomyHandler) CreateTaxonomyOption(ctx context.Context, req *userpb.CreateTaxonomyOptionRequest) (*userpb.TaxonomyOptionResponse, error) {
+	if err := h.authorizeManager(ctx); err != nil {
+		return nil, err
+	}
+
+	option, err := h.taxonomyService.CreateOption(ctx, req.GetCategory(), req.GetValue(), req.GetLabel(), int(req.GetSortOrder()),
+		req.GetParent(), req.GetLevel(), fromLocationCoordinates(req.GetCoordinates()), req.GetAdjacent())
+	if err != nil {
+		h.logger.Error("Failed to create taxonomy option", "category", req.GetCategory(), "value", req.GetValue(), "error", err)
+		return taxonomyOptionFailure("Failed to create option", err)
+	}
+
+	return &userpb.TaxonomyOptionResponse{
+		Success: true,
+		Message: "Option created successfully",
+		Option:  toTaxonomyOption(option),
+	}, nil
+}
+
+// UpdateTaxonomyOption changes the label, sort order or active flag of an option,
+// and for locations the parent, level, coordinates and bordering locations
+func (h *TaxonomyHandler) UpdateTaxonomyOption(ctx context.Context, req *userpb.UpdateTaxonomyOptionRequest) (*userpb.TaxonomyOptionResponse, error) {
+	if err := h.authorizeManager(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.GetId() == 0 {
+		return taxonomyOptionFailure("Failed to update option", userErrors.ErrInvalidInput)
+	}
+
+	var update services.TaxonomyOptionUpdate
+	if req.Label != nil {
+		label := req.Label.GetValue()
+		update.Label = &label
+	}
+	if req.SortOrder != nil {
+		sortOrder := int(req.SortOrder.GetValue())
+		update.SortOrder = &sortOrder
+	}
+	if req.IsActive != nil {
+		isActive := req.IsActive.GetValue()
+		update.IsActive = &isActive
+	}
+	if req.Parent != nil {
+		parent := req.Parent.GetValue()
+		update.Parent = &parent
+	}
+	if req.Level != nil {
+		level := req.Level.GetValue()
+		update.Level = &level
+	}
+	update.Coordinates = fromLocationCoordinates(req.GetCoordinates())
+	update.AddAdjacent = req.GetAddAdjacent()
+	update.RemoveAdjacent = req.GetRemoveAdjacent()
+
+	option, err := h.taxonomyService.UpdateOption(ctx, uint(req.GetId()), update)
+	if err != nil {
+		h.logger.Error("Failed to update taxonomy option", "id", req.GetId(), "error", err)
+		return taxonomyOptionFailure("Failed to update option", err)
+	}
+
+	return &userpb.TaxonomyOptionResponse{
+		Success: true,
+		Message: "Option updated successfully",
+		Option:  toTaxonomyOption(option),
+	}, nil
+}
+
+// DeleteTaxonomyOption deactivates an option
+func (h *TaxonomyHandler) DeleteTaxonomyOption(ctx context.Context, req *userpb.DeleteTaxonomyOptionRequest) (*userpb.TaxonomyOptionResponse, error) {
+	if err := h.authorizeManager(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.GetId() == 0 {
+		return taxonomyOptionFailure("Failed to delete option", userErrors.ErrInvalidInput)
+	}
+
+	option, err := h.taxonomyService.DeleteOption(ctx, uint(req.GetId()))
+	if err != nil {
+		h.logger.Error("Failed to delete taxonomy option", "id", req.GetId(), "error", err)
+		return taxonomyOptionFailure("Failed to delete option", err)
+	}
+
+	return &userpb.TaxonomyOptionResponse{
+		Success: true,
+		Message: "Option deactivated successfully",
+		Option:  toTaxonomyOption(option),
+	}, nil
+}
+
+// authorizeManager checks that the request carries the validated token of an
+// admin allowed to manage taxonomies
+func (h *TaxonomyHandler) authorizeManager(ctx context.Context) error {
+	claims, ok := jwt.ClaimsFromContext(ctx)
+	if !ok {
+		h.logger.Debug("Taxonomy change without an access token")
+		return status.Error(codes.Unauthenticated, "Authentication required")
+	}
+	if claims.Role != jwt.RoleAdmin || !claims.HasPermission(jwt.PermissionTaxonomiesManage) {
+		h.logger.Warn("Taxonomy change denied", "userID", claims.UserID, "role", claims.Role)
+		return status.Error(codes.PermissionDenied, "Not allowed to manage taxonomies")
+	}
+	return nil
+}
+
+func taxonomyOptionFailure(message string, err error) (*userpb.TaxonomyOptionResponse, error) {
+	errMsg, code := taxonomyErrorStatus(err, message)
+	return &userpb.TaxonomyOptionResponse{
+		Success: false,
+		Message: message,
+		Error:   errMsg,
+	}, status.Error(code, errMsg)
+}
+
+// taxonomyErrorStatus maps a taxonomy service error to its message and gRPC code
+func taxonomyErrorStatus(err error, fallback string) (string, codes.Code) {
+	switch {
+	case errors.Is(err, userErrors.ErrTaxonomyOptionNotFound):
+		return "Option not found", codes.NotFound
+	case errors.Is(err, userErrors.ErrTaxonomyOptionExists):
+		return "An option with this value already exists", codes.AlreadyExists
+	case errors.Is(err, userErrors.ErrInvalidInput):
+		return "Option ID is required", codes.InvalidArgument
+	case errors.Is(err, userErrors.ErrValidation):
+		return err.Error(), codes.InvalidArgument
+	default:
+		return fallback, codes.Internal
+	}
+}
+
+func toTaxonomyOption(option *models.TaxonomyOption) *userpb.TaxonomyOption {
+	response := &userpb.TaxonomyOption{
+		Id:        uint64(option.ID),
+		Category:  string(option.Category),
+		Value:     option.Value,
+		Label:     option.Label,
+		SortOrder: int32(option.SortOrder),
+		IsActive:  option.IsActive,
+	}
+	if option.ParentValue != nil {
+		response.Parent = *option.ParentValue
+	}
+	if option.Level != nil {
+		response.Level = string(*option.Level)
+	}
+	if option.Latitude != nil && option.Longitude != nil {
+		response.Coordinates = &userpb.LocationCoordinates{
+			Latitude:  *option.Latitude,
+			Longitude: *option.Longitude,
+		}
+	}
+	return response
+}
+
+func fromLocationCoordinates(coordinates *userpb.LocationCoordinates) *services.LocationCoordinates {
+	if coordinates == nil {
+		return nil
+	}
+	return &services.LocationCoordinates{
+		Latitude:  coordinates.GetLatitude(),
+		Longitude: coordinates.GetLongitude(),
+	}
+}
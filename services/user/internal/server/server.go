@@ -5,24 +5,30 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	userpb "github.com/mohamedfawas/qubool-kallyanam/api/proto/user/v1"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/jwt"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/auth/revocation"
 	s3config "github.com/mohamedfawas/qubool-kallyanam/pkg/cdn/s3"
 	pgdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/postgres"
 	redisdb "github.com/mohamedfawas/qubool-kallyanam/pkg/database/redis"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/logging"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/messaging/rabbitmq"
 	"github.com/mohamedfawas/qubool-kallyanam/pkg/notifications/email"
+	"github.com/mohamedfawas/qubool-kallyanam/pkg/utils/indianstandardtime"
 	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/adapters/postgres"
 	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/adapters/storage"
 	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/config"
+	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/constants"
 	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/domain/services"
+	userErrors "github.com/mohamedfawas/qubool-kallyanam/services/user/internal/errors"
 	v1 "github.com/mohamedfawas/qubool-kallyanam/services/user/internal/handlers/grpc/v1"
 	"github.com/mohamedfawas/qubool-kallyanam/services/user/internal/handlers/health"
 )
@@ -37,6 +43,7 @@ type Server struct {
 	rabbitClient        *rabbitmq.Client
 	profileService      *services.ProfileService
 	photoService        *services.PhotoService
+	videoService        *services.VideoService
 	matchmakingService  *services.MatchmakingService
 	notificationService *services.NotificationService
 	jwtManager          *jwt.Manager
@@ -50,6 +57,7 @@ type CompositeHandler struct {
 	*v1.PhotoHandler
 	*v1.VideoHandler
 	*v1.MatchHandler
+	*v1.TaxonomyHandler
 }
 
 // NewServer creates a new gRPC server
@@ -102,11 +110,26 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		return nil, fmt.Errorf("failed to create photo storage: %w", err)
 	}
 
+	// Create JWT manager
+	verificationKeys, err := jwt.LoadVerificationKeys(cfg.Auth.JWT.VerificationKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load JWT verification keys: %w", err)
+	}
+
+	jwtManager := jwt.NewManager(jwt.Config{
+		SecretKey:        cfg.Auth.JWT.SecretKey,
+		VerificationKeys: verificationKeys,
+		AccessTokenTTL:   time.Duration(15) * time.Minute,
+		RefreshTokenTTL:  time.Duration(7) * 24 * time.Hour,
+		Issuer:           cfg.Auth.JWT.Issuer,
+	})
+
 	// Create gRPC server with interceptors
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			createLoggingInterceptor(logger),
 			createErrorInterceptor(),
+			createAuthInterceptor(jwtManager, revocation.NewChecker(redisClient)),
 		),
 	)
 
@@ -118,6 +141,8 @@ func NewServer(cfg *config.Config) (*Server, error) {
 	partnerPreferencesRepo := postgres.NewPartnerPreferencesRepository(pgClient.DB)
 	photoRepo := postgres.NewPhotoRepository(pgClient.DB)
 	videoRepo := postgres.NewVideoRepository(pgClient.DB)
+	profileDetailsRepo := postgres.NewProfileDetailsRepository(pgClient.DB)
+	taxonomyRepo := postgres.NewTaxonomyRepository(pgClient.DB)
 	matchRepo := postgres.NewMatchRepository(pgClient.DB) // Fixed: Only takes *gorm.DB
 
 	// Create email client
@@ -134,17 +159,31 @@ func NewServer(cfg *config.Config) (*Server, error) {
 	}
 
 	// Create services with correct constructors
+	taxonomyService := services.NewTaxonomyService(
+		taxonomyRepo,
+		cfg.Taxonomy.CacheTTL,
+		logger,
+	)
+
+	// Warm the taxonomy cache; validation retries the load if this fails
+	if err := taxonomyService.Refresh(context.Background()); err != nil {
+		logger.Warn("Failed to load taxonomy options", "error", err)
+	}
+
 	profileService := services.NewProfileService(
 		profileRepo,
 		partnerPreferencesRepo,
 		photoRepo,
 		videoRepo,
+		profileDetailsRepo,
+		taxonomyService,
 		logger,
 	)
 
 	partnerPreferencesService := services.NewPartnerPreferencesService(
 		profileRepo,
 		partnerPreferencesRepo,
+		taxonomyService,
 		logger,
 	)
 
@@ -173,18 +212,11 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		profileRepo,
 		partnerPreferencesRepo,
 		notificationService,
+		taxonomyService,
 		logger,
 		cfg,
 	)
 
-	// Create JWT manager
-	jwtManager := jwt.NewManager(jwt.Config{
-		SecretKey:       cfg.Auth.JWT.SecretKey,
-		AccessTokenTTL:  time.Duration(15) * time.Minute,
-		RefreshTokenTTL: time.Duration(7) * 24 * time.Hour,
-		Issuer:          cfg.Auth.JWT.Issuer,
-	})
-
 	// Create modular handlers
 	profileHandler := v1.NewProfileHandler(
 		profileService,
@@ -216,6 +248,11 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		logger,
 	)
 
+	taxonomyHandler := v1.NewTaxonomyHandler(
+		taxonomyService,
+		logger,
+	)
+
 	// Create composite handler to combine all handlers
 	compositeHandler := &CompositeHandler{
 		ProfileHandler:            profileHandler,
@@ -223,6 +260,7 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		PhotoHandler:              photoHandler,
 		VideoHandler:              videoHandler,
 		MatchHandler:              matchHandler,
+		TaxonomyHandler:           taxonomyHandler,
 	}
 
 	// Register the composite handler
@@ -239,6 +277,7 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		profileService:      profileService,
 		matchmakingService:  matchmakingService,
 		photoService:        photoService,
+		videoService:        videoService,
 		notificationService: notificationService,
 		jwtManager:          jwtManager,
 		photoStorage:        photoStorage,
@@ -273,7 +312,35 @@ func (s *Server) subscribeToEvents() error {
 		return fmt.Errorf("failed to subscribe to user.deleted events: %w", err)
 	}
 
-	s.logger.Info("Subscribed to user login and deletion events")
+	// Subscribe to account suspension events
+	err = s.rabbitClient.Subscribe("user.suspended", s.handleUserSuspension)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to user.suspended events: %w", err)
+	}
+
+	err = s.rabbitClient.Subscribe("user.reinstated", s.handleUserReinstatement)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to user.reinstated events: %w", err)
+	}
+
+	// Subscribe to account deactivation events
+	err = s.rabbitClient.Subscribe("user.deactivated", s.handleUserDeactivation)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to user.deactivated events: %w", err)
+	}
+
+	err = s.rabbitClient.Subscribe("user.reactivated", s.handleUserReactivation)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to user.reactivated events: %w", err)
+	}
+
+	// Subscribe to email and phone changes
+	err = s.rabbitClient.Subscribe("user.contact_changed", s.handleUserContactChanged)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to user.contact_changed events: %w", err)
+	}
+
+	s.logger.Info("Subscribed to user login, deletion, suspension, deactivation and contact change events")
 	return nil
 }
 
@@ -337,17 +404,178 @@ func (s *Server) handleUserDeletion(message []byte) error {
 		"userID", event.UserID,
 		"eventType", event.EventType)
 
-	// Delegate business logic to service layer
+	// Media is purged first because it is looked up through the profile
 	ctx := context.Background()
+	if err := s.photoService.DeleteAllUserPhotos(ctx, event.UserID); err != nil {
+		s.logger.Error("Failed to purge user photos", "error", err, "userID", event.UserID)
+		return err
+	}
+	if err := s.videoService.DeleteUserVideo(ctx, event.UserID); err != nil && err != userErrors.ErrProfileNotFound {
+		s.logger.Error("Failed to purge user video", "error", err, "userID", event.UserID)
+		return err
+	}
+	s.publishPurgeCompleted(event.UserID, constants.PurgeStepMedia)
+
+	// Delegate business logic to service layer
 	if err := s.profileService.HandleUserDeletion(ctx, event.UserID); err != nil {
 		s.logger.Error("Failed to process user deletion event", "error", err, "userID", event.UserID)
 		return err
 	}
+	s.publishPurgeCompleted(event.UserID, constants.PurgeStepUserData)
 
 	s.logger.Info("Successfully processed user deletion event", "userID", event.UserID)
 	return nil
 }
 
+// publishPurgeCompleted tells the auth service that a part of a deleted user's data is gone
+func (s *Server) publishPurgeCompleted(userID, step string) {
+	event := map[string]interface{}{
+		"user_id":   userID,
+		"step":      step,
+		"timestamp": indianstandardtime.Now(),
+	}
+	if err := s.rabbitClient.Publish(constants.TopicUserPurgeCompleted, event); err != nil {
+		s.logger.Error("Failed to publish purge completed event", "userID", userID, "step", step, "error", err)
+	}
+}
+
+func (s *Server) handleUserSuspension(message []byte) error {
+	var event struct {
+		UserID         string     `json:"user_id"`
+		Banned         bool       `json:"banned"`
+		SuspendedUntil *time.Time `json:"suspended_until"`
+		EventType      string     `json:"event_type"`
+		Timestamp      time.Time  `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal user suspension event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received user suspension event",
+		"userID", event.UserID,
+		"banned", event.Banned,
+		"eventType", event.EventType)
+
+	ctx := context.Background()
+	if err := s.profileService.HandleUserSuspension(ctx, event.UserID, event.SuspendedUntil); err != nil {
+		s.logger.Error("Failed to process user suspension event", "error", err, "userID", event.UserID)
+		return err
+	}
+
+	s.logger.Info("Successfully processed user suspension event", "userID", event.UserID)
+	return nil
+}
+
+func (s *Server) handleUserReinstatement(message []byte) error {
+	var event struct {
+		UserID    string    `json:"user_id"`
+		EventType string    `json:"event_type"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal user reinstatement event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received user reinstatement event",
+		"userID", event.UserID,
+		"eventType", event.EventType)
+
+	ctx := context.Background()
+	if err := s.profileService.HandleUserReinstatement(ctx, event.UserID); err != nil {
+		s.logger.Error("Failed to process user reinstatement event", "error", err, "userID", event.UserID)
+		return err
+	}
+
+	s.logger.Info("Successfully processed user reinstatement event", "userID", event.UserID)
+	return nil
+}
+
+func (s *Server) handleUserDeactivation(message []byte) error {
+	var event struct {
+		UserID    string    `json:"user_id"`
+		EventType string    `json:"event_type"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal user deactivation event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received user deactivation event",
+		"userID", event.UserID,
+		"eventType", event.EventType)
+
+	ctx := context.Background()
+	if err := s.profileService.HandleUserDeactivation(ctx, event.UserID); err != nil {
+		s.logger.Error("Failed to process user deactivation event", "error", err, "userID", event.UserID)
+		return err
+	}
+
+	s.logger.Info("Successfully processed user deactivation event", "userID", event.UserID)
+	return nil
+}
+
+func (s *Server) handleUserReactivation(message []byte) error {
+	var event struct {
+		UserID    string    `json:"user_id"`
+		EventType string    `json:"event_type"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal user reactivation event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received user reactivation event",
+		"userID", event.UserID,
+		"eventType", event.EventType)
+
+	ctx := context.Background()
+	if err := s.profileService.HandleUserReactivation(ctx, event.UserID); err != nil {
+		s.logger.Error("Failed to process user reactivation event", "error", err, "userID", event.UserID)
+		return err
+	}
+
+	s.logger.Info("Successfully processed user reactivation event", "userID", event.UserID)
+	return nil
+}
+
+func (s *Server) handleUserContactChanged(message []byte) error {
+	var event struct {
+		UserID    string    `json:"user_id"`
+		Email     string    `json:"email"`
+		Phone     string    `json:"phone"`
+		Field     string    `json:"field"`
+		EventType string    `json:"event_type"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(message, &event); err != nil {
+		s.logger.Error("Failed to unmarshal user contact changed event", "error", err)
+		return err
+	}
+
+	s.logger.Info("Received user contact changed event",
+		"userID", event.UserID,
+		"field", event.Field,
+		"eventType", event.EventType)
+
+	ctx := context.Background()
+	if err := s.profileService.HandleContactChanged(ctx, event.UserID, event.Email, event.Phone); err != nil {
+		s.logger.Error("Failed to process user contact changed event", "error", err, "userID", event.UserID)
+		return err
+	}
+
+	s.logger.Info("Successfully processed user contact changed event", "userID", event.UserID)
+	return nil
+}
+
 // Start starts the gRPC server
 func (s *Server) Start() error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.config.GRPC.Port))
@@ -410,3 +638,37 @@ func createErrorInterceptor() grpc.UnaryServerInterceptor {
 		return resp, nil
 	}
 }
+
+// Create an auth interceptor for gRPC.
+// Calls that carry a bearer token are rejected when the token is invalid or was
+// revoked (logout, password change, ban) before it expired. Internal calls
+// without an authorization header are left to the handlers.
+func createAuthInterceptor(jwtManager *jwt.Manager, checker *revocation.Checker) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		values := md.Get("authorization")
+		if len(values) == 0 || values[0] == "" {
+			return handler(ctx, req)
+		}
+
+		tokenStr := strings.TrimPrefix(values[0], "Bearer ")
+		claims, err := jwtManager.ValidateToken(tokenStr)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "Invalid token")
+		}
+
+		revoked, err := checker.IsRevoked(ctx, claims.ID, claims.UserID, claims.IssuedAt.Time)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "Unable to verify token")
+		}
+		if revoked {
+			return nil, status.Error(codes.Unauthenticated, "Token has been revoked")
+		}
+
+		return handler(jwt.NewContext(ctx, claims), req)
+	}
+}
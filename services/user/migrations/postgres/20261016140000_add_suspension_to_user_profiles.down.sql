@@ -0,0 +1,3 @@
+ALTER TABLE user_profiles
+    DROP COLUMN IF EXISTS suspended_until,
+    DROP COLUMN IF EXISTS is_suspended;
@@ -0,0 +1,3 @@
+ALTER TABLE user_profiles
+    ADD COLUMN is_suspended BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN suspended_until TIMESTAMP;
@@ -0,0 +1,2 @@
+ALTER TABLE user_profiles
+    ADD COLUMN is_deactivated BOOLEAN NOT NULL DEFAULT FALSE;
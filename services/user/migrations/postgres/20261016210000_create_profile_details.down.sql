@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS profile_details;
+
+DROP TYPE IF EXISTS income_band_enum;
+DROP TYPE IF EXISTS family_type_enum;
+DROP TYPE IF EXISTS residency_status_enum;
@@ -0,0 +1,33 @@
+-- 1. ENUM types
+CREATE TYPE income_band_enum AS ENUM (
+  'upto_3_lakh','3_to_5_lakh','5_to_10_lakh','10_to_20_lakh',
+  '20_to_50_lakh','above_50_lakh','not_mentioned'
+);
+
+CREATE TYPE family_type_enum AS ENUM (
+  'nuclear','joint','extended','not_mentioned'
+);
+
+CREATE TYPE residency_status_enum AS ENUM (
+  'citizen','permanent_resident','work_visa','student_visa',
+  'dependent_visa','not_mentioned'
+);
+
+-- 2. profile_details table, one row per profile
+CREATE TABLE IF NOT EXISTS profile_details (
+  id                    BIGSERIAL             PRIMARY KEY,
+  user_profile_id       BIGINT                NOT NULL UNIQUE REFERENCES user_profiles(id) ON DELETE CASCADE,
+  about_me              TEXT                  NULL,
+  annual_income         income_band_enum      NULL,
+  languages             VARCHAR(50)[]         NULL,
+  current_city          VARCHAR(100)          NULL,
+  current_country       VARCHAR(100)          NULL,
+  residency_status      residency_status_enum NULL,
+  father_occupation     VARCHAR(100)          NULL,
+  mother_occupation     VARCHAR(100)          NULL,
+  brothers_count        INT                   CHECK (brothers_count BETWEEN 0 AND 20),
+  sisters_count         INT                   CHECK (sisters_count BETWEEN 0 AND 20),
+  family_type           family_type_enum      NULL,
+  created_at            TIMESTAMPTZ           NOT NULL DEFAULT NOW(),
+  updated_at            TIMESTAMPTZ           NOT NULL DEFAULT NOW()
+);
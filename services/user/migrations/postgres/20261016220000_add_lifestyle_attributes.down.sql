@@ -0,0 +1,25 @@
+ALTER TABLE partner_preferences
+    DROP COLUMN IF EXISTS preferred_prayer_frequencies,
+    DROP COLUMN IF EXISTS preferred_hijab,
+    DROP COLUMN IF EXISTS preferred_beard,
+    DROP COLUMN IF EXISTS preferred_madrasa_education,
+    DROP COLUMN IF EXISTS preferred_diets,
+    DROP COLUMN IF EXISTS preferred_smoking,
+    DROP COLUMN IF EXISTS preferred_relocation;
+
+ALTER TABLE user_profiles
+    DROP COLUMN IF EXISTS prayer_frequency,
+    DROP COLUMN IF EXISTS hijab,
+    DROP COLUMN IF EXISTS beard,
+    DROP COLUMN IF EXISTS madrasa_education,
+    DROP COLUMN IF EXISTS diet,
+    DROP COLUMN IF EXISTS smoking,
+    DROP COLUMN IF EXISTS willing_to_relocate;
+
+DROP TYPE IF EXISTS prayer_frequency_enum;
+DROP TYPE IF EXISTS hijab_enum;
+DROP TYPE IF EXISTS beard_enum;
+DROP TYPE IF EXISTS madrasa_education_enum;
+DROP TYPE IF EXISTS diet_enum;
+DROP TYPE IF EXISTS smoking_enum;
+DROP TYPE IF EXISTS relocation_enum;
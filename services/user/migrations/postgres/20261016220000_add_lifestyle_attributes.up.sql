@@ -0,0 +1,48 @@
+-- 1. ENUM types
+CREATE TYPE prayer_frequency_enum AS ENUM (
+  'five_times','mostly','sometimes','rarely','not_mentioned'
+);
+
+CREATE TYPE hijab_enum AS ENUM (
+  'niqab','hijab','none','not_mentioned'
+);
+
+CREATE TYPE beard_enum AS ENUM (
+  'full','trimmed','none','not_mentioned'
+);
+
+CREATE TYPE madrasa_education_enum AS ENUM (
+  'hafiz','completed','basic','none','not_mentioned'
+);
+
+CREATE TYPE diet_enum AS ENUM (
+  'non_vegetarian','vegetarian','eggetarian','not_mentioned'
+);
+
+CREATE TYPE smoking_enum AS ENUM (
+  'never','occasionally','regularly','not_mentioned'
+);
+
+CREATE TYPE relocation_enum AS ENUM (
+  'yes','no','open','not_mentioned'
+);
+
+-- 2. Lifestyle attributes of the profile
+ALTER TABLE user_profiles
+    ADD COLUMN prayer_frequency    prayer_frequency_enum  NULL,
+    ADD COLUMN hijab               hijab_enum             NULL,
+    ADD COLUMN beard               beard_enum             NULL,
+    ADD COLUMN madrasa_education   madrasa_education_enum NULL,
+    ADD COLUMN diet                diet_enum              NULL,
+    ADD COLUMN smoking             smoking_enum           NULL,
+    ADD COLUMN willing_to_relocate relocation_enum        NULL;
+
+-- 3. Matching partner preferences
+ALTER TABLE partner_preferences
+    ADD COLUMN preferred_prayer_frequencies  prayer_frequency_enum[],
+    ADD COLUMN preferred_hijab               hijab_enum[],
+    ADD COLUMN preferred_beard               beard_enum[],
+    ADD COLUMN preferred_madrasa_education   madrasa_education_enum[],
+    ADD COLUMN preferred_diets               diet_enum[],
+    ADD COLUMN preferred_smoking             smoking_enum[],
+    ADD COLUMN preferred_relocation          relocation_enum[];
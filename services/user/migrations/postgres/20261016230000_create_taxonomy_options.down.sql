@@ -0,0 +1,56 @@
+CREATE TYPE community_enum AS ENUM (
+  'sunni','mujahid','tabligh','jamate_islami','shia','muslim','not_mentioned'
+);
+
+CREATE TYPE profession_enum AS ENUM (
+  'student','doctor','engineer','farmer','teacher','not_mentioned'
+);
+
+CREATE TYPE education_level_enum AS ENUM (
+  'less_than_high_school','high_school','higher_secondary',
+  'under_graduation','post_graduation','not_mentioned'
+);
+
+CREATE TYPE home_district_enum AS ENUM (
+  'thiruvananthapuram','kollam','pathanamthitta','alappuzha','kottayam',
+  'ernakulam','thrissur','palakkad','malappuram','kozhikode','wayanad',
+  'kannur','kasaragod','idukki','not_mentioned'
+);
+
+-- Values added through the admin taxonomy do not fit the enums and fall back to not_mentioned
+UPDATE user_profiles SET community = 'not_mentioned'
+  WHERE community IS NOT NULL AND community::text NOT IN (SELECT unnest(enum_range(NULL::community_enum))::text);
+UPDATE user_profiles SET profession = 'not_mentioned'
+  WHERE profession IS NOT NULL AND profession::text NOT IN (SELECT unnest(enum_range(NULL::profession_enum))::text);
+UPDATE user_profiles SET highest_education_level = 'not_mentioned'
+  WHERE highest_education_level IS NOT NULL AND highest_education_level::text NOT IN (SELECT unnest(enum_range(NULL::education_level_enum))::text);
+UPDATE user_profiles SET home_district = 'not_mentioned'
+  WHERE home_district IS NOT NULL AND home_district::text NOT IN (SELECT unnest(enum_range(NULL::home_district_enum))::text);
+
+UPDATE partner_preferences SET
+  preferred_communities = ARRAY(
+    SELECT v FROM unnest(preferred_communities) AS v
+    WHERE v IN (SELECT unnest(enum_range(NULL::community_enum))::text)),
+  preferred_professions = ARRAY(
+    SELECT v FROM unnest(preferred_professions) AS v
+    WHERE v IN (SELECT unnest(enum_range(NULL::profession_enum))::text)),
+  preferred_education_levels = ARRAY(
+    SELECT v FROM unnest(preferred_education_levels) AS v
+    WHERE v IN (SELECT unnest(enum_range(NULL::education_level_enum))::text)),
+  preferred_home_districts = ARRAY(
+    SELECT v FROM unnest(preferred_home_districts) AS v
+    WHERE v IN (SELECT unnest(enum_range(NULL::home_district_enum))::text));
+
+ALTER TABLE user_profiles
+  ALTER COLUMN community               TYPE community_enum       USING community::community_enum,
+  ALTER COLUMN profession              TYPE profession_enum      USING profession::profession_enum,
+  ALTER COLUMN highest_education_level TYPE education_level_enum USING highest_education_level::education_level_enum,
+  ALTER COLUMN home_district           TYPE home_district_enum   USING home_district::home_district_enum;
+
+ALTER TABLE partner_preferences
+  ALTER COLUMN preferred_communities      TYPE community_enum[]       USING preferred_communities::community_enum[],
+  ALTER COLUMN preferred_professions      TYPE profession_enum[]      USING preferred_professions::profession_enum[],
+  ALTER COLUMN preferred_education_levels TYPE education_level_enum[] USING preferred_education_levels::education_level_enum[],
+  ALTER COLUMN preferred_home_districts   TYPE home_district_enum[]   USING preferred_home_districts::home_district_enum[];
+
+DROP TABLE IF EXISTS taxonomy_options;
@@ -0,0 +1,67 @@
+-- 1. Admin-managed option lists
+CREATE TABLE IF NOT EXISTS taxonomy_options (
+  id          BIGSERIAL PRIMARY KEY,
+  category    VARCHAR(50)  NOT NULL,
+  value       VARCHAR(100) NOT NULL,
+  label       VARCHAR(200) NOT NULL,
+  sort_order  INT          NOT NULL DEFAULT 0,
+  is_active   BOOLEAN      NOT NULL DEFAULT TRUE,
+  created_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+  updated_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+  CONSTRAINT uq_taxonomy_options_category_value UNIQUE (category, value)
+);
+
+CREATE INDEX IF NOT EXISTS idx_taxonomy_options_category ON taxonomy_options (category, sort_order);
+
+-- 2. Seed with the values of the enums being replaced
+INSERT INTO taxonomy_options (category, value, label, sort_order) VALUES
+  ('community', 'sunni', 'Sunni', 1),
+  ('community', 'mujahid', 'Mujahid', 2),
+  ('community', 'tabligh', 'Tabligh', 3),
+  ('community', 'jamate_islami', 'Jamate Islami', 4),
+  ('community', 'shia', 'Shia', 5),
+  ('community', 'muslim', 'Muslim', 6),
+  ('profession', 'student', 'Student', 1),
+  ('profession', 'doctor', 'Doctor', 2),
+  ('profession', 'engineer', 'Engineer', 3),
+  ('profession', 'farmer', 'Farmer', 4),
+  ('profession', 'teacher', 'Teacher', 5),
+  ('education_level', 'less_than_high_school', 'Less than high school', 1),
+  ('education_level', 'high_school', 'High school', 2),
+  ('education_level', 'higher_secondary', 'Higher secondary', 3),
+  ('education_level', 'under_graduation', 'Under graduation', 4),
+  ('education_level', 'post_graduation', 'Post graduation', 5),
+  ('location', 'thiruvananthapuram', 'Thiruvananthapuram', 1),
+  ('location', 'kollam', 'Kollam', 2),
+  ('location', 'pathanamthitta', 'Pathanamthitta', 3),
+  ('location', 'alappuzha', 'Alappuzha', 4),
+  ('location', 'kottayam', 'Kottayam', 5),
+  ('location', 'idukki', 'Idukki', 6),
+  ('location', 'ernakulam', 'Ernakulam', 7),
+  ('location', 'thrissur', 'Thrissur', 8),
+  ('location', 'palakkad', 'Palakkad', 9),
+  ('location', 'malappuram', 'Malappuram', 10),
+  ('location', 'kozhikode', 'Kozhikode', 11),
+  ('location', 'wayanad', 'Wayanad', 12),
+  ('location', 'kannur', 'Kannur', 13),
+  ('location', 'kasaragod', 'Kasaragod', 14)
+ON CONFLICT (category, value) DO NOTHING;
+
+-- 3. Keep existing values, now validated against taxonomy_options
+ALTER TABLE user_profiles
+  ALTER COLUMN community               TYPE VARCHAR(100) USING community::text,
+  ALTER COLUMN profession              TYPE VARCHAR(100) USING profession::text,
+  ALTER COLUMN highest_education_level TYPE VARCHAR(100) USING highest_education_level::text,
+  ALTER COLUMN home_district           TYPE VARCHAR(100) USING home_district::text;
+
+ALTER TABLE partner_preferences
+  ALTER COLUMN preferred_communities      TYPE VARCHAR(100)[] USING preferred_communities::text[],
+  ALTER COLUMN preferred_professions      TYPE VARCHAR(100)[] USING preferred_professions::text[],
+  ALTER COLUMN preferred_education_levels TYPE VARCHAR(100)[] USING preferred_education_levels::text[],
+  ALTER COLUMN preferred_home_districts   TYPE VARCHAR(100)[] USING preferred_home_districts::text[];
+
+-- 4. The enums are no longer used
+DROP TYPE IF EXISTS community_enum;
+DROP TYPE IF EXISTS profession_enum;
+DROP TYPE IF EXISTS education_level_enum;
+DROP TYPE IF EXISTS home_district_enum;
@@ -0,0 +1,12 @@
+ALTER TABLE partner_preferences DROP COLUMN IF EXISTS preferred_current_locations;
+ALTER TABLE user_profiles DROP COLUMN IF EXISTS current_location;
+
+DELETE FROM taxonomy_options
+WHERE category = 'location' AND level IN ('country','state','city');
+
+DROP INDEX IF EXISTS idx_taxonomy_options_parent;
+
+ALTER TABLE taxonomy_options
+  DROP CONSTRAINT IF EXISTS chk_taxonomy_options_level,
+  DROP COLUMN IF EXISTS level,
+  DROP COLUMN IF EXISTS parent_value;
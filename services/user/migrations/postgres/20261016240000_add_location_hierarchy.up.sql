@@ -0,0 +1,69 @@
+-- 1. Locations form a country > state > district > city tree.
+--    Levels may be skipped, e.g. a Gulf city directly under its country.
+ALTER TABLE taxonomy_options
+  ADD COLUMN parent_value VARCHAR(100) NULL,
+  ADD COLUMN level        VARCHAR(20)  NULL,
+  ADD CONSTRAINT chk_taxonomy_options_level
+    CHECK (level IS NULL OR level IN ('country','state','district','city'));
+
+CREATE INDEX IF NOT EXISTS idx_taxonomy_options_parent ON taxonomy_options (category, parent_value);
+
+-- 2. Countries and states
+INSERT INTO taxonomy_options (category, value, label, sort_order, level, parent_value) VALUES
+  ('location', 'india', 'India', 1, 'country', NULL),
+  ('location', 'united_arab_emirates', 'United Arab Emirates', 2, 'country', NULL),
+  ('location', 'saudi_arabia', 'Saudi Arabia', 3, 'country', NULL),
+  ('location', 'qatar', 'Qatar', 4, 'country', NULL),
+  ('location', 'kuwait', 'Kuwait', 5, 'country', NULL),
+  ('location', 'oman', 'Oman', 6, 'country', NULL),
+  ('location', 'bahrain', 'Bahrain', 7, 'country', NULL),
+  ('location', 'kerala', 'Kerala', 1, 'state', 'india'),
+  ('location', 'karnataka', 'Karnataka', 2, 'state', 'india'),
+  ('location', 'tamil_nadu', 'Tamil Nadu', 3, 'state', 'india'),
+  ('location', 'maharashtra', 'Maharashtra', 4, 'state', 'india'),
+  ('location', 'delhi', 'Delhi', 5, 'state', 'india'),
+  ('location', 'dubai', 'Dubai', 1, 'state', 'united_arab_emirates'),
+  ('location', 'abu_dhabi', 'Abu Dhabi', 2, 'state', 'united_arab_emirates'),
+  ('location', 'sharjah', 'Sharjah', 3, 'state', 'united_arab_emirates'),
+  ('location', 'ajman', 'Ajman', 4, 'state', 'united_arab_emirates'),
+  ('location', 'ras_al_khaimah', 'Ras Al Khaimah', 5, 'state', 'united_arab_emirates'),
+  ('location', 'riyadh_province', 'Riyadh Province', 1, 'state', 'saudi_arabia'),
+  ('location', 'makkah_province', 'Makkah Province', 2, 'state', 'saudi_arabia'),
+  ('location', 'eastern_province', 'Eastern Province', 3, 'state', 'saudi_arabia')
+ON CONFLICT (category, value) DO NOTHING;
+
+-- 3. The existing Kerala districts move under Kerala
+UPDATE taxonomy_options SET level = 'district', parent_value = 'kerala'
+WHERE category = 'location' AND value IN (
+  'thiruvananthapuram','kollam','pathanamthitta','alappuzha','kottayam','idukki',
+  'ernakulam','thrissur','palakkad','malappuram','kozhikode','wayanad','kannur','kasaragod'
+);
+
+-- 4. Cities where members commonly live
+INSERT INTO taxonomy_options (category, value, label, sort_order, level, parent_value) VALUES
+  ('location', 'bengaluru', 'Bengaluru', 1, 'city', 'karnataka'),
+  ('location', 'mangaluru', 'Mangaluru', 2, 'city', 'karnataka'),
+  ('location', 'chennai', 'Chennai', 1, 'city', 'tamil_nadu'),
+  ('location', 'coimbatore', 'Coimbatore', 2, 'city', 'tamil_nadu'),
+  ('location', 'mumbai', 'Mumbai', 1, 'city', 'maharashtra'),
+  ('location', 'pune', 'Pune', 2, 'city', 'maharashtra'),
+  ('location', 'new_delhi', 'New Delhi', 1, 'city', 'delhi'),
+  ('location', 'dubai_city', 'Dubai', 1, 'city', 'dubai'),
+  ('location', 'abu_dhabi_city', 'Abu Dhabi', 1, 'city', 'abu_dhabi'),
+  ('location', 'al_ain', 'Al Ain', 2, 'city', 'abu_dhabi'),
+  ('location', 'sharjah_city', 'Sharjah', 1, 'city', 'sharjah'),
+  ('location', 'riyadh', 'Riyadh', 1, 'city', 'riyadh_province'),
+  ('location', 'jeddah', 'Jeddah', 1, 'city', 'makkah_province'),
+  ('location', 'dammam', 'Dammam', 1, 'city', 'eastern_province'),
+  ('location', 'doha', 'Doha', 1, 'city', 'qatar'),
+  ('location', 'kuwait_city', 'Kuwait City', 1, 'city', 'kuwait'),
+  ('location', 'muscat', 'Muscat', 1, 'city', 'oman'),
+  ('location', 'manama', 'Manama', 1, 'city', 'bahrain')
+ON CONFLICT (category, value) DO NOTHING;
+
+-- 5. Current residence, alongside the native place kept in home_district
+ALTER TABLE user_profiles
+  ADD COLUMN current_location VARCHAR(100) NULL;
+
+ALTER TABLE partner_preferences
+  ADD COLUMN preferred_current_locations VARCHAR(100)[];
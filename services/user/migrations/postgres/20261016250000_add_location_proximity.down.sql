@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS location_adjacency;
+
+ALTER TABLE taxonomy_options
+  DROP CONSTRAINT IF EXISTS chk_taxonomy_options_coordinates,
+  DROP COLUMN IF EXISTS longitude,
+  DROP COLUMN IF EXISTS latitude;
@@ -0,0 +1,77 @@
+-- 1. Centroids of districts and cities, used to score how far apart two locations are
+ALTER TABLE taxonomy_options
+  ADD COLUMN latitude  DOUBLE PRECISION NULL,
+  ADD COLUMN longitude DOUBLE PRECISION NULL,
+  ADD CONSTRAINT chk_taxonomy_options_coordinates
+    CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+UPDATE taxonomy_options AS t SET latitude = c.latitude, longitude = c.longitude
+FROM (VALUES
+  ('thiruvananthapuram', 8.60, 77.05),
+  ('kollam', 8.98, 76.80),
+  ('pathanamthitta', 9.27, 76.90),
+  ('alappuzha', 9.40, 76.40),
+  ('kottayam', 9.58, 76.62),
+  ('idukki', 9.85, 77.00),
+  ('ernakulam', 10.03, 76.50),
+  ('thrissur', 10.52, 76.25),
+  ('palakkad', 10.78, 76.65),
+  ('malappuram', 11.07, 76.07),
+  ('kozhikode', 11.45, 75.90),
+  ('wayanad', 11.70, 76.08),
+  ('kannur', 12.00, 75.55),
+  ('kasaragod', 12.40, 75.10),
+  ('bengaluru', 12.97, 77.59),
+  ('mangaluru', 12.91, 74.86),
+  ('chennai', 13.08, 80.27),
+  ('coimbatore', 11.02, 76.96),
+  ('mumbai', 19.08, 72.88),
+  ('pune', 18.52, 73.86),
+  ('new_delhi', 28.61, 77.21),
+  ('dubai_city', 25.20, 55.27),
+  ('abu_dhabi_city', 24.45, 54.38),
+  ('al_ain', 24.21, 55.74),
+  ('sharjah_city', 25.35, 55.42),
+  ('riyadh', 24.71, 46.68),
+  ('jeddah', 21.49, 39.19),
+  ('dammam', 26.43, 50.10),
+  ('doha', 25.29, 51.53),
+  ('kuwait_city', 29.38, 47.99),
+  ('muscat', 23.59, 58.41),
+  ('manama', 26.23, 50.59)
+) AS c(value, latitude, longitude)
+WHERE t.category = 'location' AND t.value = c.value;
+
+-- 2. Locations sharing a border. Each pair is stored once.
+CREATE TABLE IF NOT EXISTS location_adjacency (
+  location_value VARCHAR(100) NOT NULL,
+  adjacent_value VARCHAR(100) NOT NULL,
+  created_at     TIMESTAMP    NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (location_value, adjacent_value),
+  CONSTRAINT chk_location_adjacency_distinct CHECK (location_value <> adjacent_value)
+);
+
+INSERT INTO location_adjacency (location_value, adjacent_value) VALUES
+  ('kasaragod', 'kannur'),
+  ('kannur', 'wayanad'),
+  ('kannur', 'kozhikode'),
+  ('wayanad', 'kozhikode'),
+  ('wayanad', 'malappuram'),
+  ('kozhikode', 'malappuram'),
+  ('malappuram', 'palakkad'),
+  ('malappuram', 'thrissur'),
+  ('palakkad', 'thrissur'),
+  ('thrissur', 'ernakulam'),
+  ('thrissur', 'idukki'),
+  ('ernakulam', 'idukki'),
+  ('ernakulam', 'kottayam'),
+  ('ernakulam', 'alappuzha'),
+  ('idukki', 'kottayam'),
+  ('idukki', 'pathanamthitta'),
+  ('kottayam', 'alappuzha'),
+  ('kottayam', 'pathanamthitta'),
+  ('alappuzha', 'pathanamthitta'),
+  ('alappuzha', 'kollam'),
+  ('pathanamthitta', 'kollam'),
+  ('kollam', 'thiruvananthapuram')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,24 @@
+-- Profile phone numbers are copied from the auth service, which used to store
+-- them as entered. Apply the same normalization as its backfill so profiles
+-- report a phone country and match the accounts they belong to.
+WITH stripped AS (
+    SELECT id, regexp_replace(phone, '[[:space:]().-]', '', 'g') AS phone
+    FROM user_profiles
+    WHERE phone IS NOT NULL
+),
+normalized AS (
+    SELECT id,
+           CASE
+               WHEN phone ~ '^00[1-9]' THEN '+' || substr(phone, 3)
+               WHEN phone ~ '^0?[6-9][0-9]{9}$' THEN '+91' || right(phone, 10)
+               WHEN phone ~ '^\+910[6-9][0-9]{9}$' THEN '+91' || right(phone, 10)
+               ELSE phone
+           END AS phone
+    FROM stripped
+)
+UPDATE user_profiles p
+SET phone = n.phone,
+    updated_at = NOW()
+FROM normalized n
+WHERE p.id = n.id
+  AND p.phone <> n.phone;